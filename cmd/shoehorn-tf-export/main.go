@@ -0,0 +1,56 @@
+// Command shoehorn-tf-export writes Terraform configuration for the objects in
+// an existing Shoehorn tenant: a resource block and a matching import block
+// for each team, entity, feature flag, platform policy, integration, forge
+// mold and announcement.
+//
+// It reads SHOEHORN_HOST and SHOEHORN_API_KEY like the provider does:
+//
+//	shoehorn-tf-export -out ./shoehorn -group-by owner
+//	terraform -chdir=shoehorn plan
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/export"
+)
+
+func main() {
+	var (
+		host    = flag.String("host", os.Getenv("SHOEHORN_HOST"), "Shoehorn API URL (default $SHOEHORN_HOST)")
+		out     = flag.String("out", ".", "directory to write the .tf files to")
+		groupBy = flag.String("group-by", export.GroupByType, `"type" for one file per resource type, "owner" for one file per owning team`)
+		kinds   = flag.String("kinds", "", "comma-separated kinds to export (default all: "+strings.Join(export.Kinds, ",")+")")
+		timeout = flag.Duration("timeout", 30*time.Second, "timeout for each API request")
+	)
+	flag.Parse()
+	log.SetFlags(0)
+
+	apiKey := os.Getenv("SHOEHORN_API_KEY")
+	if *host == "" || apiKey == "" {
+		log.Fatal("shoehorn-tf-export: set -host or SHOEHORN_HOST, and SHOEHORN_API_KEY")
+	}
+
+	var opts export.Options
+	if *kinds != "" {
+		opts.Kinds = strings.Split(*kinds, ",")
+	}
+
+	c := client.NewClient(*host, apiKey, *timeout)
+	blocks, err := export.Export(context.Background(), c, opts)
+	if err != nil {
+		log.Fatalf("shoehorn-tf-export: %s", err)
+	}
+	files, err := export.Write(*out, blocks, *groupBy)
+	if err != nil {
+		log.Fatalf("shoehorn-tf-export: %s", err)
+	}
+	fmt.Printf("Wrote %d resources to %d files in %s\n", len(blocks), len(files), *out)
+}
@@ -0,0 +1,6 @@
+# terraform apply -invoke=action.shoehorn_disable_marketplace_item.cost_tracker
+action "shoehorn_disable_marketplace_item" "cost_tracker" {
+  config {
+    slug = "cost-tracker"
+  }
+}
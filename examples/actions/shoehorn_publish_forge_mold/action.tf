@@ -0,0 +1,7 @@
+# Re-publish a mold that was unpublished in the UI:
+# terraform apply -invoke=action.shoehorn_publish_forge_mold.go_service
+action "shoehorn_publish_forge_mold" "go_service" {
+  config {
+    slug = "go-service"
+  }
+}
@@ -0,0 +1,6 @@
+# terraform apply -invoke=action.shoehorn_revoke_api_key.leaked
+action "shoehorn_revoke_api_key" "leaked" {
+  config {
+    id = "key-0042"
+  }
+}
@@ -0,0 +1,6 @@
+# terraform apply -invoke=action.shoehorn_revoke_k8s_agent.staging
+action "shoehorn_revoke_k8s_agent" "staging" {
+  config {
+    cluster_id = "staging-us-east-1"
+  }
+}
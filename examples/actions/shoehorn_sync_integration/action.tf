@@ -0,0 +1,19 @@
+# Re-sync the integration after its credentials change
+resource "shoehorn_integration" "github" {
+  name        = "GitHub"
+  type        = "github"
+  config_json = jsonencode({ org = "acme", token = var.github_token })
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.shoehorn_sync_integration.github]
+    }
+  }
+}
+
+action "shoehorn_sync_integration" "github" {
+  config {
+    id = shoehorn_integration.github.id
+  }
+}
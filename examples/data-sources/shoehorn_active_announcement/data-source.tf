@@ -0,0 +1,12 @@
+# The banner shown right now
+data "shoehorn_active_announcement" "current" {}
+
+# The banner members of a team will see when maintenance starts
+data "shoehorn_active_announcement" "platform_at_maintenance" {
+  team = "platform-engineering"
+  at   = "2026-03-01T22:00:00Z"
+}
+
+output "current_banner" {
+  value = data.shoehorn_active_announcement.current.active ? data.shoehorn_active_announcement.current.message : null
+}
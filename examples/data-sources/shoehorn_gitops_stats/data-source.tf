@@ -0,0 +1,12 @@
+# Sync statistics for a single cluster
+data "shoehorn_gitops_stats" "production" {
+  cluster_id = "prod-us-east-1"
+}
+
+output "production_gitops_health" {
+  value = {
+    synced      = data.shoehorn_gitops_stats.production.synced
+    out_of_sync = data.shoehorn_gitops_stats.production.out_of_sync
+    failed      = data.shoehorn_gitops_stats.production.failed
+  }
+}
@@ -0,0 +1,27 @@
+# Summarize governance actions for one entity
+data "shoehorn_governance_summary" "payments" {
+  entity_id = "payments-api"
+}
+
+# Block production promotion while the entity has critical SLA breaches
+resource "terraform_data" "promote_payments" {
+  input = var.payments_release
+
+  lifecycle {
+    precondition {
+      condition     = data.shoehorn_governance_summary.payments.critical_overdue == 0
+      error_message = "payments-api has ${data.shoehorn_governance_summary.payments.critical_overdue} critical governance action(s) past their SLA."
+    }
+  }
+}
+
+variable "payments_release" {
+  type = string
+}
+
+# Overdue actions per owning team across the catalog
+data "shoehorn_governance_summary" "all" {}
+
+output "overdue_by_team" {
+  value = data.shoehorn_governance_summary.all.overdue_by_owner_team
+}
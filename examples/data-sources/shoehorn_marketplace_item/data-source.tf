@@ -0,0 +1,12 @@
+# Look up the versions published for a marketplace item
+data "shoehorn_marketplace_item" "slack" {
+  slug               = "slack-notifier"
+  version_constraint = "~> 1.4"
+}
+
+output "slack_notifier_upgrade" {
+  value = {
+    version   = data.shoehorn_marketplace_item.slack.matching_version
+    changelog = data.shoehorn_marketplace_item.slack.changelog
+  }
+}
@@ -0,0 +1,10 @@
+# List all roles and their permissions
+data "shoehorn_roles" "all" {}
+
+output "custom_roles" {
+  value = [for r in data.shoehorn_roles.all.roles : r.name if !r.system]
+}
+
+output "role_permissions" {
+  value = { for r in data.shoehorn_roles.all.roles : r.name => r.permissions }
+}
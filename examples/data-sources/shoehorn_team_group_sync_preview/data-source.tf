@@ -0,0 +1,13 @@
+# Preview who a group sync rule would add before binding it to a team
+data "shoehorn_team_group_sync_preview" "payments" {
+  group_paths       = ["/eng/payments"]
+  include_subgroups = true
+
+  role_mappings = {
+    "/eng/payments/leads" = "manager"
+  }
+}
+
+output "payments_members" {
+  value = { for m in data.shoehorn_team_group_sync_preview.payments.members : m.email => m.role }
+}
@@ -0,0 +1,4 @@
+# Returns "service:payments-api"
+output "payments_ref" {
+  value = provider::shoehorn::entity_ref("service", "payments-api")
+}
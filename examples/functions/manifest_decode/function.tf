@@ -0,0 +1,13 @@
+locals {
+  payments = provider::shoehorn::manifest_decode(file("${path.module}/catalog-info.yaml"))
+}
+
+resource "shoehorn_entity" "payments" {
+  name             = local.payments.name
+  type             = local.payments.type
+  description      = local.payments.description
+  entity_lifecycle = local.payments.entity_lifecycle
+  owner            = local.payments.owner
+  tags             = local.payments.tags
+  relations        = local.payments.relations
+}
@@ -0,0 +1,13 @@
+# Write the manifest shoehorn_entity would upload, e.g. to commit it to the repository
+resource "local_file" "catalog_info" {
+  filename = "${path.module}/catalog-info.yaml"
+  content = provider::shoehorn::manifest_encode({
+    name  = "payments-api"
+    type  = "service"
+    owner = "payments"
+    tags  = ["payments", "go"]
+    relations = jsonencode([
+      { type = "depends_on", target = provider::shoehorn::entity_ref("resource", "postgres-primary") },
+    ])
+  })
+}
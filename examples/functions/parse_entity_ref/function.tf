@@ -0,0 +1,4 @@
+# Returns { type = "resource", id = "postgres-primary" }
+output "database" {
+  value = provider::shoehorn::parse_entity_ref("resource:postgres-primary")
+}
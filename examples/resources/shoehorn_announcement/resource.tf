@@ -0,0 +1,19 @@
+# Show a maintenance banner for the duration of the window only
+resource "shoehorn_announcement" "db_maintenance" {
+  message     = "Catalog database maintenance: the portal is read-only from 22:00 to 02:00 UTC."
+  type        = "warning"
+  dismissible = false
+  starts_at   = "2026-03-01T22:00:00Z"
+  ends_at     = "2026-03-02T02:00:00Z"
+
+  link_url  = "https://status.example.com"
+  link_text = "Status page"
+}
+
+# Target a banner at a team and at tenant admins
+resource "shoehorn_announcement" "platform_upgrade" {
+  message        = "Shoehorn is upgraded next week - check the release notes for breaking changes."
+  starts_at      = "2026-03-05T09:00:00Z"
+  audience_teams = ["platform-engineering"]
+  audience_roles = ["tenant:admin"]
+}
@@ -0,0 +1,20 @@
+# Scaffold a new service from a Forge mold and wait for it to complete
+resource "shoehorn_forge_run" "payments_api" {
+  mold    = "go-service"
+  version = "1.2.0"
+  team    = "payments"
+
+  inputs = {
+    name     = "payments-api"
+    replicas = "3"
+    tags     = jsonencode(["go", "payments"])
+  }
+
+  timeouts = {
+    create = "45m"
+  }
+}
+
+output "payments_api_repo" {
+  value = shoehorn_forge_run.payments_api.repo_url
+}
@@ -0,0 +1,21 @@
+variable "payments_commit" {
+  type = string
+}
+
+# Wait for Argo CD to roll out the commit before running downstream steps
+resource "shoehorn_gitops_sync_wait" "payments" {
+  cluster_id = "prod-us-east-1"
+  namespace  = "argocd"
+  kind       = "Application"
+  name       = "payments-api"
+  revision   = var.payments_commit
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+  }
+}
+
+resource "terraform_data" "smoke_tests" {
+  input = shoehorn_gitops_sync_wait.payments.synced_revision
+}
@@ -0,0 +1,21 @@
+# Feed scanner output into governance actions without one resource per finding
+locals {
+  findings = jsondecode(file("${path.module}/scan-results.json"))
+}
+
+resource "shoehorn_governance_action_set" "trivy" {
+  name            = "trivy"
+  resolution_note = "No longer reported by the nightly Trivy scan."
+
+  findings = [
+    for f in local.findings : {
+      source_type = "security"
+      source_id   = f.vulnerability_id
+      entity_id   = f.service
+      title       = "Fix ${f.vulnerability_id} in ${f.package}"
+      description = f.summary
+      priority    = lower(f.severity)
+      sla_days    = f.severity == "CRITICAL" ? 7 : 30
+    }
+  ]
+}
@@ -0,0 +1,12 @@
+# Install a marketplace item and track the newest 1.x release
+resource "shoehorn_marketplace_installation" "slack" {
+  slug               = "slack-notifier"
+  version_constraint = ">= 1.4, < 2.0"
+  enabled            = true
+}
+
+# Pin an exact version
+resource "shoehorn_marketplace_installation" "pagerduty" {
+  slug               = "pagerduty-sync"
+  version_constraint = "2.3.1"
+}
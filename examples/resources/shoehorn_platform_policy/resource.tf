@@ -4,4 +4,7 @@ resource "shoehorn_platform_policy" "require_description" {
   key         = "require-entity-description"
   enabled     = true
   enforcement = "warning"
+
+  # Put back the configuration from before Terraform managed it on destroy
+  on_destroy = "restore"
 }
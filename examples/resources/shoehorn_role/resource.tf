@@ -0,0 +1,18 @@
+# Define a custom role and assign it to a directory group
+resource "shoehorn_role" "catalog_editor" {
+  name         = "catalog-editor"
+  display_name = "Catalog Editor"
+  description  = "Can edit catalog entities but not manage the tenant"
+  color        = "#3b82f6"
+  icon         = "book"
+  permissions = [
+    "entity:read",
+    "entity:write",
+    "team:read",
+  ]
+}
+
+resource "shoehorn_group_role_mapping" "platform_editors" {
+  group_name = "platform-team"
+  role_name  = shoehorn_role.catalog_editor.name
+}
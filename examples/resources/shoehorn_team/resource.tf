@@ -6,8 +6,8 @@ resource "shoehorn_team" "platform" {
   description  = "Core platform engineering team"
 
   members = jsonencode([
-    { user_id = "alice@example.com", role = "manager" },
-    { user_id = "bob@example.com", role = "admin" },
-    { user_id = "carol@example.com", role = "member" }
+    { user_email = "alice@example.com", role = "manager" },
+    { user_email = "bob@example.com", role = "admin" },
+    { user_id = "user-abc-123", role = "member" }
   ])
 }
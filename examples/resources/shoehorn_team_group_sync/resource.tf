@@ -0,0 +1,15 @@
+# Keep the payments team in sync with its IdP groups
+resource "shoehorn_team" "payments" {
+  name = "Payments"
+  slug = "payments"
+}
+
+resource "shoehorn_team_group_sync" "payments" {
+  team_id           = shoehorn_team.payments.id
+  group_paths       = ["/eng/payments"]
+  include_subgroups = true
+
+  role_mappings = {
+    "/eng/payments/leads" = "manager"
+  }
+}
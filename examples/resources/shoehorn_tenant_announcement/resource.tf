@@ -0,0 +1,12 @@
+# Manage only the announcement bar section of the tenant settings
+resource "shoehorn_tenant_announcement" "main" {
+  enabled   = true
+  message   = "Scheduled maintenance: Saturday 2AM-4AM UTC"
+  type      = "warning" # Options: info, warning, error, success
+  pinned    = false     # If true, users cannot dismiss
+  link_url  = "https://status.acme.com"
+  link_text = "View Status Page"
+
+  # Turn the announcement bar off when this resource is destroyed
+  on_destroy = "reset_to_defaults"
+}
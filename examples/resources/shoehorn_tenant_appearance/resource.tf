@@ -0,0 +1,19 @@
+# Manage only the branding section of the tenant settings. The announcement
+# bar and forge settings can be owned by other workspaces.
+resource "shoehorn_tenant_appearance" "main" {
+  platform_name        = "Acme Developer Portal"
+  platform_description = "Internal developer portal for Acme Corp"
+  company_name         = "Acme Corporation"
+
+  primary_color   = "#3b82f6"
+  secondary_color = "#64748b"
+  accent_color    = "#8b5cf6"
+
+  logo_url      = "https://cdn.example.com/logo.png"
+  favicon_url   = "https://cdn.example.com/favicon.ico"
+  default_theme = "dark" # Options: light, dark, system
+  hidden_pages  = ["insights"]
+
+  # Put the previous branding back when this resource is destroyed
+  on_destroy = "restore"
+}
@@ -0,0 +1,5 @@
+# Manage only the forge section of the tenant settings
+resource "shoehorn_tenant_forge_settings" "main" {
+  allowed_orgs = ["acme", "acme-labs"]
+  default_org  = "acme"
+}
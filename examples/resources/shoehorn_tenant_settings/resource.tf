@@ -1,5 +1,8 @@
 # Manage tenant appearance settings and announcement bar (singleton per tenant)
 resource "shoehorn_tenant_settings" "main" {
+  # Put the previous branding back when this resource is destroyed
+  on_destroy = "restore"
+
   # Platform Branding
   platform_name        = "Acme Developer Portal"
   platform_description = "Internal developer portal for Acme Corp"
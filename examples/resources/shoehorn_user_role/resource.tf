@@ -3,3 +3,9 @@ resource "shoehorn_user_role" "admin_user" {
   user_id = "user-abc-123"
   role    = "admin"
 }
+
+# Assign a role to a user identified by email
+resource "shoehorn_user_role" "editor" {
+  user_email = "ada@example.com"
+  role       = "editor"
+}
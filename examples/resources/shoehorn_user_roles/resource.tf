@@ -0,0 +1,19 @@
+# Declare every role a user holds. Roles granted in the UI are removed on the
+# next apply.
+resource "shoehorn_user_roles" "ada" {
+  user_id = "user-abc-123"
+  roles   = ["admin", "catalog-editor"]
+}
+
+# Offboarding: an empty set removes all of the user's roles.
+resource "shoehorn_user_roles" "former_contractor" {
+  user_id = "user-def-456"
+  roles   = []
+}
+
+# Manage only the listed roles and leave others untouched.
+resource "shoehorn_user_roles" "grace" {
+  user_id       = "user-ghi-789"
+  roles         = ["viewer"]
+  authoritative = false
+}
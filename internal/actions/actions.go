@@ -0,0 +1,33 @@
+package actions
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+// configureClient returns the provider's API client, or nil before the
+// provider is configured.
+func configureClient(req action.ConfigureRequest, resp *action.ConfigureResponse) *client.Client {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return nil
+	}
+	return c
+}
+
+// progress reports a progress message if Terraform is listening for them.
+func progress(resp *action.InvokeResponse, format string, args ...any) {
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf(format, args...)})
+	}
+}
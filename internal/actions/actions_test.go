@@ -0,0 +1,65 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+// invoke configures a with c and invokes it with config, returning the
+// response and the progress messages sent.
+func invoke(t *testing.T, a action.Action, c *client.Client, config any) (*action.InvokeResponse, []string) {
+	t.Helper()
+	ctx := context.Background()
+
+	configureResp := &action.ConfigureResponse{}
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: c}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("Configure() error: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, config); diags.HasError() {
+		t.Fatalf("encoding config: %v", diags)
+	}
+
+	var messages []string
+	resp := &action.InvokeResponse{
+		SendProgress: func(e action.InvokeProgressEvent) { messages = append(messages, e.Message) },
+	}
+	a.Invoke(ctx, action.InvokeRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, resp)
+	return resp, messages
+}
+
+func TestConfigureClient_WrongType(t *testing.T) {
+	resp := &action.ConfigureResponse{}
+	if c := configureClient(action.ConfigureRequest{ProviderData: "not a client"}, resp); c != nil {
+		t.Errorf("configureClient() = %v, want nil", c)
+	}
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for the wrong provider data type")
+	}
+}
+
+func TestActions_Metadata(t *testing.T) {
+	tests := map[string]func() action.Action{
+		"shoehorn_revoke_api_key":           NewRevokeAPIKeyAction,
+		"shoehorn_revoke_k8s_agent":         NewRevokeK8sAgentAction,
+		"shoehorn_publish_forge_mold":       NewPublishForgeMoldAction,
+		"shoehorn_sync_integration":         NewSyncIntegrationAction,
+		"shoehorn_enable_marketplace_item":  NewEnableMarketplaceItemAction,
+		"shoehorn_disable_marketplace_item": NewDisableMarketplaceItemAction,
+	}
+	for want, newAction := range tests {
+		resp := &action.MetadataResponse{}
+		newAction().Metadata(context.Background(), action.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+		if resp.TypeName != want {
+			t.Errorf("TypeName = %q, want %q", resp.TypeName, want)
+		}
+	}
+}
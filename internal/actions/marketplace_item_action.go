@@ -0,0 +1,86 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ action.ActionWithConfigure = &MarketplaceItemAction{}
+
+// MarketplaceItemAction enables or disables an installed marketplace item.
+type MarketplaceItemAction struct {
+	client *client.Client
+	enable bool
+}
+
+// MarketplaceItemActionModel describes the action configuration.
+type MarketplaceItemActionModel struct {
+	Slug types.String `tfsdk:"slug"`
+}
+
+// NewEnableMarketplaceItemAction creates a new enable marketplace item action.
+func NewEnableMarketplaceItemAction() action.Action {
+	return &MarketplaceItemAction{enable: true}
+}
+
+// NewDisableMarketplaceItemAction creates a new disable marketplace item action.
+func NewDisableMarketplaceItemAction() action.Action {
+	return &MarketplaceItemAction{enable: false}
+}
+
+// verb returns the operation the action performs.
+func (a *MarketplaceItemAction) verb() string {
+	if a.enable {
+		return "enable"
+	}
+	return "disable"
+}
+
+func (a *MarketplaceItemAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + a.verb() + "_marketplace_item"
+}
+
+func (a *MarketplaceItemAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Temporarily %ss an installed Shoehorn marketplace item. A shoehorn_marketplace_installation resource managing the item plans to restore its configured enabled value.", a.verb()),
+		Attributes: map[string]schema.Attribute{
+			"slug": schema.StringAttribute{
+				Description: fmt.Sprintf("The slug of the installed marketplace item to %s.", a.verb()),
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *MarketplaceItemAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.client = configureClient(req, resp)
+}
+
+func (a *MarketplaceItemAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config MarketplaceItemActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slug := config.Slug.ValueString()
+	tflog.Debug(ctx, a.verb()+" marketplace item", map[string]any{"slug": slug})
+
+	var err error
+	if a.enable {
+		err = a.client.EnableMarketplaceItem(ctx, slug)
+	} else {
+		err = a.client.DisableMarketplaceItem(ctx, slug)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Marketplace Item", fmt.Sprintf("Could not %s marketplace item %s: %s", a.verb(), slug, err))
+		return
+	}
+	progress(resp, "Marketplace item %s %sd", slug, a.verb())
+}
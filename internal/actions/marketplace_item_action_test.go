@@ -0,0 +1,58 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+func TestMarketplaceItemAction_Invoke(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	ctx := context.Background()
+
+	api.AddMarketplaceItem(client.MarketplaceItem{Slug: "cost-tracker", Kind: "addon", Name: "Cost Tracker", Version: "1.0.0"})
+	if _, err := c.InstallMarketplaceItem(ctx, "cost-tracker", ""); err != nil {
+		t.Fatal(err)
+	}
+	config := &MarketplaceItemActionModel{Slug: types.StringValue("cost-tracker")}
+
+	for _, step := range []struct {
+		newAction func() action.Action
+		enabled   bool
+	}{
+		{NewDisableMarketplaceItemAction, false},
+		{NewEnableMarketplaceItemAction, true},
+	} {
+		resp, messages := invoke(t, step.newAction(), c, config)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Invoke() error: %v", resp.Diagnostics)
+		}
+		if len(messages) != 1 {
+			t.Errorf("progress messages = %v, want one", messages)
+		}
+
+		inst, err := c.GetMarketplaceInstallation(ctx, "cost-tracker")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if inst.Enabled != step.enabled {
+			t.Errorf("Enabled = %v, want %v (%v)", inst.Enabled, step.enabled, messages)
+		}
+	}
+}
+
+func TestMarketplaceItemAction_Invoke_NotInstalled(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+
+	resp, _ := invoke(t, NewEnableMarketplaceItemAction(), api.Client(), &MarketplaceItemActionModel{Slug: types.StringValue("missing")})
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for an item that is not installed")
+	}
+}
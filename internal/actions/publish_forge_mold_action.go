@@ -0,0 +1,81 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ action.ActionWithConfigure = &PublishForgeMoldAction{}
+
+// PublishForgeMoldAction publishes a forge mold version.
+type PublishForgeMoldAction struct {
+	client *client.Client
+}
+
+// PublishForgeMoldActionModel describes the action configuration.
+type PublishForgeMoldActionModel struct {
+	Slug    types.String `tfsdk:"slug"`
+	Version types.String `tfsdk:"version"`
+}
+
+// NewPublishForgeMoldAction creates a new publish forge mold action.
+func NewPublishForgeMoldAction() action.Action {
+	return &PublishForgeMoldAction{}
+}
+
+func (a *PublishForgeMoldAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_publish_forge_mold"
+}
+
+func (a *PublishForgeMoldAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Publishes a Shoehorn forge mold so it can be run from the catalog.",
+		Attributes: map[string]schema.Attribute{
+			"slug": schema.StringAttribute{
+				Description: "The slug of the forge mold to publish.",
+				Required:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The version to publish. Defaults to the mold's current version.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *PublishForgeMoldAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.client = configureClient(req, resp)
+}
+
+func (a *PublishForgeMoldAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config PublishForgeMoldActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slug := config.Slug.ValueString()
+	version := config.Version.ValueString()
+	if version == "" {
+		mold, err := a.client.GetForgeMold(ctx, slug)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Forge Mold", fmt.Sprintf("Could not read forge mold %s: %s", slug, err))
+			return
+		}
+		version = mold.Version
+	}
+
+	tflog.Debug(ctx, "publishing forge mold", map[string]any{"slug": slug, "version": version})
+	mold, err := a.client.PublishForgeMold(ctx, slug, version)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Publishing Forge Mold", fmt.Sprintf("Could not publish forge mold %s@%s: %s", slug, version, err))
+		return
+	}
+	progress(resp, "Published forge mold %s@%s", mold.Slug, mold.Version)
+}
@@ -0,0 +1,59 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+func TestPublishForgeMoldAction_Invoke(t *testing.T) {
+	tests := []struct {
+		name    string
+		version types.String
+		wantErr bool
+	}{
+		{"current version", types.StringNull(), false},
+		{"explicit version", types.StringValue("1.2.0"), false},
+		{"unknown version", types.StringValue("9.9.9"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fakeapi.NewServer()
+			t.Cleanup(api.Close)
+			c := api.Client()
+			ctx := context.Background()
+
+			if _, err := c.CreateForgeMold(ctx, client.CreateForgeMoldRequest{
+				Slug: "go-service", Name: "Go Service", Version: "1.2.0", Visibility: "public", Category: "service",
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			resp, messages := invoke(t, NewPublishForgeMoldAction(), c, &PublishForgeMoldActionModel{
+				Slug:    types.StringValue("go-service"),
+				Version: tt.version,
+			})
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("HasError = %v, want %v (%v)", resp.Diagnostics.HasError(), tt.wantErr, resp.Diagnostics)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(messages) != 1 || messages[0] != "Published forge mold go-service@1.2.0" {
+				t.Errorf("progress messages = %v", messages)
+			}
+
+			mold, err := c.GetForgeMold(ctx, "go-service")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !mold.Published {
+				t.Error("mold was not published")
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ action.ActionWithConfigure = &RevokeAPIKeyAction{}
+
+// RevokeAPIKeyAction revokes an API key, e.g. one that has leaked.
+type RevokeAPIKeyAction struct {
+	client *client.Client
+}
+
+// RevokeAPIKeyActionModel describes the action configuration.
+type RevokeAPIKeyActionModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// NewRevokeAPIKeyAction creates a new revoke API key action.
+func NewRevokeAPIKeyAction() action.Action {
+	return &RevokeAPIKeyAction{}
+}
+
+func (a *RevokeAPIKeyAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_revoke_api_key"
+}
+
+func (a *RevokeAPIKeyAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Revokes a Shoehorn API key. Revocation cannot be undone; a shoehorn_api_key resource managing the key removes it from state and plans a new key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the API key to revoke.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *RevokeAPIKeyAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.client = configureClient(req, resp)
+}
+
+func (a *RevokeAPIKeyAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config RevokeAPIKeyActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := config.ID.ValueString()
+	tflog.Debug(ctx, "revoking api key", map[string]any{"id": id})
+	if err := a.client.RevokeAPIKey(ctx, id); err != nil {
+		resp.Diagnostics.AddError("Error Revoking API Key", fmt.Sprintf("Could not revoke API key %s: %s", id, err))
+		return
+	}
+	progress(resp, "Revoked API key %s", id)
+}
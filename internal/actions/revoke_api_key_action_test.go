@@ -0,0 +1,48 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+func TestRevokeAPIKeyAction_Invoke(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	ctx := context.Background()
+
+	created, err := c.CreateAPIKey(ctx, client.CreateAPIKeyRequest{Name: "ci", Scopes: []string{"catalog:read"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, messages := invoke(t, NewRevokeAPIKeyAction(), c, &RevokeAPIKeyActionModel{ID: types.StringValue(created.Key.ID)})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Invoke() error: %v", resp.Diagnostics)
+	}
+	if len(messages) != 1 {
+		t.Errorf("progress messages = %v, want one", messages)
+	}
+
+	key, err := c.GetAPIKey(ctx, created.Key.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.RevokedAt == "" {
+		t.Error("API key was not revoked")
+	}
+}
+
+func TestRevokeAPIKeyAction_Invoke_NotFound(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+
+	resp, _ := invoke(t, NewRevokeAPIKeyAction(), api.Client(), &RevokeAPIKeyActionModel{ID: types.StringValue("missing")})
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error revoking an unknown key")
+	}
+}
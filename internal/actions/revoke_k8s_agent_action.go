@@ -0,0 +1,65 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ action.ActionWithConfigure = &RevokeK8sAgentAction{}
+
+// RevokeK8sAgentAction revokes the token of a K8s agent.
+type RevokeK8sAgentAction struct {
+	client *client.Client
+}
+
+// RevokeK8sAgentActionModel describes the action configuration.
+type RevokeK8sAgentActionModel struct {
+	ClusterID types.String `tfsdk:"cluster_id"`
+}
+
+// NewRevokeK8sAgentAction creates a new revoke K8s agent action.
+func NewRevokeK8sAgentAction() action.Action {
+	return &RevokeK8sAgentAction{}
+}
+
+func (a *RevokeK8sAgentAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_revoke_k8s_agent"
+}
+
+func (a *RevokeK8sAgentAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Revokes the token of a Shoehorn K8s agent so the cluster can no longer report. A shoehorn_k8s_agent resource managing the agent removes it from state and plans a new registration.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Description: "The cluster ID of the agent to revoke.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *RevokeK8sAgentAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.client = configureClient(req, resp)
+}
+
+func (a *RevokeK8sAgentAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config RevokeK8sAgentActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := config.ClusterID.ValueString()
+	tflog.Debug(ctx, "revoking k8s agent", map[string]any{"cluster_id": clusterID})
+	if err := a.client.RevokeK8sAgent(ctx, clusterID); err != nil {
+		resp.Diagnostics.AddError("Error Revoking K8s Agent", fmt.Sprintf("Could not revoke K8s agent %s: %s", clusterID, err))
+		return
+	}
+	progress(resp, "Revoked K8s agent %s", clusterID)
+}
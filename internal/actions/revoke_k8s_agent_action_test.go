@@ -0,0 +1,34 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+func TestRevokeK8sAgentAction_Invoke(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	ctx := context.Background()
+
+	if _, err := c.RegisterK8sAgent(ctx, client.RegisterK8sAgentRequest{ClusterID: "prod-eu-1", Name: "Production EU"}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, _ := invoke(t, NewRevokeK8sAgentAction(), c, &RevokeK8sAgentActionModel{ClusterID: types.StringValue("prod-eu-1")})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Invoke() error: %v", resp.Diagnostics)
+	}
+
+	agent, err := c.GetK8sAgent(ctx, "prod-eu-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if agent.Status != "revoked" {
+		t.Errorf("Status = %q, want revoked", agent.Status)
+	}
+}
@@ -0,0 +1,77 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ action.ActionWithConfigure = &SyncIntegrationAction{}
+
+// SyncIntegrationAction triggers a sync of an integration.
+type SyncIntegrationAction struct {
+	client *client.Client
+}
+
+// SyncIntegrationActionModel describes the action configuration.
+type SyncIntegrationActionModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// NewSyncIntegrationAction creates a new sync integration action.
+func NewSyncIntegrationAction() action.Action {
+	return &SyncIntegrationAction{}
+}
+
+func (a *SyncIntegrationAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sync_integration"
+}
+
+func (a *SyncIntegrationAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Re-syncs a Shoehorn integration, e.g. after rotating its credentials. Fails if the integration reports a sync error.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the integration to sync, e.g. shoehorn_integration.github.id.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *SyncIntegrationAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.client = configureClient(req, resp)
+}
+
+func (a *SyncIntegrationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config SyncIntegrationActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(config.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid Integration ID", fmt.Sprintf("Integration ID must be a number, got %q.", config.ID.ValueString()))
+		return
+	}
+
+	tflog.Debug(ctx, "syncing integration", map[string]any{"id": id})
+	integration, err := a.client.SyncIntegration(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Syncing Integration", fmt.Sprintf("Could not sync integration %d: %s", id, err))
+		return
+	}
+	if integration.LastError != "" {
+		resp.Diagnostics.AddError("Integration Sync Failed", fmt.Sprintf("Integration %d (%s) reported: %s", id, integration.Name, integration.LastError))
+		return
+	}
+	progress(resp, "Synced integration %d (%s) at %s", id, integration.Name, integration.LastSyncAt)
+}
@@ -0,0 +1,55 @@
+package actions
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+func TestSyncIntegrationAction_Invoke(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	ctx := context.Background()
+
+	integration, err := c.CreateIntegration(ctx, client.CreateIntegrationRequest{Name: "GitHub", Type: "github"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := strconv.Itoa(integration.ID)
+
+	resp, _ := invoke(t, NewSyncIntegrationAction(), c, &SyncIntegrationActionModel{ID: types.StringValue(id)})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Invoke() error: %v", resp.Diagnostics)
+	}
+	synced, err := c.GetIntegration(ctx, integration.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if synced.LastSyncAt == "" {
+		t.Error("LastSyncAt was not set")
+	}
+
+	// Inactive integrations report a sync error.
+	if _, err := c.UpdateIntegration(ctx, integration.ID, client.UpdateIntegrationRequest{Status: "inactive"}); err != nil {
+		t.Fatal(err)
+	}
+	resp, _ = invoke(t, NewSyncIntegrationAction(), c, &SyncIntegrationActionModel{ID: types.StringValue(id)})
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected the sync error to be reported")
+	}
+}
+
+func TestSyncIntegrationAction_Invoke_InvalidID(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+
+	resp, _ := invoke(t, NewSyncIntegrationAction(), api.Client(), &SyncIntegrationActionModel{ID: types.StringValue("github")})
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for a non-numeric ID")
+	}
+}
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"time"
+)
+
+// Announcement is a scheduled announcement banner. It is shown between
+// StartsAt and EndsAt to the users in its audience; an empty bound leaves the
+// window open on that side and an empty audience means everyone.
+type Announcement struct {
+	ID          string               `json:"id"`
+	Message     string               `json:"message"`
+	Type        string               `json:"type,omitempty"`
+	LinkURL     string               `json:"link_url,omitempty"`
+	LinkText    string               `json:"link_text,omitempty"`
+	Dismissible bool                 `json:"dismissible"`
+	StartsAt    string               `json:"starts_at,omitempty"`
+	EndsAt      string               `json:"ends_at,omitempty"`
+	Audience    AnnouncementAudience `json:"audience"`
+	CreatedAt   string               `json:"created_at,omitempty"`
+	UpdatedAt   string               `json:"updated_at,omitempty"`
+}
+
+// AnnouncementAudience limits an announcement to members of the listed teams
+// or holders of the listed roles.
+type AnnouncementAudience struct {
+	Teams []string `json:"teams,omitempty"`
+	Roles []string `json:"roles,omitempty"`
+}
+
+// AnnouncementRequest is the request body for creating or replacing a
+// scheduled announcement.
+type AnnouncementRequest struct {
+	Message     string               `json:"message"`
+	Type        string               `json:"type,omitempty"`
+	LinkURL     string               `json:"link_url,omitempty"`
+	LinkText    string               `json:"link_text,omitempty"`
+	Dismissible bool                 `json:"dismissible"`
+	StartsAt    string               `json:"starts_at,omitempty"`
+	EndsAt      string               `json:"ends_at,omitempty"`
+	Audience    AnnouncementAudience `json:"audience"`
+}
+
+// announcementListResponse wraps the list announcements response.
+type announcementListResponse struct {
+	Items []Announcement `json:"items"`
+}
+
+// IsActive returns true if now falls within the announcement's window. The
+// window includes StartsAt and excludes EndsAt; bounds that are not valid
+// RFC 3339 timestamps are treated as unset.
+func (a *Announcement) IsActive(now time.Time) bool {
+	if start, err := time.Parse(time.RFC3339, a.StartsAt); err == nil && now.Before(start) {
+		return false
+	}
+	if end, err := time.Parse(time.RFC3339, a.EndsAt); err == nil && !now.Before(end) {
+		return false
+	}
+	return true
+}
+
+// AppliesTo returns true if the announcement is shown to a member of team
+// holding role. An announcement without an audience applies to everyone;
+// empty team and role match only such announcements.
+func (a *Announcement) AppliesTo(team, role string) bool {
+	if len(a.Audience.Teams) == 0 && len(a.Audience.Roles) == 0 {
+		return true
+	}
+	return (team != "" && slices.Contains(a.Audience.Teams, team)) ||
+		(role != "" && slices.Contains(a.Audience.Roles, role))
+}
+
+// ActiveAnnouncement returns the announcement shown at now to a member of team
+// holding role, or nil if none is. When several are active the one that
+// started most recently wins, so a maintenance banner overrides a standing
+// one for its duration. Pass empty team and role to ignore the audience.
+func ActiveAnnouncement(announcements []Announcement, now time.Time, team, role string) *Announcement {
+	var active []Announcement
+	for _, a := range announcements {
+		if !a.IsActive(now) {
+			continue
+		}
+		if (team != "" || role != "") && !a.AppliesTo(team, role) {
+			continue
+		}
+		active = append(active, a)
+	}
+	if len(active) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(active, func(i, j int) bool {
+		si, sj := announcementStart(active[i]), announcementStart(active[j])
+		if !si.Equal(sj) {
+			return si.After(sj)
+		}
+		return active[i].ID < active[j].ID
+	})
+	return &active[0]
+}
+
+// announcementStart returns when a is first shown; the zero time if it has no
+// start.
+func announcementStart(a Announcement) time.Time {
+	start, err := time.Parse(time.RFC3339, a.StartsAt)
+	if err != nil {
+		return time.Time{}
+	}
+	return start
+}
+
+// ListAnnouncements retrieves all scheduled announcements, including ones
+// whose window has passed or not yet started.
+func (c *Client) ListAnnouncements(ctx context.Context) ([]Announcement, error) {
+	body, err := c.Get(ctx, "/api/v1/admin/announcements")
+	if err != nil {
+		return nil, fmt.Errorf("list announcements: %w", err)
+	}
+
+	var resp announcementListResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("unmarshal announcements response: %w", err)
+	}
+
+	return resp.Items, nil
+}
+
+// GetAnnouncement retrieves a scheduled announcement by ID.
+func (c *Client) GetAnnouncement(ctx context.Context, id string) (*Announcement, error) {
+	body, err := c.Get(ctx, fmt.Sprintf("/api/v1/admin/announcements/%s", url.PathEscape(id)))
+	if err != nil {
+		return nil, fmt.Errorf("get announcement %s: %w", id, err)
+	}
+
+	var announcement Announcement
+	if err := json.Unmarshal(body, &announcement); err != nil {
+		return nil, fmt.Errorf("unmarshal announcement response: %w", err)
+	}
+
+	return &announcement, nil
+}
+
+// CreateAnnouncement schedules an announcement.
+func (c *Client) CreateAnnouncement(ctx context.Context, req AnnouncementRequest) (*Announcement, error) {
+	body, err := c.Post(ctx, "/api/v1/admin/announcements", req)
+	if err != nil {
+		return nil, fmt.Errorf("create announcement: %w", err)
+	}
+
+	var announcement Announcement
+	if err := json.Unmarshal(body, &announcement); err != nil {
+		return nil, fmt.Errorf("unmarshal create announcement response: %w", err)
+	}
+
+	return &announcement, nil
+}
+
+// UpdateAnnouncement replaces a scheduled announcement.
+func (c *Client) UpdateAnnouncement(ctx context.Context, id string, req AnnouncementRequest) (*Announcement, error) {
+	body, err := c.Put(ctx, fmt.Sprintf("/api/v1/admin/announcements/%s", url.PathEscape(id)), req)
+	if err != nil {
+		return nil, fmt.Errorf("update announcement %s: %w", id, err)
+	}
+
+	var announcement Announcement
+	if err := json.Unmarshal(body, &announcement); err != nil {
+		return nil, fmt.Errorf("unmarshal update announcement response: %w", err)
+	}
+
+	return &announcement, nil
+}
+
+// DeleteAnnouncement deletes a scheduled announcement by ID.
+func (c *Client) DeleteAnnouncement(ctx context.Context, id string) error {
+	if err := c.Delete(ctx, fmt.Sprintf("/api/v1/admin/announcements/%s", url.PathEscape(id))); err != nil {
+		return fmt.Errorf("delete announcement %s: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAnnouncement_IsActive(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		startsAt string
+		endsAt   string
+		want     bool
+	}{
+		{"open window", "", "", true},
+		{"started", "2026-03-01T00:00:00Z", "", true},
+		{"not started", "2026-03-02T00:00:00Z", "", false},
+		{"ended", "", "2026-03-01T12:00:00Z", false},
+		{"within window", "2026-03-01T11:00:00Z", "2026-03-01T13:00:00Z", true},
+		{"starts now", "2026-03-01T12:00:00Z", "2026-03-01T13:00:00Z", true},
+		{"other time zone", "2026-03-01T13:30:00+02:00", "", true},
+		{"invalid bound", "soon", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Announcement{StartsAt: tt.startsAt, EndsAt: tt.endsAt}
+			if got := a.IsActive(now); got != tt.want {
+				t.Errorf("IsActive() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnnouncement_AppliesTo(t *testing.T) {
+	everyone := Announcement{}
+	targeted := Announcement{Audience: AnnouncementAudience{Teams: []string{"platform"}, Roles: []string{"tenant:admin"}}}
+
+	if !everyone.AppliesTo("", "") || !everyone.AppliesTo("payments", "") {
+		t.Error("an announcement without an audience should apply to everyone")
+	}
+	if !targeted.AppliesTo("platform", "") || !targeted.AppliesTo("payments", "tenant:admin") {
+		t.Error("a targeted announcement should apply to its teams and roles")
+	}
+	if targeted.AppliesTo("payments", "member") || targeted.AppliesTo("", "") {
+		t.Error("a targeted announcement should not apply outside its audience")
+	}
+}
+
+func TestActiveAnnouncement(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	announcements := []Announcement{
+		{ID: "standing", Message: "Welcome"},
+		{ID: "maintenance", Message: "Maintenance", StartsAt: "2026-03-01T11:00:00Z", EndsAt: "2026-03-01T13:00:00Z"},
+		{ID: "upcoming", Message: "Upgrade", StartsAt: "2026-03-05T00:00:00Z"},
+		{ID: "platform", Message: "Platform only", StartsAt: "2026-03-01T11:30:00Z", Audience: AnnouncementAudience{Teams: []string{"platform"}}},
+	}
+
+	if got := ActiveAnnouncement(announcements, now, "", ""); got == nil || got.ID != "platform" {
+		t.Errorf("ActiveAnnouncement() = %+v, want the latest started", got)
+	}
+	if got := ActiveAnnouncement(announcements, now, "payments", ""); got == nil || got.ID != "maintenance" {
+		t.Errorf("ActiveAnnouncement(payments) = %+v, want maintenance", got)
+	}
+	if got := ActiveAnnouncement(announcements, now.Add(2*time.Hour), "payments", ""); got == nil || got.ID != "standing" {
+		t.Errorf("ActiveAnnouncement(after maintenance) = %+v, want standing", got)
+	}
+	if got := ActiveAnnouncement(announcements[2:3], now, "", ""); got != nil {
+		t.Errorf("ActiveAnnouncement(upcoming only) = %+v, want nil", got)
+	}
+}
+
+func TestAnnouncements_Calls(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/announcements":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"items": []map[string]interface{}{{"id": "ann-1", "message": "Maintenance", "starts_at": "2026-03-01T11:00:00Z", "dismissible": true}},
+			})
+		case r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": "ann-1", "message": "Maintenance", "audience": map[string]interface{}{"teams": []string{"platform"}}})
+		default:
+			var req AnnouncementRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request: %v", err)
+			}
+			if r.Method == http.MethodPost {
+				w.WriteHeader(http.StatusCreated)
+			}
+			json.NewEncoder(w).Encode(Announcement{ID: "ann-1", Message: req.Message, StartsAt: req.StartsAt, EndsAt: req.EndsAt, Dismissible: req.Dismissible})
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	ctx := context.Background()
+	req := AnnouncementRequest{Message: "Maintenance", StartsAt: "2026-03-01T11:00:00Z", EndsAt: "2026-03-01T13:00:00Z", Dismissible: true}
+
+	created, err := c.CreateAnnouncement(ctx, req)
+	if err != nil {
+		t.Fatalf("CreateAnnouncement() error = %v", err)
+	}
+	if created.ID != "ann-1" || created.EndsAt != req.EndsAt {
+		t.Errorf("created = %+v", created)
+	}
+	got, err := c.GetAnnouncement(ctx, "ann-1")
+	if err != nil {
+		t.Fatalf("GetAnnouncement() error = %v", err)
+	}
+	if len(got.Audience.Teams) != 1 || got.Audience.Teams[0] != "platform" {
+		t.Errorf("audience = %+v", got.Audience)
+	}
+	if _, err := c.UpdateAnnouncement(ctx, "ann-1", req); err != nil {
+		t.Fatalf("UpdateAnnouncement() error = %v", err)
+	}
+	list, err := c.ListAnnouncements(ctx)
+	if err != nil {
+		t.Fatalf("ListAnnouncements() error = %v", err)
+	}
+	if len(list) != 1 || !list[0].Dismissible {
+		t.Errorf("list = %+v", list)
+	}
+	if err := c.DeleteAnnouncement(ctx, "ann-1"); err != nil {
+		t.Fatalf("DeleteAnnouncement() error = %v", err)
+	}
+
+	want := []string{
+		"POST /api/v1/admin/announcements",
+		"GET /api/v1/admin/announcements/ann-1",
+		"PUT /api/v1/admin/announcements/ann-1",
+		"GET /api/v1/admin/announcements",
+		"DELETE /api/v1/admin/announcements/ann-1",
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("call %d = %q, want %q", i, calls[i], want[i])
+		}
+	}
+}
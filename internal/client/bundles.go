@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Bundle is a Shoehorn permission bundle: a named role that grants a set of
+// permissions and can be assigned to users and mapped to groups by name.
+type Bundle struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	DisplayName string   `json:"displayName"`
+	Description string   `json:"description,omitempty"`
+	Color       string   `json:"color,omitempty"`
+	Icon        string   `json:"icon,omitempty"`
+	Permissions []string `json:"permissions"`
+	System      bool     `json:"system,omitempty"`
+	CreatedAt   string   `json:"createdAt,omitempty"`
+	UpdatedAt   string   `json:"updatedAt,omitempty"`
+}
+
+// BundleRequest is the request body for creating or replacing a bundle.
+type BundleRequest struct {
+	Name        string   `json:"name"`
+	DisplayName string   `json:"displayName"`
+	Description string   `json:"description,omitempty"`
+	Color       string   `json:"color,omitempty"`
+	Icon        string   `json:"icon,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+// bundleListResponse wraps the list bundles response.
+type bundleListResponse struct {
+	Items []Bundle `json:"items"`
+}
+
+// ListBundles retrieves all permission bundles, including built-in ones.
+func (c *Client) ListBundles(ctx context.Context) ([]Bundle, error) {
+	body, err := c.Get(ctx, "/api/v1/admin/bundles")
+	if err != nil {
+		return nil, fmt.Errorf("list bundles: %w", err)
+	}
+
+	var resp bundleListResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("unmarshal bundles response: %w", err)
+	}
+
+	return resp.Items, nil
+}
+
+// GetBundle retrieves a permission bundle by name.
+func (c *Client) GetBundle(ctx context.Context, name string) (*Bundle, error) {
+	body, err := c.Get(ctx, fmt.Sprintf("/api/v1/admin/bundles/%s", url.PathEscape(name)))
+	if err != nil {
+		return nil, fmt.Errorf("get bundle %s: %w", name, err)
+	}
+
+	var bundle Bundle
+	if err := json.Unmarshal(body, &bundle); err != nil {
+		return nil, fmt.Errorf("unmarshal bundle response: %w", err)
+	}
+
+	return &bundle, nil
+}
+
+// CreateBundle creates a custom permission bundle.
+func (c *Client) CreateBundle(ctx context.Context, req BundleRequest) (*Bundle, error) {
+	body, err := c.Post(ctx, "/api/v1/admin/bundles", req)
+	if err != nil {
+		return nil, fmt.Errorf("create bundle: %w", err)
+	}
+
+	var bundle Bundle
+	if err := json.Unmarshal(body, &bundle); err != nil {
+		return nil, fmt.Errorf("unmarshal create bundle response: %w", err)
+	}
+
+	return &bundle, nil
+}
+
+// UpdateBundle replaces the display settings and permissions of a bundle.
+func (c *Client) UpdateBundle(ctx context.Context, name string, req BundleRequest) (*Bundle, error) {
+	body, err := c.Put(ctx, fmt.Sprintf("/api/v1/admin/bundles/%s", url.PathEscape(name)), req)
+	if err != nil {
+		return nil, fmt.Errorf("update bundle %s: %w", name, err)
+	}
+
+	var bundle Bundle
+	if err := json.Unmarshal(body, &bundle); err != nil {
+		return nil, fmt.Errorf("unmarshal update bundle response: %w", err)
+	}
+
+	return &bundle, nil
+}
+
+// DeleteBundle deletes a custom permission bundle by name.
+func (c *Client) DeleteBundle(ctx context.Context, name string) error {
+	if err := c.Delete(ctx, fmt.Sprintf("/api/v1/admin/bundles/%s", url.PathEscape(name))); err != nil {
+		return fmt.Errorf("delete bundle %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListBundles_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/admin/bundles" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"id": "b-1", "name": "tenant:admin", "displayName": "Admin", "permissions": []string{"*"}, "system": true},
+				{"id": "b-2", "name": "catalog-editor", "displayName": "Catalog Editor", "color": "#3b82f6", "icon": "book", "permissions": []string{"entity:read", "entity:write"}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	bundles, err := c.ListBundles(context.Background())
+	if err != nil {
+		t.Fatalf("ListBundles() error = %v", err)
+	}
+	if len(bundles) != 2 {
+		t.Fatalf("bundle count = %d, want 2", len(bundles))
+	}
+	if !bundles[0].System {
+		t.Error("System = false, want true for the built-in bundle")
+	}
+	if bundles[1].DisplayName != "Catalog Editor" || bundles[1].Color != "#3b82f6" || len(bundles[1].Permissions) != 2 {
+		t.Errorf("bundle = %+v", bundles[1])
+	}
+}
+
+func TestGetBundle_EscapesName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/v1/admin/bundles/org%2Fadmin" {
+			t.Errorf("path = %s, want the name path-escaped", r.URL.EscapedPath())
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": "b-1", "name": "org/admin", "displayName": "Admin"})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	bundle, err := c.GetBundle(context.Background(), "org/admin")
+	if err != nil {
+		t.Fatalf("GetBundle() error = %v", err)
+	}
+	if bundle.ID != "b-1" {
+		t.Errorf("ID = %q, want %q", bundle.ID, "b-1")
+	}
+}
+
+func TestGetBundle_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": "not_found", "message": "bundle not found"})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	_, err := c.GetBundle(context.Background(), "missing")
+	if !IsNotFound(err) {
+		t.Errorf("GetBundle() error = %v, want not found", err)
+	}
+}
+
+func TestCreateUpdateDeleteBundle(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		var req BundleRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request: %v", err)
+		}
+		status := http.StatusOK
+		if r.Method == http.MethodPost {
+			status = http.StatusCreated
+		}
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(Bundle{ID: "b-2", Name: req.Name, DisplayName: req.DisplayName, Permissions: req.Permissions})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	ctx := context.Background()
+	req := BundleRequest{Name: "catalog-editor", DisplayName: "Catalog Editor", Permissions: []string{"entity:read"}}
+
+	created, err := c.CreateBundle(ctx, req)
+	if err != nil {
+		t.Fatalf("CreateBundle() error = %v", err)
+	}
+	if created.ID != "b-2" || created.Name != "catalog-editor" {
+		t.Errorf("created = %+v", created)
+	}
+
+	req.Permissions = append(req.Permissions, "entity:write")
+	updated, err := c.UpdateBundle(ctx, "catalog-editor", req)
+	if err != nil {
+		t.Fatalf("UpdateBundle() error = %v", err)
+	}
+	if len(updated.Permissions) != 2 {
+		t.Errorf("permissions = %v, want 2", updated.Permissions)
+	}
+
+	if err := c.DeleteBundle(ctx, "catalog-editor"); err != nil {
+		t.Fatalf("DeleteBundle() error = %v", err)
+	}
+
+	want := []string{
+		"POST /api/v1/admin/bundles",
+		"PUT /api/v1/admin/bundles/catalog-editor",
+		"DELETE /api/v1/admin/bundles/catalog-editor",
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("call %d = %q, want %q", i, calls[i], want[i])
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -22,6 +23,13 @@ type Client struct {
 	APIKey     string
 	HTTPClient *http.Client
 	UserAgent  string
+
+	// References resolves references to other Shoehorn objects for plan-time
+	// validation. It is nil unless the provider enables validate_references.
+	References *References
+
+	directoryMu sync.Mutex
+	directory   *UserDirectory
 }
 
 // APIError represents an error response from the Shoehorn API. It captures the
@@ -55,6 +63,26 @@ func NewClient(baseURL, apiKey string, timeout time.Duration) *Client {
 	}
 }
 
+type ifMatchKey struct{}
+
+// WithIfMatch returns a copy of ctx under which write requests carry an
+// If-Match precondition on version, the updated_at (or ETag) of the object as
+// last read. The API then rejects the write with 412 Precondition Failed if the
+// object has changed since. An empty version adds no precondition.
+func WithIfMatch(ctx context.Context, version string) context.Context {
+	return context.WithValue(ctx, ifMatchKey{}, version)
+}
+
+// ifMatchHeader returns the If-Match header value for ctx, quoting a bare
+// version as an entity tag. It returns "" if ctx carries no precondition.
+func ifMatchHeader(ctx context.Context) string {
+	version, _ := ctx.Value(ifMatchKey{}).(string)
+	if version == "" || strings.HasPrefix(version, `"`) || strings.HasPrefix(version, `W/"`) {
+		return version
+	}
+	return `"` + version + `"`
+}
+
 // isRetryable returns true if the error is a transient connection error worth retrying.
 func isRetryable(err error) bool {
 	if err == nil {
@@ -116,6 +144,9 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("Accept", "application/json")
 		req.Header.Set("User-Agent", c.UserAgent)
+		if ifMatch := ifMatchHeader(ctx); ifMatch != "" && method != http.MethodGet {
+			req.Header.Set("If-Match", ifMatch)
+		}
 
 		resp, err := c.HTTPClient.Do(req)
 		if err != nil {
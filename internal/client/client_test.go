@@ -166,6 +166,29 @@ func TestClient_Put_SendsBody(t *testing.T) {
 	}
 }
 
+func TestClient_WithIfMatch_SetsHeaderOnWrites(t *testing.T) {
+	var gotIfMatch []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotIfMatch = append(gotIfMatch, r.Method+" "+r.Header.Get("If-Match"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	ctx := WithIfMatch(context.Background(), "2025-01-15T12:00:00Z")
+	_, _ = c.Get(ctx, "/api/v1/admin/teams/123")
+	_, _ = c.Put(ctx, "/api/v1/admin/teams/123", map[string]string{"name": "updated"})
+	_, _ = c.Put(WithIfMatch(context.Background(), `W/"abc"`), "/api/v1/admin/teams/123", nil)
+	_, _ = c.Put(WithIfMatch(context.Background(), ""), "/api/v1/admin/teams/123", nil)
+
+	want := []string{"GET ", `PUT "2025-01-15T12:00:00Z"`, `PUT W/"abc"`, "PUT "}
+	if fmt.Sprint(gotIfMatch) != fmt.Sprint(want) {
+		t.Errorf("If-Match headers = %q, want %q", gotIfMatch, want)
+	}
+}
+
 func TestClient_Delete_SendsRequest(t *testing.T) {
 	var gotMethod string
 	var gotPath string
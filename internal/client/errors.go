@@ -19,6 +19,21 @@ func IsAlreadyExists(err error) bool {
 	return false
 }
 
+// IsPreconditionFailed returns true if the error indicates that the object
+// changed since the version a request was conditioned on (HTTP 412), for
+// example an update sent with WithIfMatch. A 409 means the object already
+// exists; see IsAlreadyExists.
+func IsPreconditionFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == 412
+	}
+	return false
+}
+
 // IsNotFound returns true if the error indicates a resource was not found.
 // It unwraps error chains, so it works with errors wrapped via fmt.Errorf %w.
 // It checks for the ErrNotFound sentinel (used by list-and-filter methods)
@@ -128,3 +128,14 @@ func TestIsNotFound_HTTP404ViaAPIError(t *testing.T) {
 		t.Errorf("wrapped HTTP 404 error should satisfy IsNotFound, got: %v", wrapped)
 	}
 }
+
+func TestIsPreconditionFailed(t *testing.T) {
+	t.Parallel()
+	wrapped := fmt.Errorf("patch settings: %w", &APIError{StatusCode: 412, Code: "precondition_failed"})
+	if !IsPreconditionFailed(wrapped) {
+		t.Error("IsPreconditionFailed(wrapped APIError{412}) = false, want true")
+	}
+	if IsPreconditionFailed(&APIError{StatusCode: 409}) || IsPreconditionFailed(nil) {
+		t.Error("IsPreconditionFailed() = true for a non-412 error")
+	}
+}
@@ -5,19 +5,32 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"sort"
 )
 
 // ForgeApprovalPolicy represents a Shoehorn Forge approval policy.
 type ForgeApprovalPolicy struct {
-	ID               string         `json:"id"`
-	Name             string         `json:"name"`
-	Description      string         `json:"description,omitempty"`
-	Enabled          bool           `json:"enabled"`
-	Priority         int            `json:"priority,omitempty"`
-	ApprovalChain    []ApprovalStep `json:"approval_chain,omitempty"`
-	AutoApproveAfter int            `json:"auto_approve_after,omitempty"`
-	CreatedAt        string         `json:"created_at,omitempty"`
-	UpdatedAt        string         `json:"updated_at,omitempty"`
+	ID               string               `json:"id"`
+	Name             string               `json:"name"`
+	Description      string               `json:"description,omitempty"`
+	Enabled          bool                 `json:"enabled"`
+	Priority         int                  `json:"priority,omitempty"`
+	ApprovalChain    []ApprovalStep       `json:"approval_chain,omitempty"`
+	AutoApproveAfter int                  `json:"auto_approve_after,omitempty"`
+	Match            *ApprovalPolicyMatch `json:"match,omitempty"`
+	CreatedAt        string               `json:"created_at,omitempty"`
+	UpdatedAt        string               `json:"updated_at,omitempty"`
+}
+
+// ApprovalPolicyMatch scopes an approval policy to a subset of forge runs.
+// Empty criteria match everything, so a policy without a match block applies
+// to every mold. Within a criterion any listed value matches; across criteria
+// all non-empty criteria must match.
+type ApprovalPolicyMatch struct {
+	MoldSlugs  []string `json:"mold_slugs,omitempty"`
+	Categories []string `json:"categories,omitempty"`
+	Visibility []string `json:"visibility,omitempty"`
+	Teams      []string `json:"teams,omitempty"`
 }
 
 // ApprovalStep represents a single step in an approval policy workflow.
@@ -31,22 +44,24 @@ type ApprovalStep struct {
 
 // CreateApprovalPolicyRequest is the request body for creating a forge approval policy.
 type CreateApprovalPolicyRequest struct {
-	Name             string         `json:"name"`
-	Description      string         `json:"description,omitempty"`
-	Enabled          bool           `json:"enabled"`
-	Priority         int            `json:"priority,omitempty"`
-	ApprovalChain    []ApprovalStep `json:"approval_chain,omitempty"`
-	AutoApproveAfter int            `json:"auto_approve_after,omitempty"`
+	Name             string               `json:"name"`
+	Description      string               `json:"description,omitempty"`
+	Enabled          bool                 `json:"enabled"`
+	Priority         int                  `json:"priority,omitempty"`
+	ApprovalChain    []ApprovalStep       `json:"approval_chain,omitempty"`
+	AutoApproveAfter int                  `json:"auto_approve_after,omitempty"`
+	Match            *ApprovalPolicyMatch `json:"match,omitempty"`
 }
 
 // UpdateApprovalPolicyRequest is the request body for updating a forge approval policy.
 type UpdateApprovalPolicyRequest struct {
-	Name             string         `json:"name,omitempty"`
-	Description      string         `json:"description,omitempty"`
-	Enabled          *bool          `json:"enabled,omitempty"`
-	Priority         *int           `json:"priority,omitempty"`
-	ApprovalChain    []ApprovalStep `json:"approval_chain,omitempty"`
-	AutoApproveAfter *int           `json:"auto_approve_after,omitempty"`
+	Name             string               `json:"name,omitempty"`
+	Description      string               `json:"description,omitempty"`
+	Enabled          *bool                `json:"enabled,omitempty"`
+	Priority         *int                 `json:"priority,omitempty"`
+	ApprovalChain    []ApprovalStep       `json:"approval_chain,omitempty"`
+	AutoApproveAfter *int                 `json:"auto_approve_after,omitempty"`
+	Match            *ApprovalPolicyMatch `json:"match,omitempty"`
 }
 
 // approvalPolicyResponse wraps a single approval policy response.
@@ -126,3 +141,54 @@ func (c *Client) DeleteApprovalPolicy(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+// Matches reports whether the policy applies to a run of the given mold
+// requested by the given team. An empty team only matches policies that do
+// not restrict the requesting team.
+func (p *ForgeApprovalPolicy) Matches(mold *ForgeMold, team string) bool {
+	if p.Match == nil {
+		return true
+	}
+	return matchesAny(p.Match.MoldSlugs, mold.Slug) &&
+		matchesAny(p.Match.Categories, mold.Category) &&
+		matchesAny(p.Match.Visibility, mold.Visibility) &&
+		matchesAny(p.Match.Teams, team)
+}
+
+// matchesAny returns true if values is empty or contains v.
+func matchesAny(values []string, v string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, candidate := range values {
+		if candidate == v {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveApprovalPolicy returns the enabled policy that governs a run of the
+// given mold by the given team, or nil if no policy applies. When several
+// policies match, the one with the highest Priority wins; ties are broken by
+// name so the result is deterministic.
+func ResolveApprovalPolicy(policies []ForgeApprovalPolicy, mold *ForgeMold, team string) *ForgeApprovalPolicy {
+	var candidates []ForgeApprovalPolicy
+	for _, p := range policies {
+		if p.Enabled && p.Matches(mold, team) {
+			candidates = append(candidates, p)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Priority != candidates[j].Priority {
+			return candidates[i].Priority > candidates[j].Priority
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	return &candidates[0]
+}
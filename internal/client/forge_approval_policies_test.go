@@ -498,3 +498,119 @@ func TestApprovalPolicyClient_Lifecycle(t *testing.T) {
 		t.Errorf("VERIFY DELETE: expected IsNotFound, got: %v", err)
 	}
 }
+
+func TestCreateApprovalPolicy_SendsMatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req CreateApprovalPolicyRequest
+		json.Unmarshal(body, &req)
+
+		if req.Match == nil {
+			t.Fatal("Match = nil, want non-nil")
+		}
+		if len(req.Match.MoldSlugs) != 1 || req.Match.MoldSlugs[0] != "go-service" {
+			t.Errorf("Match.MoldSlugs = %v, want [go-service]", req.Match.MoldSlugs)
+		}
+		if len(req.Match.Teams) != 1 || req.Match.Teams[0] != "payments" {
+			t.Errorf("Match.Teams = %v, want [payments]", req.Match.Teams)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"policy": map[string]interface{}{
+				"id": "ap-new", "name": req.Name, "enabled": true,
+				"match": map[string]interface{}{"mold_slugs": req.Match.MoldSlugs, "teams": req.Match.Teams},
+			},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	policy, err := c.CreateApprovalPolicy(context.Background(), CreateApprovalPolicyRequest{
+		Name:    "Payments Services",
+		Enabled: true,
+		Match:   &ApprovalPolicyMatch{MoldSlugs: []string{"go-service"}, Teams: []string{"payments"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateApprovalPolicy() error = %v", err)
+	}
+	if policy.Match == nil || len(policy.Match.MoldSlugs) != 1 {
+		t.Fatalf("Match = %+v, want mold_slugs populated", policy.Match)
+	}
+}
+
+func TestForgeApprovalPolicy_Matches(t *testing.T) {
+	mold := &ForgeMold{Slug: "go-service", Category: "backend", Visibility: "tenant"}
+
+	tests := []struct {
+		name  string
+		match *ApprovalPolicyMatch
+		team  string
+		want  bool
+	}{
+		{name: "nil match applies to everything", match: nil, want: true},
+		{name: "empty match applies to everything", match: &ApprovalPolicyMatch{}, want: true},
+		{name: "matching slug", match: &ApprovalPolicyMatch{MoldSlugs: []string{"node-service", "go-service"}}, want: true},
+		{name: "non-matching slug", match: &ApprovalPolicyMatch{MoldSlugs: []string{"node-service"}}, want: false},
+		{name: "matching category", match: &ApprovalPolicyMatch{Categories: []string{"backend"}}, want: true},
+		{name: "non-matching visibility", match: &ApprovalPolicyMatch{Visibility: []string{"public"}}, want: false},
+		{name: "matching team", match: &ApprovalPolicyMatch{Teams: []string{"payments"}}, team: "payments", want: true},
+		{name: "team restricted but no team given", match: &ApprovalPolicyMatch{Teams: []string{"payments"}}, want: false},
+		{
+			name:  "all criteria must match",
+			match: &ApprovalPolicyMatch{MoldSlugs: []string{"go-service"}, Categories: []string{"frontend"}},
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := ForgeApprovalPolicy{Match: tt.match}
+			if got := p.Matches(mold, tt.team); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveApprovalPolicy(t *testing.T) {
+	mold := &ForgeMold{Slug: "go-service", Category: "backend", Visibility: "tenant"}
+	policies := []ForgeApprovalPolicy{
+		{ID: "ap-default", Name: "Default", Enabled: true, Priority: 0},
+		{ID: "ap-backend", Name: "Backend", Enabled: true, Priority: 10, Match: &ApprovalPolicyMatch{Categories: []string{"backend"}}},
+		{ID: "ap-disabled", Name: "Disabled", Enabled: false, Priority: 100},
+		{ID: "ap-frontend", Name: "Frontend", Enabled: true, Priority: 50, Match: &ApprovalPolicyMatch{Categories: []string{"frontend"}}},
+	}
+
+	got := ResolveApprovalPolicy(policies, mold, "")
+	if got == nil {
+		t.Fatal("ResolveApprovalPolicy() = nil, want policy")
+	}
+	if got.ID != "ap-backend" {
+		t.Errorf("ResolveApprovalPolicy() ID = %q, want %q", got.ID, "ap-backend")
+	}
+}
+
+func TestResolveApprovalPolicy_TieBrokenByName(t *testing.T) {
+	mold := &ForgeMold{Slug: "go-service"}
+	policies := []ForgeApprovalPolicy{
+		{ID: "ap-b", Name: "Bravo", Enabled: true, Priority: 5},
+		{ID: "ap-a", Name: "Alpha", Enabled: true, Priority: 5},
+	}
+
+	got := ResolveApprovalPolicy(policies, mold, "")
+	if got == nil || got.ID != "ap-a" {
+		t.Errorf("ResolveApprovalPolicy() = %+v, want ap-a", got)
+	}
+}
+
+func TestResolveApprovalPolicy_NoMatch(t *testing.T) {
+	mold := &ForgeMold{Slug: "go-service"}
+	policies := []ForgeApprovalPolicy{
+		{ID: "ap-1", Name: "Other", Enabled: true, Match: &ApprovalPolicyMatch{MoldSlugs: []string{"other"}}},
+	}
+
+	if got := ResolveApprovalPolicy(policies, mold, ""); got != nil {
+		t.Errorf("ResolveApprovalPolicy() = %+v, want nil", got)
+	}
+}
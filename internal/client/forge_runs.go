@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Forge run statuses reported by the API.
+const (
+	ForgeRunStatusPendingApproval = "pending_approval"
+	ForgeRunStatusApproved        = "approved"
+	ForgeRunStatusRunning         = "running"
+	ForgeRunStatusSucceeded       = "succeeded"
+	ForgeRunStatusFailed          = "failed"
+	ForgeRunStatusRejected        = "rejected"
+	ForgeRunStatusCancelled       = "cancelled"
+)
+
+// ForgeRun represents an execution of a Forge mold.
+type ForgeRun struct {
+	ID               string                 `json:"id"`
+	MoldSlug         string                 `json:"mold_slug"`
+	MoldVersion      string                 `json:"mold_version"`
+	Team             string                 `json:"team,omitempty"`
+	Status           string                 `json:"status"`
+	Inputs           map[string]interface{} `json:"inputs,omitempty"`
+	Outputs          map[string]interface{} `json:"outputs,omitempty"`
+	ApprovalPolicyID string                 `json:"approval_policy_id,omitempty"`
+	Error            string                 `json:"error,omitempty"`
+	RequestedBy      string                 `json:"requested_by,omitempty"`
+	CreatedAt        string                 `json:"created_at,omitempty"`
+	UpdatedAt        string                 `json:"updated_at,omitempty"`
+	CompletedAt      string                 `json:"completed_at,omitempty"`
+}
+
+// IsTerminal returns true if the run has finished and its status will not change.
+func (r *ForgeRun) IsTerminal() bool {
+	switch r.Status {
+	case ForgeRunStatusSucceeded, ForgeRunStatusFailed, ForgeRunStatusRejected, ForgeRunStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// CreateForgeRunRequest is the request body for triggering a forge mold run.
+type CreateForgeRunRequest struct {
+	MoldSlug string                 `json:"mold_slug"`
+	Version  string                 `json:"version,omitempty"`
+	Team     string                 `json:"team,omitempty"`
+	Inputs   map[string]interface{} `json:"inputs,omitempty"`
+}
+
+// forgeRunResponse wraps a single run response.
+type forgeRunResponse struct {
+	Run ForgeRun `json:"run"`
+}
+
+// CreateForgeRun triggers a new run of a forge mold.
+func (c *Client) CreateForgeRun(ctx context.Context, req CreateForgeRunRequest) (*ForgeRun, error) {
+	body, err := c.Post(ctx, "/api/v1/forge/runs", req)
+	if err != nil {
+		return nil, fmt.Errorf("create forge run for mold %s: %w", req.MoldSlug, err)
+	}
+
+	var resp forgeRunResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("unmarshal create forge run response: %w", err)
+	}
+
+	return &resp.Run, nil
+}
+
+// GetForgeRun retrieves a forge run by ID.
+func (c *Client) GetForgeRun(ctx context.Context, id string) (*ForgeRun, error) {
+	body, err := c.Get(ctx, fmt.Sprintf("/api/v1/forge/runs/%s", url.PathEscape(id)))
+	if err != nil {
+		return nil, fmt.Errorf("get forge run %s: %w", id, err)
+	}
+
+	var resp forgeRunResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("unmarshal forge run response: %w", err)
+	}
+
+	return &resp.Run, nil
+}
+
+// CancelForgeRun cancels a forge run that has not finished yet.
+func (c *Client) CancelForgeRun(ctx context.Context, id string) error {
+	if _, err := c.Post(ctx, fmt.Sprintf("/api/v1/forge/runs/%s/cancel", url.PathEscape(id)), nil); err != nil {
+		return fmt.Errorf("cancel forge run %s: %w", id, err)
+	}
+	return nil
+}
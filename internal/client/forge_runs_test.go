@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCreateForgeRun_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/forge/runs" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var req CreateForgeRunRequest
+		json.Unmarshal(body, &req)
+
+		if req.MoldSlug != "go-service" {
+			t.Errorf("MoldSlug = %q, want %q", req.MoldSlug, "go-service")
+		}
+		if req.Version != "1.2.0" {
+			t.Errorf("Version = %q, want %q", req.Version, "1.2.0")
+		}
+		if req.Inputs["name"] != "payments-api" {
+			t.Errorf("Inputs[name] = %v, want %q", req.Inputs["name"], "payments-api")
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"run": map[string]interface{}{
+				"id": "run-1", "mold_slug": req.MoldSlug, "mold_version": req.Version,
+				"status": "pending_approval", "inputs": req.Inputs,
+				"approval_policy_id": "ap-1",
+			},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	run, err := c.CreateForgeRun(context.Background(), CreateForgeRunRequest{
+		MoldSlug: "go-service",
+		Version:  "1.2.0",
+		Inputs:   map[string]interface{}{"name": "payments-api"},
+	})
+	if err != nil {
+		t.Fatalf("CreateForgeRun() error = %v", err)
+	}
+	if run.ID != "run-1" {
+		t.Errorf("ID = %q, want %q", run.ID, "run-1")
+	}
+	if run.Status != ForgeRunStatusPendingApproval {
+		t.Errorf("Status = %q, want %q", run.Status, ForgeRunStatusPendingApproval)
+	}
+	if run.ApprovalPolicyID != "ap-1" {
+		t.Errorf("ApprovalPolicyID = %q, want %q", run.ApprovalPolicyID, "ap-1")
+	}
+	if run.IsTerminal() {
+		t.Error("IsTerminal() = true, want false for pending run")
+	}
+}
+
+func TestGetForgeRun_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/forge/runs/run-1" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"run": map[string]interface{}{
+				"id": "run-1", "mold_slug": "go-service", "mold_version": "1.2.0",
+				"status": "succeeded",
+				"outputs": map[string]interface{}{
+					"repo_url":  "https://github.com/acme/payments-api",
+					"entity_id": "service:payments-api",
+				},
+				"completed_at": "2025-06-10T10:05:00Z",
+			},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	run, err := c.GetForgeRun(context.Background(), "run-1")
+	if err != nil {
+		t.Fatalf("GetForgeRun() error = %v", err)
+	}
+	if !run.IsTerminal() {
+		t.Error("IsTerminal() = false, want true for succeeded run")
+	}
+	if run.Outputs["repo_url"] != "https://github.com/acme/payments-api" {
+		t.Errorf("Outputs[repo_url] = %v, want repo URL", run.Outputs["repo_url"])
+	}
+}
+
+func TestGetForgeRun_NotFound(t *testing.T) {
+	c := setupClientWith404Server(t)
+
+	_, err := c.GetForgeRun(testCtx(), "missing")
+	if !IsNotFound(err) {
+		t.Errorf("expected IsNotFound, got: %v", err)
+	}
+}
+
+func TestCancelForgeRun_Success(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/forge/runs/run-1/cancel" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{"message": "cancelled"})
+	})
+
+	c := setupClientWithServer(server)
+	if err := c.CancelForgeRun(testCtx(), "run-1"); err != nil {
+		t.Fatalf("CancelForgeRun() error = %v", err)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
 // GitOpsResource represents a GitOps resource (Application, Kustomization, HelmRelease, etc.)
@@ -33,6 +35,16 @@ type GitOpsResource struct {
 	UpdatedAt      string `json:"updated_at,omitempty"`
 }
 
+// IsSynced returns true if the resource reports it is in sync with its source.
+func (r *GitOpsResource) IsSynced() bool {
+	return strings.EqualFold(r.SyncStatus, "synced")
+}
+
+// IsHealthy returns true if the resource reports a healthy rollout.
+func (r *GitOpsResource) IsHealthy() bool {
+	return strings.EqualFold(r.HealthStatus, "healthy")
+}
+
 // GitOpsStats represents aggregate statistics for GitOps resources.
 type GitOpsStats struct {
 	Total     int `json:"total"`
@@ -49,8 +61,32 @@ type ListGitOpsResourcesParams struct {
 	Tool         string
 	SyncStatus   string
 	HealthStatus string
+	EntityID     string
+	OwnerTeam    string
+	Namespace    string
+	Kind         string
+	Suspended    *bool
+	AutoSync     *bool
 }
 
+// matches reports whether res satisfies the filters that the API applies
+// server-side. It guards against API versions that ignore newer filters.
+func (p ListGitOpsResourcesParams) matches(res *GitOpsResource) bool {
+	switch {
+	case p.EntityID != "" && res.EntityID != p.EntityID,
+		p.OwnerTeam != "" && res.OwnerTeam != p.OwnerTeam,
+		p.Namespace != "" && res.Namespace != p.Namespace,
+		p.Kind != "" && !strings.EqualFold(res.Kind, p.Kind),
+		p.Suspended != nil && res.Suspended != *p.Suspended,
+		p.AutoSync != nil && res.AutoSync != *p.AutoSync:
+		return false
+	}
+	return true
+}
+
+// gitOpsPageSize is the number of resources requested per page.
+const gitOpsPageSize = 100
+
 // gitOpsListResponse wraps the list response from /api/v1/operations/gitops.
 type gitOpsListResponse struct {
 	Resources []GitOpsResource `json:"resources"`
@@ -62,10 +98,10 @@ type gitOpsResourceResponse struct {
 	Resource GitOpsResource `json:"resource"`
 }
 
-// ListGitOpsResources retrieves GitOps resources with optional filters.
+// ListGitOpsResources retrieves all GitOps resources matching the filters,
+// following offset-based pagination. The returned total is the number of
+// matching resources.
 func (c *Client) ListGitOpsResources(ctx context.Context, params ListGitOpsResourcesParams) ([]GitOpsResource, int, error) {
-	path := "/api/v1/operations/gitops"
-
 	q := url.Values{}
 	if params.ClusterID != "" {
 		q.Set("cluster_id", params.ClusterID)
@@ -79,21 +115,64 @@ func (c *Client) ListGitOpsResources(ctx context.Context, params ListGitOpsResou
 	if params.HealthStatus != "" {
 		q.Set("health_status", params.HealthStatus)
 	}
-	if encoded := q.Encode(); encoded != "" {
-		path += "?" + encoded
+	if params.EntityID != "" {
+		q.Set("entity_id", params.EntityID)
 	}
-
-	body, err := c.Get(ctx, path)
-	if err != nil {
-		return nil, 0, fmt.Errorf("list gitops resources: %w", err)
+	if params.OwnerTeam != "" {
+		q.Set("owner_team", params.OwnerTeam)
 	}
-
-	var resp gitOpsListResponse
-	if err := json.Unmarshal(body, &resp); err != nil {
-		return nil, 0, fmt.Errorf("unmarshal gitops resources response: %w", err)
+	if params.Namespace != "" {
+		q.Set("namespace", params.Namespace)
+	}
+	if params.Kind != "" {
+		q.Set("kind", params.Kind)
+	}
+	if params.Suspended != nil {
+		q.Set("suspended", strconv.FormatBool(*params.Suspended))
+	}
+	if params.AutoSync != nil {
+		q.Set("auto_sync", strconv.FormatBool(*params.AutoSync))
+	}
+	q.Set("limit", strconv.Itoa(gitOpsPageSize))
+
+	resources := []GitOpsResource{}
+	seen := map[string]bool{}
+	for offset := 0; ; {
+		q.Set("offset", strconv.Itoa(offset))
+		body, err := c.Get(ctx, "/api/v1/operations/gitops?"+q.Encode())
+		if err != nil {
+			return nil, 0, fmt.Errorf("list gitops resources: %w", err)
+		}
+
+		var resp gitOpsListResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, 0, fmt.Errorf("unmarshal gitops resources response: %w", err)
+		}
+
+		added := 0
+		for i := range resp.Resources {
+			res := resp.Resources[i]
+			if res.ID != "" {
+				if seen[res.ID] {
+					continue
+				}
+				seen[res.ID] = true
+			}
+			added++
+			if params.matches(&res) {
+				resources = append(resources, res)
+			}
+		}
+
+		// Stop on the last page, or if the API ignores the offset and repeats
+		// itself. Total is only trusted when the API reports it.
+		offset += len(resp.Resources)
+		if added == 0 || len(resp.Resources) < gitOpsPageSize || (resp.Total > 0 && offset >= resp.Total) {
+			break
+		}
 	}
 
-	return resp.Resources, resp.Total, nil
+	return resources, len(resources), nil
 }
 
 // GetGitOpsResource retrieves a single GitOps resource by ID.
@@ -125,7 +204,18 @@ func (c *Client) GetGitOpsResource(ctx context.Context, id string) (*GitOpsResou
 
 // GetGitOpsStats retrieves aggregate GitOps statistics.
 func (c *Client) GetGitOpsStats(ctx context.Context) (*GitOpsStats, error) {
-	body, err := c.Get(ctx, "/api/v1/operations/gitops/stats")
+	return c.GetGitOpsClusterStats(ctx, "")
+}
+
+// GetGitOpsClusterStats retrieves aggregate GitOps statistics for a single
+// cluster. An empty clusterID returns statistics across all clusters.
+func (c *Client) GetGitOpsClusterStats(ctx context.Context, clusterID string) (*GitOpsStats, error) {
+	path := "/api/v1/operations/gitops/stats"
+	if clusterID != "" {
+		path += "?cluster_id=" + url.QueryEscape(clusterID)
+	}
+
+	body, err := c.Get(ctx, path)
 	if err != nil {
 		return nil, fmt.Errorf("get gitops stats: %w", err)
 	}
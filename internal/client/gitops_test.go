@@ -409,3 +409,145 @@ func TestGetGitOpsStats_ServerError(t *testing.T) {
 		t.Fatal("GetGitOpsStats() expected error for 500 response, got nil")
 	}
 }
+
+func TestListGitOpsResources_EntityFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		want := map[string]string{
+			"entity_id": "service:payments", "owner_team": "payments", "namespace": "argocd",
+			"kind": "Application", "suspended": "false", "auto_sync": "true",
+		}
+		for k, v := range want {
+			if got := query.Get(k); got != v {
+				t.Errorf("%s = %q, want %q", k, got, v)
+			}
+		}
+
+		// An API that ignores the filters still only yields matching resources.
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"resources": []map[string]interface{}{
+				{"id": "res-1", "entity_id": "service:payments", "owner_team": "payments", "namespace": "argocd", "kind": "Application", "auto_sync": true},
+				{"id": "res-2", "entity_id": "service:search", "owner_team": "search", "namespace": "argocd", "kind": "Application", "auto_sync": true},
+			},
+			"total": 2,
+		})
+	}))
+	defer server.Close()
+
+	suspended, autoSync := false, true
+	c := NewClient(server.URL, "key", 30*time.Second)
+	resources, total, err := c.ListGitOpsResources(context.Background(), ListGitOpsResourcesParams{
+		EntityID:  "service:payments",
+		OwnerTeam: "payments",
+		Namespace: "argocd",
+		Kind:      "Application",
+		Suspended: &suspended,
+		AutoSync:  &autoSync,
+	})
+	if err != nil {
+		t.Fatalf("ListGitOpsResources() error = %v", err)
+	}
+	if total != 1 || len(resources) != 1 || resources[0].ID != "res-1" {
+		t.Errorf("ListGitOpsResources() = %+v (total %d), want only res-1", resources, total)
+	}
+}
+
+func TestListGitOpsResources_Paginates(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset := 0
+		fmt.Sscan(r.URL.Query().Get("offset"), &offset)
+		if r.URL.Query().Get("limit") != "100" {
+			t.Errorf("limit = %q, want 100", r.URL.Query().Get("limit"))
+		}
+
+		page := []map[string]interface{}{}
+		for i := offset; i < 230 && i < offset+100; i++ {
+			page = append(page, map[string]interface{}{"id": fmt.Sprintf("res-%03d", i)})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"resources": page, "total": 230})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	resources, total, err := c.ListGitOpsResources(context.Background(), ListGitOpsResourcesParams{})
+	if err != nil {
+		t.Fatalf("ListGitOpsResources() error = %v", err)
+	}
+	if total != 230 || len(resources) != 230 {
+		t.Errorf("got %d resources (total %d), want 230", len(resources), total)
+	}
+	if requests != 3 {
+		t.Errorf("requests = %d, want 3", requests)
+	}
+	if resources[229].ID != "res-229" {
+		t.Errorf("last ID = %q, want res-229", resources[229].ID)
+	}
+}
+
+func TestListGitOpsResources_PaginatesWithoutTotal(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset := 0
+		fmt.Sscan(r.URL.Query().Get("offset"), &offset)
+
+		page := []map[string]interface{}{}
+		for i := offset; i < 230 && i < offset+100; i++ {
+			page = append(page, map[string]interface{}{"id": fmt.Sprintf("res-%03d", i)})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"resources": page})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	resources, _, err := c.ListGitOpsResources(context.Background(), ListGitOpsResourcesParams{})
+	if err != nil {
+		t.Fatalf("ListGitOpsResources() error = %v", err)
+	}
+	if len(resources) != 230 || requests != 3 {
+		t.Errorf("got %d resources in %d requests, want 230 in 3", len(resources), requests)
+	}
+}
+
+func TestListGitOpsResources_StopsWhenOffsetIgnored(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page := []map[string]interface{}{}
+		for i := 0; i < 100; i++ {
+			page = append(page, map[string]interface{}{"id": fmt.Sprintf("res-%03d", i)})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"resources": page, "total": 500})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	resources, _, err := c.ListGitOpsResources(context.Background(), ListGitOpsResourcesParams{})
+	if err != nil {
+		t.Fatalf("ListGitOpsResources() error = %v", err)
+	}
+	if len(resources) != 100 || requests != 2 {
+		t.Errorf("got %d resources in %d requests, want 100 in 2", len(resources), requests)
+	}
+}
+
+func TestGetGitOpsClusterStats_SendsClusterID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/operations/gitops/stats" || r.URL.Query().Get("cluster_id") != "prod-east" {
+			t.Errorf("unexpected request: %s", r.URL.String())
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"total": 4, "synced": 3, "failed": 1})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	stats, err := c.GetGitOpsClusterStats(context.Background(), "prod-east")
+	if err != nil {
+		t.Fatalf("GetGitOpsClusterStats() error = %v", err)
+	}
+	if stats.Total != 4 || stats.Synced != 3 || stats.Failed != 1 {
+		t.Errorf("stats = %+v", stats)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"time"
 )
 
 // GovernanceAction represents a governance action item in the Shoehorn platform.
@@ -25,6 +26,99 @@ type GovernanceAction struct {
 	CreatedBy      string `json:"created_by,omitempty"`
 	CreatedAt      string `json:"created_at,omitempty"`
 	UpdatedAt      string `json:"updated_at,omitempty"`
+
+	History []GovernanceStatusChange `json:"history,omitempty"`
+}
+
+// GovernanceStatusChange records one status transition of a governance action.
+type GovernanceStatusChange struct {
+	Status    string `json:"status"`
+	Actor     string `json:"actor,omitempty"`
+	Note      string `json:"note,omitempty"`
+	ChangedAt string `json:"changed_at"`
+}
+
+// Governance action statuses reported by the API.
+const (
+	GovernanceStatusOpen       = "open"
+	GovernanceStatusInProgress = "in_progress"
+	GovernanceStatusResolved   = "resolved"
+	GovernanceStatusDismissed  = "dismissed"
+	GovernanceStatusWontFix    = "wont_fix"
+)
+
+// governanceTransitions lists the statuses a governance action may move to
+// from each status. Work is started before it is resolved; triage may dismiss
+// or decline an action at any point before then, and closed actions can only
+// be reopened.
+var governanceTransitions = map[string][]string{
+	GovernanceStatusOpen:       {GovernanceStatusInProgress, GovernanceStatusDismissed, GovernanceStatusWontFix},
+	GovernanceStatusInProgress: {GovernanceStatusOpen, GovernanceStatusResolved, GovernanceStatusDismissed, GovernanceStatusWontFix},
+	GovernanceStatusResolved:   {GovernanceStatusOpen},
+	GovernanceStatusDismissed:  {GovernanceStatusOpen},
+	GovernanceStatusWontFix:    {GovernanceStatusOpen},
+}
+
+// GovernanceStatusTransitions returns the statuses an action in the given status may move to.
+func GovernanceStatusTransitions(from string) []string {
+	return governanceTransitions[from]
+}
+
+// ValidGovernanceTransition reports whether an action may move from one status
+// to another. Staying in the same status is always allowed.
+func ValidGovernanceTransition(from, to string) bool {
+	if from == to {
+		return true
+	}
+	for _, next := range governanceTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsClosedGovernanceStatus returns true for resolved, dismissed and won't fix.
+// Moving an action into a closed status requires a resolution note.
+func IsClosedGovernanceStatus(status string) bool {
+	switch status {
+	case GovernanceStatusResolved, GovernanceStatusDismissed, GovernanceStatusWontFix:
+		return true
+	}
+	return false
+}
+
+// IsClosed returns true if the action has been resolved, dismissed or marked won't fix.
+func (a *GovernanceAction) IsClosed() bool {
+	return IsClosedGovernanceStatus(a.Status)
+}
+
+// DueAt returns when the action is due. The API's due_date is used when set;
+// otherwise the deadline is derived from created_at plus sla_days. The second
+// return value is false when neither is available.
+func (a *GovernanceAction) DueAt() (time.Time, bool) {
+	if a.DueDate != "" {
+		for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+			if due, err := time.Parse(layout, a.DueDate); err == nil {
+				return due, true
+			}
+		}
+	}
+	if a.SLADays != nil && a.CreatedAt != "" {
+		if created, err := time.Parse(time.RFC3339, a.CreatedAt); err == nil {
+			return created.AddDate(0, 0, *a.SLADays), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// IsOverdue returns true if the action is still open past its due date.
+func (a *GovernanceAction) IsOverdue(now time.Time) bool {
+	if a.IsClosed() {
+		return false
+	}
+	due, ok := a.DueAt()
+	return ok && now.After(due)
 }
 
 // CreateGovernanceActionRequest is the request body for creating a governance action.
@@ -47,9 +141,16 @@ type UpdateGovernanceActionRequest struct {
 	Priority       *string `json:"priority,omitempty"`
 	AssignedTo     *string `json:"assigned_to,omitempty"`
 	DueDate        *string `json:"due_date,omitempty"`
+	SLADays        *int    `json:"sla_days,omitempty"`
 	ResolutionNote *string `json:"resolution_note,omitempty"`
 }
 
+// IsEmpty returns true if the request would not change any field.
+func (r UpdateGovernanceActionRequest) IsEmpty() bool {
+	return r.Status == nil && r.Priority == nil && r.AssignedTo == nil &&
+		r.DueDate == nil && r.SLADays == nil && r.ResolutionNote == nil
+}
+
 // GovernanceActionFilters defines optional query parameters for listing governance actions.
 type GovernanceActionFilters struct {
 	EntityID   string
@@ -59,6 +160,15 @@ type GovernanceActionFilters struct {
 	Overdue    *bool
 }
 
+// GovernanceSummary holds the action counts reported alongside a governance
+// action list.
+type GovernanceSummary struct {
+	Total        int
+	ByStatus     map[string]int
+	ByPriority   map[string]int
+	BySourceType map[string]int
+}
+
 // governanceActionListResponse wraps the list governance actions API response.
 type governanceActionListResponse struct {
 	Actions []GovernanceAction     `json:"actions"`
@@ -74,6 +184,49 @@ type governanceActionCreateResponse struct {
 
 // ListGovernanceActions retrieves governance actions with optional filters.
 func (c *Client) ListGovernanceActions(ctx context.Context, filters *GovernanceActionFilters) ([]GovernanceAction, int, error) {
+	resp, err := c.listGovernanceActions(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp.Actions, resp.Total, nil
+}
+
+// ListGovernanceActionsWithSummary retrieves governance actions together with
+// the summary counts returned by the API. Breakdowns the API omits from its
+// summary are tallied from the returned actions.
+func (c *Client) ListGovernanceActionsWithSummary(ctx context.Context, filters *GovernanceActionFilters) ([]GovernanceAction, *GovernanceSummary, error) {
+	resp, err := c.listGovernanceActions(ctx, filters)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summary := &GovernanceSummary{
+		Total:        resp.Total,
+		ByStatus:     summaryCounts(resp.Summary, "by_status"),
+		ByPriority:   summaryPriorityCounts(resp.Summary),
+		BySourceType: summaryCounts(resp.Summary, "by_source_type"),
+	}
+	if summary.Total == 0 {
+		summary.Total = len(resp.Actions)
+	}
+	tally := func(counts map[string]int, key func(a GovernanceAction) string) map[string]int {
+		if counts != nil {
+			return counts
+		}
+		counts = map[string]int{}
+		for _, a := range resp.Actions {
+			counts[key(a)]++
+		}
+		return counts
+	}
+	summary.ByStatus = tally(summary.ByStatus, func(a GovernanceAction) string { return a.Status })
+	summary.ByPriority = tally(summary.ByPriority, func(a GovernanceAction) string { return a.Priority })
+	summary.BySourceType = tally(summary.BySourceType, func(a GovernanceAction) string { return a.SourceType })
+
+	return resp.Actions, summary, nil
+}
+
+func (c *Client) listGovernanceActions(ctx context.Context, filters *GovernanceActionFilters) (*governanceActionListResponse, error) {
 	path := "/api/v1/governance/actions"
 
 	if filters != nil {
@@ -104,15 +257,49 @@ func (c *Client) ListGovernanceActions(ctx context.Context, filters *GovernanceA
 
 	body, err := c.Get(ctx, path)
 	if err != nil {
-		return nil, 0, fmt.Errorf("list governance actions: %w", err)
+		return nil, fmt.Errorf("list governance actions: %w", err)
 	}
 
 	var resp governanceActionListResponse
 	if err := json.Unmarshal(body, &resp); err != nil {
-		return nil, 0, fmt.Errorf("unmarshal governance actions response: %w", err)
+		return nil, fmt.Errorf("unmarshal governance actions response: %w", err)
 	}
 
-	return resp.Actions, resp.Total, nil
+	return &resp, nil
+}
+
+// summaryCounts extracts a map of counts from the loosely typed summary
+// object. It returns nil when the key is absent or not an object.
+func summaryCounts(summary map[string]interface{}, key string) map[string]int {
+	raw, ok := summary[key].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	counts := make(map[string]int, len(raw))
+	for k, v := range raw {
+		if n, ok := v.(float64); ok {
+			counts[k] = int(n)
+		}
+	}
+	return counts
+}
+
+// summaryPriorityCounts extracts per-priority counts from the summary. Older
+// API versions report them as top-level keys rather than under by_priority.
+func summaryPriorityCounts(summary map[string]interface{}) map[string]int {
+	if counts := summaryCounts(summary, "by_priority"); counts != nil {
+		return counts
+	}
+	var counts map[string]int
+	for _, p := range []string{"critical", "high", "medium", "low"} {
+		if n, ok := summary[p].(float64); ok {
+			if counts == nil {
+				counts = map[string]int{}
+			}
+			counts[p] = int(n)
+		}
+	}
+	return counts
 }
 
 // GetGovernanceAction retrieves a governance action by ID.
@@ -567,3 +567,186 @@ func TestGovernanceAction_Lifecycle_Integration(t *testing.T) {
 		t.Errorf("GET after DELETE: expected not-found error, got: %v", err)
 	}
 }
+
+func TestUpdateGovernanceAction_SendsSLADays(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req map[string]interface{}
+		json.Unmarshal(body, &req)
+
+		if req["sla_days"] != float64(14) {
+			t.Errorf("sla_days = %v, want 14", req["sla_days"])
+		}
+		if _, ok := req["status"]; ok {
+			t.Error("status should not be sent when unset")
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{"message": "ok"})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	sla := 14
+	if err := c.UpdateGovernanceAction(context.Background(), "act-1", UpdateGovernanceActionRequest{SLADays: &sla}); err != nil {
+		t.Fatalf("UpdateGovernanceAction() error = %v", err)
+	}
+}
+
+func TestGovernanceAction_IsClosed(t *testing.T) {
+	tests := map[string]bool{
+		GovernanceStatusOpen:       false,
+		GovernanceStatusInProgress: false,
+		GovernanceStatusResolved:   true,
+		GovernanceStatusDismissed:  true,
+		GovernanceStatusWontFix:    true,
+	}
+	for status, want := range tests {
+		a := GovernanceAction{Status: status}
+		if got := a.IsClosed(); got != want {
+			t.Errorf("IsClosed() for %q = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestValidGovernanceTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{GovernanceStatusOpen, GovernanceStatusInProgress, true},
+		{GovernanceStatusInProgress, GovernanceStatusResolved, true},
+		{GovernanceStatusOpen, GovernanceStatusDismissed, true},
+		{GovernanceStatusResolved, GovernanceStatusOpen, true},
+		{GovernanceStatusOpen, GovernanceStatusOpen, true},
+		{GovernanceStatusOpen, GovernanceStatusResolved, false},
+		{GovernanceStatusResolved, GovernanceStatusInProgress, false},
+		{GovernanceStatusDismissed, GovernanceStatusWontFix, false},
+		{"unknown", GovernanceStatusOpen, false},
+	}
+	for _, tt := range tests {
+		if got := ValidGovernanceTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("ValidGovernanceTransition(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestGetGovernanceAction_DecodesHistory(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":     "act-1",
+			"status": "resolved",
+			"history": []map[string]interface{}{
+				{"status": "open", "actor": "scanner", "changed_at": "2026-01-01T00:00:00Z"},
+				{"status": "in_progress", "actor": "alice", "changed_at": "2026-01-02T00:00:00Z"},
+				{"status": "resolved", "actor": "alice", "note": "patched", "changed_at": "2026-01-03T00:00:00Z"},
+			},
+		})
+	})
+	c := setupClientWithServer(server)
+
+	action, err := c.GetGovernanceAction(testCtx(), "act-1")
+	if err != nil {
+		t.Fatalf("GetGovernanceAction() error = %v", err)
+	}
+	if len(action.History) != 3 {
+		t.Fatalf("History length = %d, want 3", len(action.History))
+	}
+	last := action.History[2]
+	if last.Status != "resolved" || last.Actor != "alice" || last.Note != "patched" {
+		t.Errorf("last history entry = %+v", last)
+	}
+}
+
+func TestListGovernanceActionsWithSummary(t *testing.T) {
+	tests := []struct {
+		name         string
+		summary      map[string]interface{}
+		wantStatus   map[string]int
+		wantPriority map[string]int
+		wantSource   map[string]int
+	}{
+		{
+			name: "structured summary",
+			summary: map[string]interface{}{
+				"by_status":      map[string]int{"open": 7, "resolved": 3},
+				"by_priority":    map[string]int{"critical": 2, "low": 8},
+				"by_source_type": map[string]int{"security": 10},
+			},
+			wantStatus:   map[string]int{"open": 7, "resolved": 3},
+			wantPriority: map[string]int{"critical": 2, "low": 8},
+			wantSource:   map[string]int{"security": 10},
+		},
+		{
+			name:         "flat priority summary",
+			summary:      map[string]interface{}{"critical": 1, "high": 0, "medium": 1, "low": 0},
+			wantStatus:   map[string]int{"open": 1, "in_progress": 1},
+			wantPriority: map[string]int{"critical": 1, "high": 0, "medium": 1, "low": 0},
+			wantSource:   map[string]int{"security": 1, "scorecard": 1},
+		},
+		{
+			name:         "no summary",
+			wantStatus:   map[string]int{"open": 1, "in_progress": 1},
+			wantPriority: map[string]int{"critical": 1, "medium": 1},
+			wantSource:   map[string]int{"security": 1, "scorecard": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"actions": []map[string]interface{}{
+						{"id": "act-1", "priority": "critical", "status": "open", "source_type": "security"},
+						{"id": "act-2", "priority": "medium", "status": "in_progress", "source_type": "scorecard"},
+					},
+					"total":   2,
+					"summary": tt.summary,
+				})
+			}))
+			defer server.Close()
+
+			c := NewClient(server.URL, "key", 30*time.Second)
+			actions, summary, err := c.ListGovernanceActionsWithSummary(context.Background(), nil)
+			if err != nil {
+				t.Fatalf("ListGovernanceActionsWithSummary() error = %v", err)
+			}
+			if len(actions) != 2 || summary.Total != 2 {
+				t.Fatalf("got %d actions, total %d; want 2, 2", len(actions), summary.Total)
+			}
+			if fmt.Sprint(summary.ByStatus) != fmt.Sprint(tt.wantStatus) {
+				t.Errorf("ByStatus = %v, want %v", summary.ByStatus, tt.wantStatus)
+			}
+			if fmt.Sprint(summary.ByPriority) != fmt.Sprint(tt.wantPriority) {
+				t.Errorf("ByPriority = %v, want %v", summary.ByPriority, tt.wantPriority)
+			}
+			if fmt.Sprint(summary.BySourceType) != fmt.Sprint(tt.wantSource) {
+				t.Errorf("BySourceType = %v, want %v", summary.BySourceType, tt.wantSource)
+			}
+		})
+	}
+}
+
+func TestGovernanceAction_IsOverdue(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	sla := 5
+
+	tests := []struct {
+		name   string
+		action GovernanceAction
+		want   bool
+	}{
+		{"past due date", GovernanceAction{Status: "open", DueDate: "2026-03-01T00:00:00Z"}, true},
+		{"past date-only due date", GovernanceAction{Status: "open", DueDate: "2026-03-09"}, true},
+		{"due in future", GovernanceAction{Status: "open", DueDate: "2026-03-11T00:00:00Z"}, false},
+		{"sla elapsed", GovernanceAction{Status: "in_progress", SLADays: &sla, CreatedAt: "2026-03-01T00:00:00Z"}, true},
+		{"sla not elapsed", GovernanceAction{Status: "open", SLADays: &sla, CreatedAt: "2026-03-08T00:00:00Z"}, false},
+		{"closed", GovernanceAction{Status: "resolved", DueDate: "2026-03-01T00:00:00Z"}, false},
+		{"no deadline", GovernanceAction{Status: "open"}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.action.IsOverdue(now); got != tt.want {
+			t.Errorf("%s: IsOverdue() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
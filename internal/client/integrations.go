@@ -140,6 +140,22 @@ func (c *Client) UpdateIntegration(ctx context.Context, id int, req UpdateIntegr
 	return &resp.Integration, nil
 }
 
+// SyncIntegration triggers a sync of an integration and returns it with the
+// outcome in LastSyncAt and LastError.
+func (c *Client) SyncIntegration(ctx context.Context, id int) (*Integration, error) {
+	body, err := c.Post(ctx, fmt.Sprintf("/api/v1/integrations/%d/sync", id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("sync integration %d: %w", id, err)
+	}
+
+	var resp integrationResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("unmarshal sync integration response: %w", err)
+	}
+
+	return &resp.Integration, nil
+}
+
 // DeleteIntegration deletes an integration by ID.
 func (c *Client) DeleteIntegration(ctx context.Context, id int) error {
 	if err := c.Delete(ctx, fmt.Sprintf("/api/v1/integrations/%d", id)); err != nil {
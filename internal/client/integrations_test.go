@@ -147,6 +147,31 @@ func TestUpdateIntegration_Success(t *testing.T) {
 	}
 }
 
+func TestSyncIntegration_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/integrations/1/sync" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"integration": map[string]interface{}{
+				"id": 1, "name": "GitHub Prod", "type": "github", "status": "active",
+				"last_sync_at": "2025-01-15T12:00:00Z",
+			},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	integration, err := c.SyncIntegration(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("SyncIntegration() error = %v", err)
+	}
+	if integration.LastSyncAt != "2025-01-15T12:00:00Z" {
+		t.Errorf("LastSyncAt = %q, want %q", integration.LastSyncAt, "2025-01-15T12:00:00Z")
+	}
+}
+
 func TestDeleteIntegration_Success(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodDelete || r.URL.Path != "/api/v1/integrations/1" {
@@ -5,22 +5,102 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
+
+	goversion "github.com/hashicorp/go-version"
 )
 
 // MarketplaceItem represents an item available in the Shoehorn marketplace catalog.
 type MarketplaceItem struct {
-	Slug        string `json:"slug"`
-	Kind        string `json:"kind"`
-	Name        string `json:"name"`
-	Version     string `json:"version"`
-	Description string `json:"description,omitempty"`
-	AuthorName  string `json:"author_name,omitempty"`
-	Category    string `json:"category,omitempty"`
-	Tier        string `json:"tier,omitempty"`
-	Verified    bool   `json:"verified,omitempty"`
-	Featured    bool   `json:"featured,omitempty"`
+	Slug        string                   `json:"slug"`
+	Kind        string                   `json:"kind"`
+	Name        string                   `json:"name"`
+	Version     string                   `json:"version"`
+	Description string                   `json:"description,omitempty"`
+	AuthorName  string                   `json:"author_name,omitempty"`
+	Category    string                   `json:"category,omitempty"`
+	Tier        string                   `json:"tier,omitempty"`
+	Verified    bool                     `json:"verified,omitempty"`
+	Featured    bool                     `json:"featured,omitempty"`
+	Versions    []MarketplaceItemVersion `json:"versions,omitempty"`
+	// ConfigSchema is the JSON Schema the item's configuration must satisfy.
+	ConfigSchema map[string]interface{} `json:"config_schema,omitempty"`
+}
+
+// MarketplaceItemVersion describes a published version of a marketplace item.
+type MarketplaceItemVersion struct {
+	Version    string `json:"version"`
+	ReleasedAt string `json:"released_at,omitempty"`
+	Changelog  string `json:"changelog,omitempty"`
+}
+
+// AvailableVersions returns every version of the item that can be installed.
+// The catalog's current version is always included, even when the API does not
+// return a version history.
+func (i *MarketplaceItem) AvailableVersions() []string {
+	versions := make([]string, 0, len(i.Versions)+1)
+	seen := make(map[string]bool, len(i.Versions)+1)
+	for _, v := range i.Versions {
+		if v.Version != "" && !seen[v.Version] {
+			seen[v.Version] = true
+			versions = append(versions, v.Version)
+		}
+	}
+	if i.Version != "" && !seen[i.Version] {
+		versions = append(versions, i.Version)
+	}
+	return versions
 }
 
+// LatestMatchingVersion returns the highest available version of the item that
+// satisfies the constraint. The constraint is either an exact version ("1.4.2")
+// or a comma-separated list of semver comparisons (">= 1.2, < 2.0", "~> 1.4").
+// Versions that are not valid semver are ignored. Returns an error if the
+// constraint cannot be parsed or no available version satisfies it.
+func (i *MarketplaceItem) LatestMatchingVersion(constraint string) (string, error) {
+	constraints, err := goversion.NewConstraint(constraint)
+	if err != nil {
+		return "", fmt.Errorf("invalid version constraint %q: %w", constraint, err)
+	}
+
+	var best *goversion.Version
+	var bestRaw string
+	for _, raw := range i.AvailableVersions() {
+		v, err := goversion.NewVersion(raw)
+		if err != nil || !constraints.Check(v) {
+			continue
+		}
+		if best == nil || v.GreaterThan(best) {
+			best, bestRaw = v, raw
+		}
+	}
+
+	if best == nil {
+		return "", fmt.Errorf("no version of marketplace item %q satisfies %q (available: %s)", i.Slug, constraint, strings.Join(i.AvailableVersions(), ", "))
+	}
+	return bestRaw, nil
+}
+
+// Changelog returns the changelog published for the given version, or an empty
+// string if the version is unknown or has no changelog.
+func (i *MarketplaceItem) Changelog(version string) string {
+	for _, v := range i.Versions {
+		if v.Version == version {
+			return v.Changelog
+		}
+	}
+	return ""
+}
+
+// Marketplace installation sync statuses reported by the API.
+const (
+	MarketplaceSyncStatusPending = "pending"
+	MarketplaceSyncStatusSyncing = "syncing"
+	MarketplaceSyncStatusSynced  = "synced"
+	MarketplaceSyncStatusFailed  = "failed"
+	MarketplaceSyncStatusError   = "error"
+)
+
 // MarketplaceInstallation represents an installed marketplace item.
 type MarketplaceInstallation struct {
 	ID          string                 `json:"id"`
@@ -30,12 +110,28 @@ type MarketplaceInstallation struct {
 	Enabled     bool                   `json:"enabled"`
 	Config      map[string]interface{} `json:"config,omitempty"`
 	SyncStatus  string                 `json:"syncStatus,omitempty"`
+	SyncError   string                 `json:"syncError,omitempty"`
 	LastSyncAt  string                 `json:"lastSyncAt,omitempty"`
 	InstalledBy string                 `json:"installedBy,omitempty"`
 	CreatedAt   string                 `json:"createdAt,omitempty"`
 	UpdatedAt   string                 `json:"updatedAt,omitempty"`
 }
 
+// SyncSettled returns true if the installation is no longer waiting for its
+// configuration to be synced to the addon.
+func (i *MarketplaceInstallation) SyncSettled() bool {
+	switch i.SyncStatus {
+	case MarketplaceSyncStatusPending, MarketplaceSyncStatusSyncing:
+		return false
+	}
+	return true
+}
+
+// SyncFailed returns true if the last configuration sync failed.
+func (i *MarketplaceInstallation) SyncFailed() bool {
+	return i.SyncStatus == MarketplaceSyncStatusFailed || i.SyncStatus == MarketplaceSyncStatusError
+}
+
 // marketplaceItemsResponse wraps the list catalog response.
 type marketplaceItemsResponse struct {
 	Items []MarketplaceItem `json:"items"`
@@ -53,7 +149,13 @@ type marketplaceInstallationResponse struct {
 
 // marketplaceInstallRequest is the request body for installing a marketplace item.
 type marketplaceInstallRequest struct {
-	Slug string `json:"slug"`
+	Slug    string `json:"slug"`
+	Version string `json:"version,omitempty"`
+}
+
+// marketplaceUpgradeRequest is the request body for changing the installed version of an item.
+type marketplaceUpgradeRequest struct {
+	Version string `json:"version"`
 }
 
 // marketplaceConfigRequest is the request body for updating a marketplace item's config.
@@ -90,6 +192,23 @@ func (c *Client) ListMarketplaceItems(ctx context.Context, kind, category string
 	return resp.Items, nil
 }
 
+// GetMarketplaceItem retrieves a single catalog item by slug by listing the
+// catalog and filtering. Returns ErrNotFound if no item has the given slug.
+func (c *Client) GetMarketplaceItem(ctx context.Context, slug string) (*MarketplaceItem, error) {
+	items, err := c.ListMarketplaceItems(ctx, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item.Slug == slug {
+			return &item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("marketplace item %q: %w", slug, ErrNotFound)
+}
+
 // ListMarketplaceInstallations retrieves all installed marketplace items.
 func (c *Client) ListMarketplaceInstallations(ctx context.Context) ([]MarketplaceInstallation, error) {
 	body, err := c.Get(ctx, "/api/v1/marketplace/installed")
@@ -121,10 +240,11 @@ func (c *Client) GetMarketplaceInstallation(ctx context.Context, slug string) (*
 	return &installation, nil
 }
 
-// InstallMarketplaceItem installs a marketplace item by slug.
+// InstallMarketplaceItem installs a marketplace item by slug. An empty version
+// installs whatever version the catalog currently offers.
 // The API returns the installation object directly (not wrapped in an envelope).
-func (c *Client) InstallMarketplaceItem(ctx context.Context, slug string) (*MarketplaceInstallation, error) {
-	body, err := c.Post(ctx, "/api/v1/marketplace/install", marketplaceInstallRequest{Slug: slug})
+func (c *Client) InstallMarketplaceItem(ctx context.Context, slug, version string) (*MarketplaceInstallation, error) {
+	body, err := c.Post(ctx, "/api/v1/marketplace/install", marketplaceInstallRequest{Slug: slug, Version: version})
 	if err != nil {
 		return nil, fmt.Errorf("install marketplace item %q: %w", slug, err)
 	}
@@ -137,6 +257,22 @@ func (c *Client) InstallMarketplaceItem(ctx context.Context, slug string) (*Mark
 	return &installation, nil
 }
 
+// UpgradeMarketplaceItem changes the installed version of a marketplace item.
+// The API returns the installation object directly (not wrapped in an envelope).
+func (c *Client) UpgradeMarketplaceItem(ctx context.Context, slug, version string) (*MarketplaceInstallation, error) {
+	body, err := c.Post(ctx, fmt.Sprintf("/api/v1/marketplace/%s/upgrade", url.PathEscape(slug)), marketplaceUpgradeRequest{Version: version})
+	if err != nil {
+		return nil, fmt.Errorf("upgrade marketplace item %q to %s: %w", slug, version, err)
+	}
+
+	var installation MarketplaceInstallation
+	if err := json.Unmarshal(body, &installation); err != nil {
+		return nil, fmt.Errorf("unmarshal upgrade marketplace response: %w", err)
+	}
+
+	return &installation, nil
+}
+
 // UninstallMarketplaceItem uninstalls a marketplace item by slug.
 func (c *Client) UninstallMarketplaceItem(ctx context.Context, slug string) error {
 	if err := c.Delete(ctx, fmt.Sprintf("/api/v1/marketplace/%s/uninstall", url.PathEscape(slug))); err != nil {
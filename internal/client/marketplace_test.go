@@ -201,7 +201,7 @@ func TestInstallMarketplaceItem_Success(t *testing.T) {
 	defer server.Close()
 
 	c := NewClient(server.URL, "key", 30*time.Second)
-	inst, err := c.InstallMarketplaceItem(context.Background(), "slack-notifier")
+	inst, err := c.InstallMarketplaceItem(context.Background(), "slack-notifier", "")
 	if err != nil {
 		t.Fatalf("InstallMarketplaceItem() error = %v", err)
 	}
@@ -361,7 +361,7 @@ func TestInstallMarketplaceItem_Conflict(t *testing.T) {
 	defer server.Close()
 
 	c := NewClient(server.URL, "key", 30*time.Second)
-	_, err := c.InstallMarketplaceItem(context.Background(), "slack-notifier")
+	_, err := c.InstallMarketplaceItem(context.Background(), "slack-notifier", "")
 	if err == nil {
 		t.Fatal("InstallMarketplaceItem() expected error for 409, got nil")
 	}
@@ -450,7 +450,7 @@ func TestMarketplace_Lifecycle(t *testing.T) {
 	c := NewClient(server.URL, "key", 30*time.Second)
 
 	// INSTALL
-	inst, err := c.InstallMarketplaceItem(context.Background(), "test-addon")
+	inst, err := c.InstallMarketplaceItem(context.Background(), "test-addon", "")
 	if err != nil {
 		t.Fatalf("INSTALL failed: %v", err)
 	}
@@ -530,3 +530,201 @@ func TestMarketplace_Lifecycle(t *testing.T) {
 		t.Fatal("GET after UNINSTALL: expected error, got nil")
 	}
 }
+
+func TestGetMarketplaceItem_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/marketplace" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"slug": "dashboard-widget", "kind": "widget", "version": "2.0.1"},
+				{"slug": "slack-notifier", "kind": "addon", "version": "1.3.0", "versions": []map[string]interface{}{
+					{"version": "1.2.0", "released_at": "2025-05-01T00:00:00Z", "changelog": "Initial release"},
+					{"version": "1.3.0", "released_at": "2025-06-01T00:00:00Z", "changelog": "Thread replies"},
+				}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	item, err := c.GetMarketplaceItem(context.Background(), "slack-notifier")
+	if err != nil {
+		t.Fatalf("GetMarketplaceItem() error = %v", err)
+	}
+	if item.Kind != "addon" {
+		t.Errorf("Kind = %q, want %q", item.Kind, "addon")
+	}
+	if len(item.Versions) != 2 {
+		t.Fatalf("version count = %d, want 2", len(item.Versions))
+	}
+	if item.Versions[1].Changelog != "Thread replies" {
+		t.Errorf("Versions[1].Changelog = %q, want %q", item.Versions[1].Changelog, "Thread replies")
+	}
+}
+
+func TestGetMarketplaceItem_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{"items": []map[string]interface{}{}})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	_, err := c.GetMarketplaceItem(context.Background(), "missing")
+	if !IsNotFound(err) {
+		t.Errorf("GetMarketplaceItem() error = %v, want not found", err)
+	}
+}
+
+func TestInstallMarketplaceItem_SendsVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req map[string]interface{}
+		json.Unmarshal(body, &req)
+
+		if req["version"] != "1.2.0" {
+			t.Errorf("version = %v, want %q", req["version"], "1.2.0")
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": "inst-001", "itemSlug": "slack-notifier", "itemVersion": "1.2.0"})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	inst, err := c.InstallMarketplaceItem(context.Background(), "slack-notifier", "1.2.0")
+	if err != nil {
+		t.Fatalf("InstallMarketplaceItem() error = %v", err)
+	}
+	if inst.Version != "1.2.0" {
+		t.Errorf("Version = %q, want %q", inst.Version, "1.2.0")
+	}
+}
+
+func TestUpgradeMarketplaceItem_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/marketplace/slack-notifier/upgrade" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var req map[string]interface{}
+		json.Unmarshal(body, &req)
+
+		if req["version"] != "1.3.0" {
+			t.Errorf("version = %v, want %q", req["version"], "1.3.0")
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": "inst-001", "itemSlug": "slack-notifier", "itemVersion": "1.3.0"})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	inst, err := c.UpgradeMarketplaceItem(context.Background(), "slack-notifier", "1.3.0")
+	if err != nil {
+		t.Fatalf("UpgradeMarketplaceItem() error = %v", err)
+	}
+	if inst.Version != "1.3.0" {
+		t.Errorf("Version = %q, want %q", inst.Version, "1.3.0")
+	}
+}
+
+func TestMarketplaceItem_AvailableVersions(t *testing.T) {
+	item := MarketplaceItem{
+		Version:  "1.3.0",
+		Versions: []MarketplaceItemVersion{{Version: "1.2.0"}, {Version: "1.2.0"}, {Version: "1.3.0"}},
+	}
+
+	got := item.AvailableVersions()
+	if len(got) != 2 || got[0] != "1.2.0" || got[1] != "1.3.0" {
+		t.Errorf("AvailableVersions() = %v, want [1.2.0 1.3.0]", got)
+	}
+
+	noHistory := MarketplaceItem{Version: "2.0.0"}
+	if got := noHistory.AvailableVersions(); len(got) != 1 || got[0] != "2.0.0" {
+		t.Errorf("AvailableVersions() without history = %v, want [2.0.0]", got)
+	}
+}
+
+func TestMarketplaceItem_LatestMatchingVersion(t *testing.T) {
+	item := MarketplaceItem{
+		Slug:    "slack-notifier",
+		Version: "2.0.0",
+		Versions: []MarketplaceItemVersion{
+			{Version: "1.2.0"}, {Version: "1.4.1"}, {Version: "1.10.0"}, {Version: "2.0.0"}, {Version: "not-semver"},
+		},
+	}
+
+	tests := []struct {
+		constraint string
+		want       string
+		wantErr    bool
+	}{
+		{constraint: "1.4.1", want: "1.4.1"},
+		{constraint: ">= 1.2, < 2.0", want: "1.10.0"},
+		{constraint: "~> 1.4", want: "1.10.0"},
+		{constraint: ">= 0", want: "2.0.0"},
+		{constraint: "3.0.0", wantErr: true},
+		{constraint: "latest please", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			got, err := item.LatestMatchingVersion(tt.constraint)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LatestMatchingVersion(%q) error = %v, wantErr %v", tt.constraint, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("LatestMatchingVersion(%q) = %q, want %q", tt.constraint, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarketplaceItem_Changelog(t *testing.T) {
+	item := MarketplaceItem{Versions: []MarketplaceItemVersion{{Version: "1.2.0", Changelog: "Initial release"}}}
+	if got := item.Changelog("1.2.0"); got != "Initial release" {
+		t.Errorf("Changelog(1.2.0) = %q, want %q", got, "Initial release")
+	}
+	if got := item.Changelog("9.9.9"); got != "" {
+		t.Errorf("Changelog(9.9.9) = %q, want empty", got)
+	}
+}
+
+func TestMarketplaceItem_DecodesConfigSchema(t *testing.T) {
+	var item MarketplaceItem
+	raw := `{"slug":"slack-notifier","config_schema":{"type":"object","required":["webhook_url"]}}`
+	if err := json.Unmarshal([]byte(raw), &item); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if item.ConfigSchema["type"] != "object" {
+		t.Errorf("ConfigSchema[type] = %v, want %q", item.ConfigSchema["type"], "object")
+	}
+}
+
+func TestMarketplaceInstallation_SyncState(t *testing.T) {
+	tests := []struct {
+		status      string
+		wantSettled bool
+		wantFailed  bool
+	}{
+		{status: "", wantSettled: true},
+		{status: MarketplaceSyncStatusPending},
+		{status: MarketplaceSyncStatusSyncing},
+		{status: MarketplaceSyncStatusSynced, wantSettled: true},
+		{status: MarketplaceSyncStatusFailed, wantSettled: true, wantFailed: true},
+		{status: MarketplaceSyncStatusError, wantSettled: true, wantFailed: true},
+	}
+	for _, tt := range tests {
+		inst := MarketplaceInstallation{SyncStatus: tt.status}
+		if got := inst.SyncSettled(); got != tt.wantSettled {
+			t.Errorf("SyncSettled() for %q = %v, want %v", tt.status, got, tt.wantSettled)
+		}
+		if got := inst.SyncFailed(); got != tt.wantFailed {
+			t.Errorf("SyncFailed() for %q = %v, want %v", tt.status, got, tt.wantFailed)
+		}
+	}
+}
@@ -77,3 +77,19 @@ func (c *Client) UpdatePolicy(ctx context.Context, id string, req UpdatePolicyRe
 
 	return &policy, nil
 }
+
+// ResetPolicy resets a platform policy's enabled state and enforcement level
+// to the defaults it is seeded with.
+func (c *Client) ResetPolicy(ctx context.Context, id string) (*PlatformPolicy, error) {
+	body, err := c.Post(ctx, fmt.Sprintf("/api/v1/admin/policies/%s/reset", id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("reset policy %s: %w", id, err)
+	}
+
+	var policy PlatformPolicy
+	if err := json.Unmarshal(body, &policy); err != nil {
+		return nil, fmt.Errorf("unmarshal reset policy response: %w", err)
+	}
+
+	return &policy, nil
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// References resolves string references to teams, catalog entities, directory
+// users and roles. Each collection is listed at most once and cached for the
+// lifetime of the References, so validating many resources during a plan does
+// not repeat the same list requests.
+type References struct {
+	client *Client
+
+	mu       sync.Mutex
+	teams    map[string]bool
+	entities map[string]bool
+	users    map[string]bool
+	roles    map[string]bool
+}
+
+// NewReferences creates a reference resolver backed by c.
+func NewReferences(c *Client) *References {
+	return &References{client: c}
+}
+
+// HasTeam reports whether ref is the slug or ID of an existing team.
+func (r *References) HasTeam(ctx context.Context, ref string) (bool, error) {
+	return r.has(ctx, &r.teams, ref, func(ctx context.Context) (map[string]bool, error) {
+		teams, err := r.client.ListTeams(ctx)
+		if err != nil {
+			return nil, err
+		}
+		known := make(map[string]bool, 2*len(teams))
+		for _, t := range teams {
+			known[t.Slug] = true
+			known[t.ID] = true
+		}
+		return known, nil
+	})
+}
+
+// HasEntity reports whether ref names an existing catalog entity, either by
+// ID or in the "<type>:<id>" form used by relations.
+func (r *References) HasEntity(ctx context.Context, ref string) (bool, error) {
+	return r.has(ctx, &r.entities, ref, func(ctx context.Context) (map[string]bool, error) {
+		entities, err := r.client.ListEntities(ctx)
+		if err != nil {
+			return nil, err
+		}
+		known := make(map[string]bool, 2*len(entities))
+		for _, e := range entities {
+			known[e.Service.ID] = true
+			if e.Service.Type != "" {
+				known[e.Service.Type+":"+e.Service.ID] = true
+			}
+		}
+		return known, nil
+	})
+}
+
+// HasUser reports whether ref is the ID, username or email of a directory user.
+// Emails are compared case-insensitively.
+func (r *References) HasUser(ctx context.Context, ref string) (bool, error) {
+	if strings.Contains(ref, "@") {
+		ref = strings.ToLower(ref)
+	}
+	return r.has(ctx, &r.users, ref, func(ctx context.Context) (map[string]bool, error) {
+		users, err := r.client.ListDirectoryUsers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		known := make(map[string]bool, 3*len(users))
+		for _, u := range users {
+			known[u.ID] = true
+			if u.Username != "" {
+				known[u.Username] = true
+			}
+			if u.Email != "" {
+				known[strings.ToLower(u.Email)] = true
+			}
+		}
+		return known, nil
+	})
+}
+
+// HasRole reports whether name is a defined role (permission bundle) or a
+// role that is assigned to at least one user or mapped to at least one group.
+func (r *References) HasRole(ctx context.Context, name string) (bool, error) {
+	return r.has(ctx, &r.roles, name, func(ctx context.Context) (map[string]bool, error) {
+		assignments, err := r.client.ListRoles(ctx)
+		if err != nil {
+			return nil, err
+		}
+		groups, err := r.client.ListGroups(ctx)
+		if err != nil {
+			return nil, err
+		}
+		bundles, err := r.client.ListBundles(ctx)
+		if err != nil && !IsNotFound(err) {
+			return nil, err
+		}
+		known := make(map[string]bool, len(assignments)+len(bundles))
+		for _, b := range bundles {
+			known[b.Name] = true
+		}
+		for _, a := range assignments {
+			known[a.Role] = true
+		}
+		var addGroupRoles func(groups []Group)
+		addGroupRoles = func(groups []Group) {
+			for _, g := range groups {
+				for _, role := range g.Roles {
+					known[role.RoleName] = true
+				}
+				addGroupRoles(g.SubGroups)
+			}
+		}
+		addGroupRoles(groups)
+		return known, nil
+	})
+}
+
+// has looks ref up in the cached set, loading the set on first use.
+func (r *References) has(ctx context.Context, set *map[string]bool, ref string, load func(context.Context) (map[string]bool, error)) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if *set == nil {
+		known, err := load(ctx)
+		if err != nil {
+			return false, err
+		}
+		*set = known
+	}
+	return (*set)[ref], nil
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestReferences_ResolvesAndCaches(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/api/v1/admin/teams":
+			json.NewEncoder(w).Encode(map[string]interface{}{"teams": []map[string]interface{}{
+				{"id": "team-1", "name": "Platform", "slug": "platform"},
+			}})
+		case "/api/v1/entities":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"entities": []map[string]interface{}{{"service": map[string]interface{}{"id": "payments", "type": "service"}}},
+				"page":     map[string]interface{}{"total": 1},
+			})
+		case "/api/v1/users":
+			json.NewEncoder(w).Encode(map[string]interface{}{"items": []map[string]interface{}{
+				{"id": "u-1", "username": "ada", "email": "Ada@Example.com"},
+			}})
+		case "/api/v1/roles":
+			json.NewEncoder(w).Encode(map[string]interface{}{"roles": []map[string]interface{}{{"user_id": "u-1", "role": "admin"}}})
+		case "/api/v1/groups":
+			json.NewEncoder(w).Encode(map[string]interface{}{"items": []map[string]interface{}{
+				{"id": "g-1", "name": "devs", "roles": []map[string]interface{}{{"roleName": "editor"}}},
+			}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	refs := NewReferences(NewClient(server.URL, "key", 30*time.Second))
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		lookup func(context.Context, string) (bool, error)
+		ref    string
+		want   bool
+	}{
+		{"team by slug", refs.HasTeam, "platform", true},
+		{"team by id", refs.HasTeam, "team-1", true},
+		{"unknown team", refs.HasTeam, "platfrom", false},
+		{"entity by id", refs.HasEntity, "payments", true},
+		{"entity by type and id", refs.HasEntity, "service:payments", true},
+		{"entity with wrong type", refs.HasEntity, "resource:payments", false},
+		{"user by id", refs.HasUser, "u-1", true},
+		{"user by username", refs.HasUser, "ada", true},
+		{"user by email", refs.HasUser, "ada@example.COM", true},
+		{"unknown user", refs.HasUser, "grace@example.com", false},
+		{"role assigned to user", refs.HasRole, "admin", true},
+		{"role mapped to group", refs.HasRole, "editor", true},
+		{"unknown role", refs.HasRole, "superuser", false},
+	}
+	for _, tt := range tests {
+		got, err := tt.lookup(ctx, tt.ref)
+		if err != nil {
+			t.Fatalf("%s: error = %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	for path, n := range requests {
+		if n != 1 {
+			t.Errorf("%s requested %d times, want 1", path, n)
+		}
+	}
+}
+
+func TestReferences_PropagatesErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{"message": "forbidden"})
+	}))
+	defer server.Close()
+
+	refs := NewReferences(NewClient(server.URL, "key", 30*time.Second))
+	if _, err := refs.HasTeam(context.Background(), "platform"); err == nil {
+		t.Error("expected error when teams cannot be listed")
+	}
+}
@@ -57,6 +57,24 @@ func (c *Client) GetUserRole(ctx context.Context, userID, role string) (*UserRol
 	return nil, fmt.Errorf("role %q for user %q: %w", role, userID, ErrNotFound)
 }
 
+// ListUserRoles retrieves the role assignments of a single user by listing all
+// roles and filtering for userID. A user without roles yields an empty slice.
+func (c *Client) ListUserRoles(ctx context.Context, userID string) ([]UserRole, error) {
+	roles, err := c.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var assigned []UserRole
+	for _, r := range roles {
+		if r.UserID == userID {
+			assigned = append(assigned, r)
+		}
+	}
+
+	return assigned, nil
+}
+
 // AddUserRole assigns a role to a user by POSTing to /api/v1/roles/users/{userID}/roles.
 func (c *Client) AddUserRole(ctx context.Context, userID string, req RoleRequest) error {
 	path := fmt.Sprintf("/api/v1/roles/users/%s/roles", url.PathEscape(userID))
@@ -243,3 +243,35 @@ func TestRoleClient_Lifecycle_Integration(t *testing.T) {
 		t.Error("expected error after removal, got nil")
 	}
 }
+
+func TestListUserRoles_FiltersByUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"roles": []map[string]interface{}{
+				{"user_id": "user-1", "role": "admin"},
+				{"user_id": "user-2", "role": "viewer"},
+				{"user_id": "user-1", "role": "editor"},
+			},
+			"count": 3,
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	roles, err := c.ListUserRoles(context.Background(), "user-1")
+	if err != nil {
+		t.Fatalf("ListUserRoles() error = %v", err)
+	}
+	if len(roles) != 2 || roles[0].Role != "admin" || roles[1].Role != "editor" {
+		t.Errorf("roles = %+v, want admin and editor", roles)
+	}
+
+	roles, err = c.ListUserRoles(context.Background(), "user-3")
+	if err != nil {
+		t.Fatalf("ListUserRoles() error = %v", err)
+	}
+	if len(roles) != 0 {
+		t.Errorf("roles = %+v, want none", roles)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // TenantSettings represents Shoehorn tenant settings.
@@ -55,6 +57,31 @@ type UpdateSettingsRequest struct {
 	Forge        *ForgeSettings        `json:"forge,omitempty"`
 }
 
+// PatchSettingsRequest is the request body for changing some sections of the
+// tenant settings; sections left nil are not changed. When UpdatedAt is set,
+// the API only applies the patch if the settings were last updated at that
+// time and otherwise responds with 412 Precondition Failed.
+type PatchSettingsRequest struct {
+	Appearance   *AppearanceSettings   `json:"appearance,omitempty"`
+	Announcement *AnnouncementSettings `json:"announcement,omitempty"`
+	Forge        *ForgeSettings        `json:"forge,omitempty"`
+	UpdatedAt    string                `json:"updated_at,omitempty"`
+}
+
+// UpdateRequest returns the request that puts the tenant settings back to s.
+// It is used to restore a snapshot of the settings taken before Terraform
+// changed them.
+func (s TenantSettings) UpdateRequest() UpdateSettingsRequest {
+	announcement := s.Announcement
+	announcement.UpdatedAt = ""
+	forge := s.Forge
+	return UpdateSettingsRequest{
+		Appearance:   s.Appearance,
+		Announcement: &announcement,
+		Forge:        &forge,
+	}
+}
+
 // GetSettings retrieves the tenant settings.
 func (c *Client) GetSettings(ctx context.Context) (*TenantSettings, error) {
 	body, err := c.Get(ctx, "/api/v1/admin/settings")
@@ -84,3 +111,66 @@ func (c *Client) UpdateSettings(ctx context.Context, req UpdateSettingsRequest)
 
 	return &settings, nil
 }
+
+// PatchSettings changes the sections of the tenant settings set in req.
+func (c *Client) PatchSettings(ctx context.Context, req PatchSettingsRequest) (*TenantSettings, error) {
+	body, err := c.Patch(ctx, "/api/v1/admin/settings", req)
+	if err != nil {
+		return nil, fmt.Errorf("patch settings: %w", err)
+	}
+
+	var settings TenantSettings
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, fmt.Errorf("unmarshal patch settings response: %w", err)
+	}
+
+	return &settings, nil
+}
+
+// ModifySettings changes the tenant settings by read-modify-write. modify
+// receives the current settings and returns the sections to change, or nil if
+// they already match; an error from modify is returned as is. The patch is sent
+// with the UpdatedAt that was read as a precondition, so a concurrent change to
+// the settings is never overwritten with stale values; the read and modify are
+// retried instead, which lets modify decide whether the change conflicts. The
+// patch returned by modify is not changed, so it can be compared on a retry.
+func (c *Client) ModifySettings(ctx context.Context, modify func(current *TenantSettings) (*PatchSettingsRequest, error)) (*TenantSettings, error) {
+	for attempt := 1; ; attempt++ {
+		current, err := c.GetSettings(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		patch, err := modify(current)
+		if err != nil {
+			return nil, err
+		}
+		if patch == nil {
+			return current, nil
+		}
+		request := *patch
+		request.UpdatedAt = current.UpdatedAt
+
+		settings, err := c.PatchSettings(ctx, request)
+		if !IsPreconditionFailed(err) || attempt == maxRetries {
+			return settings, err
+		}
+		tflog.Debug(ctx, "settings changed during update, retrying", map[string]any{"attempt": attempt})
+	}
+}
+
+// ResetSettings resets the tenant appearance, announcement and forge settings
+// to the Shoehorn defaults.
+func (c *Client) ResetSettings(ctx context.Context) (*TenantSettings, error) {
+	body, err := c.Post(ctx, "/api/v1/admin/settings/reset", nil)
+	if err != nil {
+		return nil, fmt.Errorf("reset settings: %w", err)
+	}
+
+	var settings TenantSettings
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, fmt.Errorf("unmarshal reset settings response: %w", err)
+	}
+
+	return &settings, nil
+}
@@ -131,3 +131,67 @@ func TestUpdateSettings_Success(t *testing.T) {
 		t.Errorf("UpdatedAt = %q, want %q", settings.UpdatedAt, "2025-01-15T12:00:00Z")
 	}
 }
+
+func TestModifySettings_RetriesOnPreconditionFailed(t *testing.T) {
+	updatedAt := []string{"2025-01-15T12:00:00Z", "2025-01-15T12:00:05Z"}
+	var reads, patches int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(TenantSettings{ID: "settings-1", UpdatedAt: updatedAt[reads]})
+			reads++
+		case http.MethodPatch:
+			patches++
+			var req PatchSettingsRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decoding request: %v", err)
+			}
+			if req.Appearance != nil {
+				t.Error("Appearance was sent, want only the forge section")
+			}
+			// Another writer changed the settings after the first read.
+			if req.UpdatedAt != updatedAt[1] {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				json.NewEncoder(w).Encode(map[string]string{"code": "precondition_failed", "message": "settings changed"})
+				return
+			}
+			json.NewEncoder(w).Encode(TenantSettings{ID: "settings-1", Forge: *req.Forge, UpdatedAt: "2025-01-15T12:00:10Z"})
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	settings, err := c.ModifySettings(context.Background(), func(current *TenantSettings) (*PatchSettingsRequest, error) {
+		return &PatchSettingsRequest{Forge: &ForgeSettings{DefaultOrg: "acme"}}, nil
+	})
+	if err != nil {
+		t.Fatalf("ModifySettings() error = %v", err)
+	}
+	if settings.Forge.DefaultOrg != "acme" {
+		t.Errorf("DefaultOrg = %q, want %q", settings.Forge.DefaultOrg, "acme")
+	}
+	if reads != 2 || patches != 2 {
+		t.Errorf("reads = %d, patches = %d, want 2 each", reads, patches)
+	}
+}
+
+func TestModifySettings_SkipsUnchanged(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(TenantSettings{ID: "settings-1", Forge: ForgeSettings{DefaultOrg: "acme"}})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	settings, err := c.ModifySettings(context.Background(), func(current *TenantSettings) (*PatchSettingsRequest, error) {
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("ModifySettings() error = %v", err)
+	}
+	if settings.Forge.DefaultOrg != "acme" {
+		t.Errorf("DefaultOrg = %q, want the current settings", settings.Forge.DefaultOrg)
+	}
+}
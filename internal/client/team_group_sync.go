@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// TeamGroupSync binds a team's membership to one or more IdP groups. Members
+// of the groups are kept in sync as team members by the Shoehorn API.
+type TeamGroupSync struct {
+	TeamID           string            `json:"teamId"`
+	GroupPaths       []string          `json:"groupPaths"`
+	IncludeSubgroups bool              `json:"includeSubgroups"`
+	DefaultRole      string            `json:"defaultRole,omitempty"`
+	RoleMappings     map[string]string `json:"roleMappings,omitempty"`
+	MemberCount      int               `json:"memberCount"`
+	LastSyncedAt     string            `json:"lastSyncedAt,omitempty"`
+}
+
+// TeamGroupSyncRequest is the request body for setting a team's group sync
+// rule or previewing the membership it would produce. RoleMappings maps group
+// paths to the team role given to their members; other members get
+// DefaultRole.
+type TeamGroupSyncRequest struct {
+	GroupPaths       []string          `json:"groupPaths"`
+	IncludeSubgroups bool              `json:"includeSubgroups"`
+	DefaultRole      string            `json:"defaultRole,omitempty"`
+	RoleMappings     map[string]string `json:"roleMappings,omitempty"`
+}
+
+// TeamGroupSyncMember is a team member produced by a group sync rule.
+type TeamGroupSyncMember struct {
+	UserID string   `json:"userId"`
+	Email  string   `json:"email,omitempty"`
+	Role   string   `json:"role"`
+	Groups []string `json:"groups"`
+}
+
+// teamGroupSyncPreviewResponse wraps the group sync preview response.
+type teamGroupSyncPreviewResponse struct {
+	Items []TeamGroupSyncMember `json:"items"`
+}
+
+// GetTeamGroupSync retrieves the group sync rule of a team. It returns a not
+// found error when the team has no rule.
+func (c *Client) GetTeamGroupSync(ctx context.Context, teamID string) (*TeamGroupSync, error) {
+	body, err := c.Get(ctx, fmt.Sprintf("/api/v1/admin/teams/%s/group-sync", url.PathEscape(teamID)))
+	if err != nil {
+		return nil, fmt.Errorf("get group sync for team %s: %w", teamID, err)
+	}
+
+	var sync TeamGroupSync
+	if err := json.Unmarshal(body, &sync); err != nil {
+		return nil, fmt.Errorf("unmarshal team group sync response: %w", err)
+	}
+
+	return &sync, nil
+}
+
+// SetTeamGroupSync creates or replaces the group sync rule of a team. The API
+// applies the resulting membership before responding.
+func (c *Client) SetTeamGroupSync(ctx context.Context, teamID string, req TeamGroupSyncRequest) (*TeamGroupSync, error) {
+	body, err := c.Put(ctx, fmt.Sprintf("/api/v1/admin/teams/%s/group-sync", url.PathEscape(teamID)), req)
+	if err != nil {
+		return nil, fmt.Errorf("set group sync for team %s: %w", teamID, err)
+	}
+
+	var sync TeamGroupSync
+	if err := json.Unmarshal(body, &sync); err != nil {
+		return nil, fmt.Errorf("unmarshal team group sync response: %w", err)
+	}
+
+	return &sync, nil
+}
+
+// DeleteTeamGroupSync removes the group sync rule of a team together with the
+// members it added.
+func (c *Client) DeleteTeamGroupSync(ctx context.Context, teamID string) error {
+	if err := c.Delete(ctx, fmt.Sprintf("/api/v1/admin/teams/%s/group-sync", url.PathEscape(teamID))); err != nil {
+		return fmt.Errorf("delete group sync for team %s: %w", teamID, err)
+	}
+	return nil
+}
+
+// PreviewTeamGroupSync returns the team membership a group sync rule would
+// produce without applying it.
+func (c *Client) PreviewTeamGroupSync(ctx context.Context, req TeamGroupSyncRequest) ([]TeamGroupSyncMember, error) {
+	body, err := c.Post(ctx, "/api/v1/admin/teams/group-sync/preview", req)
+	if err != nil {
+		return nil, fmt.Errorf("preview team group sync: %w", err)
+	}
+
+	var resp teamGroupSyncPreviewResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("unmarshal team group sync preview response: %w", err)
+	}
+
+	return resp.Items, nil
+}
+
+// GroupPathWithin reports whether the IdP group at groupPath is covered by a
+// sync rule for root: it is root itself or, when includeSubgroups is set, one
+// of root's descendants.
+func GroupPathWithin(groupPath, root string, includeSubgroups bool) bool {
+	groupPath, root = strings.TrimSuffix(groupPath, "/"), strings.TrimSuffix(root, "/")
+	if groupPath == root {
+		return true
+	}
+	return includeSubgroups && strings.HasPrefix(groupPath, root+"/")
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTeamGroupSync_Calls(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/api/v1/admin/teams/group-sync/preview":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"items": []map[string]interface{}{{"userId": "u1", "email": "ada@example.com", "role": "member", "groups": []string{"/eng/payments"}}},
+			})
+		default:
+			var req TeamGroupSyncRequest
+			if r.Method == http.MethodPut {
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Fatalf("decoding request: %v", err)
+				}
+			}
+			json.NewEncoder(w).Encode(TeamGroupSync{TeamID: "team-1", GroupPaths: []string{"/eng/payments"}, DefaultRole: "member", MemberCount: 1})
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	ctx := context.Background()
+	req := TeamGroupSyncRequest{GroupPaths: []string{"/eng/payments"}, IncludeSubgroups: true}
+
+	if _, err := c.SetTeamGroupSync(ctx, "team-1", req); err != nil {
+		t.Fatalf("SetTeamGroupSync() error = %v", err)
+	}
+	sync, err := c.GetTeamGroupSync(ctx, "team-1")
+	if err != nil {
+		t.Fatalf("GetTeamGroupSync() error = %v", err)
+	}
+	if sync.MemberCount != 1 || sync.DefaultRole != "member" {
+		t.Errorf("sync = %+v", sync)
+	}
+	members, err := c.PreviewTeamGroupSync(ctx, req)
+	if err != nil {
+		t.Fatalf("PreviewTeamGroupSync() error = %v", err)
+	}
+	if len(members) != 1 || members[0].UserID != "u1" || members[0].Groups[0] != "/eng/payments" {
+		t.Errorf("members = %+v", members)
+	}
+	if err := c.DeleteTeamGroupSync(ctx, "team-1"); err != nil {
+		t.Fatalf("DeleteTeamGroupSync() error = %v", err)
+	}
+
+	want := []string{
+		"PUT /api/v1/admin/teams/team-1/group-sync",
+		"GET /api/v1/admin/teams/team-1/group-sync",
+		"POST /api/v1/admin/teams/group-sync/preview",
+		"DELETE /api/v1/admin/teams/team-1/group-sync",
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("call %d = %q, want %q", i, calls[i], want[i])
+		}
+	}
+}
+
+func TestGroupPathWithin(t *testing.T) {
+	tests := []struct {
+		groupPath, root  string
+		includeSubgroups bool
+		want             bool
+	}{
+		{"/eng/payments", "/eng/payments", false, true},
+		{"/eng/payments/", "/eng/payments", false, true},
+		{"/eng/payments/leads", "/eng/payments", false, false},
+		{"/eng/payments/leads", "/eng/payments", true, true},
+		{"/eng/payments-ops", "/eng/payments", true, false},
+		{"/eng", "/eng/payments", true, false},
+	}
+	for _, tt := range tests {
+		if got := GroupPathWithin(tt.groupPath, tt.root, tt.includeSubgroups); got != tt.want {
+			t.Errorf("GroupPathWithin(%q, %q, %v) = %v, want %v", tt.groupPath, tt.root, tt.includeSubgroups, got, tt.want)
+		}
+	}
+}
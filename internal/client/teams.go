@@ -32,8 +32,13 @@ type TeamMember struct {
 	Role      string `json:"role,omitempty"`
 	CreatedAt string `json:"created_at,omitempty"`
 	CreatedBy string `json:"created_by,omitempty"`
+	Source    string `json:"source,omitempty"`
 }
 
+// TeamMemberSourceGroupSync is the TeamMember.Source of members added by a
+// team's group sync rule.
+const TeamMemberSourceGroupSync = "group_sync"
+
 // AddMemberRequest is the request to add a member to a team.
 type AddMemberRequest struct {
 	UserID string `json:"user_id"`
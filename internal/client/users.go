@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 )
 
 // DirectoryUser represents a user in the IdP directory.
@@ -65,3 +66,72 @@ func (c *Client) GetDirectoryUser(ctx context.Context, userID string) (*Director
 
 	return &user, nil
 }
+
+// UserDirectory is a snapshot of the IdP directory indexed by email, used to
+// resolve the user emails kept in configuration to directory user IDs.
+type UserDirectory struct {
+	byEmail map[string][]string
+	emails  map[string]string
+}
+
+// LoadUserDirectory lists all IdP users and indexes them by email. The
+// directory is listed once and cached for the lifetime of the client, so
+// resolving many emails during a plan does not repeat the listing.
+func (c *Client) LoadUserDirectory(ctx context.Context) (*UserDirectory, error) {
+	c.directoryMu.Lock()
+	defer c.directoryMu.Unlock()
+
+	if c.directory == nil {
+		users, err := c.ListDirectoryUsers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.directory = NewUserDirectory(users)
+	}
+	return c.directory, nil
+}
+
+// NewUserDirectory indexes users by email. Emails are compared case-insensitively.
+func NewUserDirectory(users []DirectoryUser) *UserDirectory {
+	d := &UserDirectory{
+		byEmail: make(map[string][]string, len(users)),
+		emails:  make(map[string]string, len(users)),
+	}
+	for _, u := range users {
+		d.emails[u.ID] = u.Email
+		if u.Email != "" {
+			key := strings.ToLower(u.Email)
+			d.byEmail[key] = append(d.byEmail[key], u.ID)
+		}
+	}
+	return d
+}
+
+// UserID returns the ID of the user with the given email. Returns ErrNotFound
+// if no user has the email, and an error if several users share it.
+func (d *UserDirectory) UserID(email string) (string, error) {
+	ids := d.byEmail[strings.ToLower(email)]
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("user with email %q: %w", email, ErrNotFound)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("email %q is shared by %d users (%s)", email, len(ids), strings.Join(ids, ", "))
+	}
+}
+
+// Email returns the email of the user with the given ID, or "" if the user
+// is not in the directory or has no email.
+func (d *UserDirectory) Email(userID string) string {
+	return d.emails[userID]
+}
+
+// ResolveUserEmail returns the ID of the IdP user with the given email.
+func (c *Client) ResolveUserEmail(ctx context.Context, email string) (string, error) {
+	d, err := c.LoadUserDirectory(ctx)
+	if err != nil {
+		return "", err
+	}
+	return d.UserID(email)
+}
@@ -182,3 +182,73 @@ func TestGetDirectoryUser_MalformedJSON(t *testing.T) {
 		t.Fatal("expected error for malformed JSON, got nil")
 	}
 }
+
+func TestUserDirectory_UserID(t *testing.T) {
+	d := NewUserDirectory([]DirectoryUser{
+		{ID: "u-1", Email: "alice@example.com"},
+		{ID: "u-2", Email: "Shared@example.com"},
+		{ID: "u-3", Email: "shared@example.com"},
+		{ID: "u-4"},
+	})
+
+	id, err := d.UserID("ALICE@example.com")
+	if err != nil || id != "u-1" {
+		t.Errorf("UserID(alice) = %q, %v; want u-1", id, err)
+	}
+	if _, err := d.UserID("bob@example.com"); !IsNotFound(err) {
+		t.Errorf("UserID(bob) error = %v, want not found", err)
+	}
+	if _, err := d.UserID("shared@example.com"); err == nil || IsNotFound(err) {
+		t.Errorf("UserID(shared) error = %v, want ambiguity error", err)
+	}
+	if got := d.Email("u-1"); got != "alice@example.com" {
+		t.Errorf("Email(u-1) = %q, want alice@example.com", got)
+	}
+	if got := d.Email("u-9"); got != "" {
+		t.Errorf("Email(u-9) = %q, want empty", got)
+	}
+}
+
+func TestResolveUserEmail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"items": []map[string]interface{}{{"id": "u-1", "email": "alice@example.com"}},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	id, err := c.ResolveUserEmail(context.Background(), "alice@example.com")
+	if err != nil {
+		t.Fatalf("ResolveUserEmail() error = %v", err)
+	}
+	if id != "u-1" {
+		t.Errorf("ID = %q, want %q", id, "u-1")
+	}
+}
+
+func TestResolveUserEmail_ListsDirectoryOnce(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"id": "u-1", "email": "alice@example.com"},
+				{"id": "u-2", "email": "bob@example.com"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "key", 30*time.Second)
+	for _, email := range []string{"alice@example.com", "bob@example.com", "alice@example.com"} {
+		if _, err := c.ResolveUserEmail(context.Background(), email); err != nil {
+			t.Fatalf("ResolveUserEmail(%q) error = %v", email, err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("requests = %d, want the directory listed once", requests)
+	}
+}
@@ -0,0 +1,208 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ datasource.DataSource = &ActiveAnnouncementDataSource{}
+
+// Where the active banner comes from.
+const (
+	announcementSourceScheduled = "scheduled"
+	announcementSourceSettings  = "settings"
+)
+
+// ActiveAnnouncementDataSource defines the data source implementation.
+type ActiveAnnouncementDataSource struct {
+	client *client.Client
+}
+
+// ActiveAnnouncementDataSourceModel describes the data source data model.
+type ActiveAnnouncementDataSourceModel struct {
+	Team        types.String `tfsdk:"team"`
+	Role        types.String `tfsdk:"role"`
+	At          types.String `tfsdk:"at"`
+	Active      types.Bool   `tfsdk:"active"`
+	Source      types.String `tfsdk:"source"`
+	ID          types.String `tfsdk:"id"`
+	Message     types.String `tfsdk:"message"`
+	Type        types.String `tfsdk:"type"`
+	LinkURL     types.String `tfsdk:"link_url"`
+	LinkText    types.String `tfsdk:"link_text"`
+	Dismissible types.Bool   `tfsdk:"dismissible"`
+	StartsAt    types.String `tfsdk:"starts_at"`
+	EndsAt      types.String `tfsdk:"ends_at"`
+}
+
+// NewActiveAnnouncementDataSource creates a new active announcement data source.
+func NewActiveAnnouncementDataSource() datasource.DataSource {
+	return &ActiveAnnouncementDataSource{}
+}
+
+func (d *ActiveAnnouncementDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_active_announcement"
+}
+
+func (d *ActiveAnnouncementDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Shows the announcement banner Shoehorn displays: the most recently started shoehorn_announcement whose window is open, or the tenant settings announcement when none is.",
+		Attributes: map[string]schema.Attribute{
+			"team": schema.StringAttribute{
+				Description: "Only consider announcements shown to members of this team (ID or slug).",
+				Optional:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "Only consider announcements shown to holders of this role. If neither team nor role is set, the audience is ignored.",
+				Optional:    true,
+			},
+			"at": schema.StringAttribute{
+				Description: "The RFC 3339 time to evaluate announcement windows at. Defaults to now.",
+				Optional:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether a banner is shown.",
+				Computed:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "Where the banner comes from: scheduled for a shoehorn_announcement, settings for the tenant settings announcement. Null when no banner is shown.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the scheduled announcement. Null for the settings announcement.",
+				Computed:    true,
+			},
+			"message": schema.StringAttribute{
+				Description: "The banner message.",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The banner type (info, warning, error, success).",
+				Computed:    true,
+			},
+			"link_url": schema.StringAttribute{
+				Description: "The call-to-action link URL.",
+				Computed:    true,
+			},
+			"link_text": schema.StringAttribute{
+				Description: "The call-to-action link text.",
+				Computed:    true,
+			},
+			"dismissible": schema.BoolAttribute{
+				Description: "Whether users can dismiss the banner.",
+				Computed:    true,
+			},
+			"starts_at": schema.StringAttribute{
+				Description: "When the scheduled announcement started being shown.",
+				Computed:    true,
+			},
+			"ends_at": schema.StringAttribute{
+				Description: "When the scheduled announcement stops being shown.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ActiveAnnouncementDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ActiveAnnouncementDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "reading active announcement data source")
+
+	var state ActiveAnnouncementDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now()
+	if !state.At.IsNull() {
+		at, err := time.Parse(time.RFC3339, state.At.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("at"), "Invalid Timestamp", fmt.Sprintf("%q is not an RFC 3339 timestamp.", state.At.ValueString()))
+			return
+		}
+		now = at
+	}
+
+	announcements, err := d.client.ListAnnouncements(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Announcements", fmt.Sprintf("Could not list announcements: %s", err))
+		return
+	}
+
+	source := announcementSourceScheduled
+	active := client.ActiveAnnouncement(announcements, now, state.Team.ValueString(), state.Role.ValueString())
+	if active == nil {
+		settings, err := d.client.GetSettings(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Tenant Settings", fmt.Sprintf("Could not read the settings announcement: %s", err))
+			return
+		}
+		if banner := settings.Announcement; banner.Enabled && banner.Message != "" {
+			source = announcementSourceSettings
+			active = &client.Announcement{
+				Message:     banner.Message,
+				Type:        banner.Type,
+				LinkURL:     banner.LinkURL,
+				LinkText:    banner.LinkText,
+				Dismissible: !banner.Pinned,
+			}
+		}
+	}
+
+	mapActiveAnnouncement(active, source, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// mapActiveAnnouncement sets the computed attributes from the shown banner,
+// or to null when a is nil.
+func mapActiveAnnouncement(a *client.Announcement, source string, state *ActiveAnnouncementDataSourceModel) {
+	optional := func(s string) types.String {
+		if s == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(s)
+	}
+
+	if a == nil {
+		state.Active = types.BoolValue(false)
+		state.Source = types.StringNull()
+		state.Dismissible = types.BoolNull()
+		a = &client.Announcement{}
+	} else {
+		state.Active = types.BoolValue(true)
+		state.Source = types.StringValue(source)
+		state.Dismissible = types.BoolValue(a.Dismissible)
+	}
+	state.ID = optional(a.ID)
+	state.Message = optional(a.Message)
+	state.Type = optional(a.Type)
+	state.LinkURL = optional(a.LinkURL)
+	state.LinkText = optional(a.LinkText)
+	state.StartsAt = optional(a.StartsAt)
+	state.EndsAt = optional(a.EndsAt)
+}
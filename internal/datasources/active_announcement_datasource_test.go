@@ -0,0 +1,68 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func TestActiveAnnouncementDataSource_Metadata(t *testing.T) {
+	d := NewActiveAnnouncementDataSource()
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_active_announcement" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_active_announcement")
+	}
+}
+
+func TestActiveAnnouncementDataSource_Schema(t *testing.T) {
+	d := NewActiveAnnouncementDataSource()
+	resp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	attrs := resp.Schema.Attributes
+	for _, name := range []string{"team", "role", "at", "active", "source", "id", "message", "type", "link_url", "link_text", "dismissible", "starts_at", "ends_at"} {
+		if _, ok := attrs[name]; !ok {
+			t.Errorf("schema missing attribute %q", name)
+		}
+	}
+	for _, name := range []string{"team", "role", "at"} {
+		if !attrs[name].IsOptional() {
+			t.Errorf("%s should be optional", name)
+		}
+	}
+}
+
+func TestActiveAnnouncementDataSource_Configure_WithValidClient(t *testing.T) {
+	d := &ActiveAnnouncementDataSource{}
+	c := client.NewClient("https://test.example.com", "key", 30*time.Second)
+
+	resp := &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: c,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if d.client != c {
+		t.Error("client not set correctly")
+	}
+}
+
+func TestActiveAnnouncementDataSource_Configure_WrongType(t *testing.T) {
+	d := &ActiveAnnouncementDataSource{}
+
+	resp := &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: "not a client",
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected error for wrong provider data type")
+	}
+}
@@ -0,0 +1,205 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ datasource.DataSource = &ForgeApprovalPolicyDataSource{}
+
+// ForgeApprovalPolicyDataSource defines the data source implementation.
+type ForgeApprovalPolicyDataSource struct {
+	client *client.Client
+}
+
+// ForgeApprovalPolicyDataSourceModel describes the data source data model.
+type ForgeApprovalPolicyDataSourceModel struct {
+	MoldSlug         types.String              `tfsdk:"mold_slug"`
+	Team             types.String              `tfsdk:"team"`
+	Found            types.Bool                `tfsdk:"found"`
+	ID               types.String              `tfsdk:"id"`
+	Name             types.String              `tfsdk:"name"`
+	Description      types.String              `tfsdk:"description"`
+	Priority         types.Int64               `tfsdk:"priority"`
+	AutoApproveAfter types.Int64               `tfsdk:"auto_approve_after"`
+	Steps            []ApprovalPolicyStepModel `tfsdk:"steps"`
+}
+
+// ApprovalPolicyStepModel describes a single step of the resolved approval policy.
+type ApprovalPolicyStepModel struct {
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Approvers     types.List   `tfsdk:"approvers"`
+	RequiredCount types.Int64  `tfsdk:"required_count"`
+}
+
+// NewForgeApprovalPolicyDataSource creates a new forge approval policy data source.
+func NewForgeApprovalPolicyDataSource() datasource.DataSource {
+	return &ForgeApprovalPolicyDataSource{}
+}
+
+func (d *ForgeApprovalPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_approval_policy"
+}
+
+func (d *ForgeApprovalPolicyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resolves the Forge approval policy that governs runs of a mold. Among the enabled policies whose match criteria apply, the one with the highest priority wins.",
+		Attributes: map[string]schema.Attribute{
+			"mold_slug": schema.StringAttribute{
+				Description: "The slug of the mold to resolve the governing policy for.",
+				Required:    true,
+			},
+			"team": schema.StringAttribute{
+				Description: "The slug of the requesting team. Policies restricted to specific teams only match when this is set.",
+				Optional:    true,
+			},
+			"found": schema.BoolAttribute{
+				Description: "Whether any enabled policy applies to the mold. When false, runs of the mold need no approval.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the governing policy.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the governing policy.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the governing policy.",
+				Computed:    true,
+			},
+			"priority": schema.Int64Attribute{
+				Description: "The priority of the governing policy.",
+				Computed:    true,
+			},
+			"auto_approve_after": schema.Int64Attribute{
+				Description: "The auto-approval delay configured on the governing policy. 0 means runs are never approved automatically.",
+				Computed:    true,
+			},
+			"steps": schema.ListNestedAttribute{
+				Description: "The approval chain of the governing policy.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the approval step.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "A description of the approval step.",
+							Computed:    true,
+						},
+						"approvers": schema.ListAttribute{
+							Description: "The approvers for this step.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"required_count": schema.Int64Attribute{
+							Description: "Number of approvers required. 0 means all must approve.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ForgeApprovalPolicyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ForgeApprovalPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "reading forge approval policy data source")
+
+	var config ForgeApprovalPolicyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slug := config.MoldSlug.ValueString()
+	mold, err := d.client.GetForgeMold(ctx, slug)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Forge Mold", fmt.Sprintf("Could not read forge mold %s: %s", slug, err))
+		return
+	}
+
+	policies, err := d.client.ListApprovalPolicies(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Forge Approval Policies", fmt.Sprintf("Could not list approval policies: %s", err))
+		return
+	}
+
+	state := ForgeApprovalPolicyDataSourceModel{
+		MoldSlug: config.MoldSlug,
+		Team:     config.Team,
+		Steps:    []ApprovalPolicyStepModel{},
+	}
+
+	policy := client.ResolveApprovalPolicy(policies, mold, config.Team.ValueString())
+	if policy == nil {
+		state.Found = types.BoolValue(false)
+		state.ID = types.StringNull()
+		state.Name = types.StringNull()
+		state.Description = types.StringNull()
+		state.Priority = types.Int64Null()
+		state.AutoApproveAfter = types.Int64Null()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	state.Found = types.BoolValue(true)
+	state.ID = types.StringValue(policy.ID)
+	state.Name = types.StringValue(policy.Name)
+	state.Priority = types.Int64Value(int64(policy.Priority))
+	state.AutoApproveAfter = types.Int64Value(int64(policy.AutoApproveAfter))
+	if policy.Description != "" {
+		state.Description = types.StringValue(policy.Description)
+	} else {
+		state.Description = types.StringNull()
+	}
+
+	for _, s := range policy.ApprovalChain {
+		approvers, diags := types.ListValueFrom(ctx, types.StringType, s.Approvers)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		step := ApprovalPolicyStepModel{
+			Name:          types.StringValue(s.Name),
+			Approvers:     approvers,
+			RequiredCount: types.Int64Value(int64(s.RequiredCount)),
+		}
+		if s.Description != "" {
+			step.Description = types.StringValue(s.Description)
+		} else {
+			step.Description = types.StringNull()
+		}
+		state.Steps = append(state.Steps, step)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,66 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func TestForgeApprovalPolicyDataSource_Metadata(t *testing.T) {
+	d := NewForgeApprovalPolicyDataSource()
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_forge_approval_policy" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_forge_approval_policy")
+	}
+}
+
+func TestForgeApprovalPolicyDataSource_Schema_HasExpectedAttributes(t *testing.T) {
+	d := NewForgeApprovalPolicyDataSource()
+	resp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	expectedAttrs := []string{"mold_slug", "team", "found", "id", "name", "description", "priority", "auto_approve_after", "steps"}
+	for _, name := range expectedAttrs {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("schema missing %q attribute", name)
+		}
+	}
+	if !resp.Schema.Attributes["mold_slug"].IsRequired() {
+		t.Error("mold_slug should be required")
+	}
+}
+
+func TestForgeApprovalPolicyDataSource_Configure_WithValidClient(t *testing.T) {
+	d := &ForgeApprovalPolicyDataSource{}
+	c := client.NewClient("https://test.example.com", "key", 30*time.Second)
+
+	resp := &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: c,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if d.client != c {
+		t.Error("client not set correctly")
+	}
+}
+
+func TestForgeApprovalPolicyDataSource_Configure_WrongType(t *testing.T) {
+	d := &ForgeApprovalPolicyDataSource{}
+
+	resp := &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: "not a client",
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected error for wrong provider data type")
+	}
+}
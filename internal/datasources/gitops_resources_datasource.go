@@ -24,6 +24,12 @@ type GitOpsResourcesDataSourceModel struct {
 	Tool         types.String           `tfsdk:"tool"`
 	SyncStatus   types.String           `tfsdk:"sync_status"`
 	HealthStatus types.String           `tfsdk:"health_status"`
+	EntityID     types.String           `tfsdk:"entity_id"`
+	OwnerTeam    types.String           `tfsdk:"owner_team"`
+	Namespace    types.String           `tfsdk:"namespace"`
+	Kind         types.String           `tfsdk:"kind"`
+	Suspended    types.Bool             `tfsdk:"suspended"`
+	AutoSync     types.Bool             `tfsdk:"auto_sync"`
 	Total        types.Int64            `tfsdk:"total"`
 	Resources    []GitOpsResourceModel  `tfsdk:"resources"`
 }
@@ -78,8 +84,32 @@ func (d *GitOpsResourcesDataSource) Schema(_ context.Context, _ datasource.Schem
 				Description: "Filter resources by health status.",
 				Optional:    true,
 			},
+			"entity_id": schema.StringAttribute{
+				Description: "Filter resources by linked Shoehorn entity ID.",
+				Optional:    true,
+			},
+			"owner_team": schema.StringAttribute{
+				Description: "Filter resources by owning team.",
+				Optional:    true,
+			},
+			"namespace": schema.StringAttribute{
+				Description: "Filter resources by Kubernetes namespace.",
+				Optional:    true,
+			},
+			"kind": schema.StringAttribute{
+				Description: "Filter resources by kind (Application, Kustomization, HelmRelease).",
+				Optional:    true,
+			},
+			"suspended": schema.BoolAttribute{
+				Description: "Filter resources by whether they are suspended.",
+				Optional:    true,
+			},
+			"auto_sync": schema.BoolAttribute{
+				Description: "Filter resources by whether auto-sync is enabled.",
+				Optional:    true,
+			},
 			"total": schema.Int64Attribute{
-				Description: "Total number of resources matching the filters.",
+				Description: "Total number of resources matching the filters. All pages are fetched.",
 				Computed:    true,
 			},
 			"resources": schema.ListNestedAttribute{
@@ -201,6 +231,26 @@ func (d *GitOpsResourcesDataSource) Read(ctx context.Context, req datasource.Rea
 	if !config.HealthStatus.IsNull() && !config.HealthStatus.IsUnknown() {
 		params.HealthStatus = config.HealthStatus.ValueString()
 	}
+	if !config.EntityID.IsNull() && !config.EntityID.IsUnknown() {
+		params.EntityID = config.EntityID.ValueString()
+	}
+	if !config.OwnerTeam.IsNull() && !config.OwnerTeam.IsUnknown() {
+		params.OwnerTeam = config.OwnerTeam.ValueString()
+	}
+	if !config.Namespace.IsNull() && !config.Namespace.IsUnknown() {
+		params.Namespace = config.Namespace.ValueString()
+	}
+	if !config.Kind.IsNull() && !config.Kind.IsUnknown() {
+		params.Kind = config.Kind.ValueString()
+	}
+	if !config.Suspended.IsNull() && !config.Suspended.IsUnknown() {
+		v := config.Suspended.ValueBool()
+		params.Suspended = &v
+	}
+	if !config.AutoSync.IsNull() && !config.AutoSync.IsUnknown() {
+		v := config.AutoSync.ValueBool()
+		params.AutoSync = &v
+	}
 
 	resources, total, err := d.client.ListGitOpsResources(ctx, params)
 	if err != nil {
@@ -213,6 +263,12 @@ func (d *GitOpsResourcesDataSource) Read(ctx context.Context, req datasource.Rea
 		Tool:         config.Tool,
 		SyncStatus:   config.SyncStatus,
 		HealthStatus: config.HealthStatus,
+		EntityID:     config.EntityID,
+		OwnerTeam:    config.OwnerTeam,
+		Namespace:    config.Namespace,
+		Kind:         config.Kind,
+		Suspended:    config.Suspended,
+		AutoSync:     config.AutoSync,
 		Total:        types.Int64Value(int64(total)),
 	}
 
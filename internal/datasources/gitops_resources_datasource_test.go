@@ -25,7 +25,8 @@ func TestGitOpsResourcesDataSource_Schema_HasExpectedAttributes(t *testing.T) {
 	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
 
 	expectedAttrs := []string{
-		"cluster_id", "tool", "sync_status", "health_status", "total", "resources",
+		"cluster_id", "tool", "sync_status", "health_status", "entity_id", "owner_team",
+		"namespace", "kind", "suspended", "auto_sync", "total", "resources",
 	}
 	for _, name := range expectedAttrs {
 		if _, ok := resp.Schema.Attributes[name]; !ok {
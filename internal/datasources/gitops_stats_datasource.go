@@ -0,0 +1,120 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ datasource.DataSource = &GitOpsStatsDataSource{}
+
+// GitOpsStatsDataSource defines the data source implementation.
+type GitOpsStatsDataSource struct {
+	client *client.Client
+}
+
+// GitOpsStatsDataSourceModel describes the data source data model.
+type GitOpsStatsDataSourceModel struct {
+	ClusterID types.String `tfsdk:"cluster_id"`
+	Total     types.Int64  `tfsdk:"total"`
+	Synced    types.Int64  `tfsdk:"synced"`
+	OutOfSync types.Int64  `tfsdk:"out_of_sync"`
+	Failed    types.Int64  `tfsdk:"failed"`
+	Suspended types.Int64  `tfsdk:"suspended"`
+	Unknown   types.Int64  `tfsdk:"unknown"`
+}
+
+// NewGitOpsStatsDataSource creates a new GitOps stats data source.
+func NewGitOpsStatsDataSource() datasource.DataSource {
+	return &GitOpsStatsDataSource{}
+}
+
+func (d *GitOpsStatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gitops_stats"
+}
+
+func (d *GitOpsStatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves aggregate sync statistics for GitOps resources pushed by K8s agents.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Description: "Only count resources in this cluster. Defaults to all clusters.",
+				Optional:    true,
+			},
+			"total": schema.Int64Attribute{
+				Description: "Total number of GitOps resources.",
+				Computed:    true,
+			},
+			"synced": schema.Int64Attribute{
+				Description: "Number of resources in sync with their source.",
+				Computed:    true,
+			},
+			"out_of_sync": schema.Int64Attribute{
+				Description: "Number of resources out of sync with their source.",
+				Computed:    true,
+			},
+			"failed": schema.Int64Attribute{
+				Description: "Number of resources whose sync failed or that are degraded.",
+				Computed:    true,
+			},
+			"suspended": schema.Int64Attribute{
+				Description: "Number of suspended resources.",
+				Computed:    true,
+			},
+			"unknown": schema.Int64Attribute{
+				Description: "Number of resources with an unknown status.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *GitOpsStatsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *GitOpsStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "reading gitops stats data source")
+
+	var config GitOpsStatsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stats, err := d.client.GetGitOpsClusterStats(ctx, config.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading GitOps Stats", fmt.Sprintf("Could not read gitops stats: %s", err))
+		return
+	}
+
+	state := GitOpsStatsDataSourceModel{
+		ClusterID: config.ClusterID,
+		Total:     types.Int64Value(int64(stats.Total)),
+		Synced:    types.Int64Value(int64(stats.Synced)),
+		OutOfSync: types.Int64Value(int64(stats.OutOfSync)),
+		Failed:    types.Int64Value(int64(stats.Failed)),
+		Suspended: types.Int64Value(int64(stats.Suspended)),
+		Unknown:   types.Int64Value(int64(stats.Unknown)),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
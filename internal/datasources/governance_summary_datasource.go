@@ -0,0 +1,238 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ datasource.DataSource = &GovernanceSummaryDataSource{}
+
+// GovernanceSummaryDataSource defines the data source implementation.
+type GovernanceSummaryDataSource struct {
+	client *client.Client
+	now    func() time.Time
+}
+
+// GovernanceSummaryDataSourceModel describes the data source data model.
+type GovernanceSummaryDataSourceModel struct {
+	EntityID           types.String `tfsdk:"entity_id"`
+	SourceType         types.String `tfsdk:"source_type"`
+	Total              types.Int64  `tfsdk:"total"`
+	ByStatus           types.Map    `tfsdk:"by_status"`
+	ByPriority         types.Map    `tfsdk:"by_priority"`
+	BySourceType       types.Map    `tfsdk:"by_source_type"`
+	Overdue            types.Int64  `tfsdk:"overdue"`
+	CriticalOverdue    types.Int64  `tfsdk:"critical_overdue"`
+	OverdueByPriority  types.Map    `tfsdk:"overdue_by_priority"`
+	OverdueByEntity    types.Map    `tfsdk:"overdue_by_entity"`
+	OverdueByOwnerTeam types.Map    `tfsdk:"overdue_by_owner_team"`
+}
+
+// overdueCounts holds the overdue breakdowns computed from individual actions.
+type overdueCounts struct {
+	total      int
+	byPriority map[string]int
+	byEntity   map[string]int
+	byTeam     map[string]int
+}
+
+// NewGovernanceSummaryDataSource creates a new governance summary data source.
+func NewGovernanceSummaryDataSource() datasource.DataSource {
+	return &GovernanceSummaryDataSource{now: time.Now}
+}
+
+func (d *GovernanceSummaryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_governance_summary"
+}
+
+func (d *GovernanceSummaryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	countMap := func(description string) schema.MapAttribute {
+		return schema.MapAttribute{
+			Description: description,
+			ElementType: types.Int64Type,
+			Computed:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Summarizes governance actions and SLA breaches. Overdue counts only include " +
+			"actions that are not closed and are past their due date, or past created_at plus " +
+			"sla_days when the API reports no due date.",
+		Attributes: map[string]schema.Attribute{
+			"entity_id": schema.StringAttribute{
+				Description: "Only summarize actions for this entity ID.",
+				Optional:    true,
+			},
+			"source_type": schema.StringAttribute{
+				Description: "Only summarize actions from this source type (scorecard, security, policy).",
+				Optional:    true,
+			},
+			"total": schema.Int64Attribute{
+				Description: "Total number of actions matching the filters.",
+				Computed:    true,
+			},
+			"by_status":      countMap("Number of actions per status."),
+			"by_priority":    countMap("Number of actions per priority."),
+			"by_source_type": countMap("Number of actions per source type."),
+			"overdue": schema.Int64Attribute{
+				Description: "Number of open actions past their due date.",
+				Computed:    true,
+			},
+			"critical_overdue": schema.Int64Attribute{
+				Description: "Number of overdue actions with critical priority.",
+				Computed:    true,
+			},
+			"overdue_by_priority": countMap("Number of overdue actions per priority."),
+			"overdue_by_entity":   countMap("Number of overdue actions per entity ID."),
+			"overdue_by_owner_team": countMap("Number of overdue actions per owning team of the entity. " +
+				"Actions on entities without a team owner are not included."),
+		},
+	}
+}
+
+func (d *GovernanceSummaryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *GovernanceSummaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "reading governance summary data source")
+
+	var config GovernanceSummaryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filters *client.GovernanceActionFilters
+	if !config.EntityID.IsNull() || !config.SourceType.IsNull() {
+		filters = &client.GovernanceActionFilters{
+			EntityID:   config.EntityID.ValueString(),
+			SourceType: config.SourceType.ValueString(),
+		}
+	}
+
+	actions, summary, err := d.client.ListGovernanceActionsWithSummary(ctx, filters)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Governance Summary", fmt.Sprintf("Could not list governance actions: %s", err))
+		return
+	}
+
+	// Owner teams come from the catalog, so only look them up when something is overdue.
+	now := d.now()
+	var owners map[string]string
+	for i := range actions {
+		if actions[i].IsOverdue(now) {
+			entities, err := d.client.ListEntities(ctx)
+			if err != nil {
+				resp.Diagnostics.AddError("Error Reading Governance Summary", fmt.Sprintf("Could not list entities to resolve owner teams: %s", err))
+				return
+			}
+			owners = entityOwnerTeams(entities)
+			break
+		}
+	}
+
+	overdue := countOverdue(actions, owners, now)
+
+	state := GovernanceSummaryDataSourceModel{
+		EntityID:        config.EntityID,
+		SourceType:      config.SourceType,
+		Total:           types.Int64Value(int64(summary.Total)),
+		Overdue:         types.Int64Value(int64(overdue.total)),
+		CriticalOverdue: types.Int64Value(int64(overdue.byPriority["critical"])),
+	}
+	state.ByStatus = countMapValue(summary.ByStatus, &resp.Diagnostics)
+	state.ByPriority = countMapValue(summary.ByPriority, &resp.Diagnostics)
+	state.BySourceType = countMapValue(summary.BySourceType, &resp.Diagnostics)
+	state.OverdueByPriority = countMapValue(overdue.byPriority, &resp.Diagnostics)
+	state.OverdueByEntity = countMapValue(overdue.byEntity, &resp.Diagnostics)
+	state.OverdueByOwnerTeam = countMapValue(overdue.byTeam, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// countOverdue tallies the actions that are overdue at now. owners maps entity
+// IDs to their owning team.
+func countOverdue(actions []client.GovernanceAction, owners map[string]string, now time.Time) overdueCounts {
+	counts := overdueCounts{
+		byPriority: map[string]int{},
+		byEntity:   map[string]int{},
+		byTeam:     map[string]int{},
+	}
+	for i := range actions {
+		a := &actions[i]
+		if !a.IsOverdue(now) {
+			continue
+		}
+		counts.total++
+		counts.byPriority[a.Priority]++
+		counts.byEntity[a.EntityID]++
+		if team := lookupOwnerTeam(owners, a.EntityID); team != "" {
+			counts.byTeam[team]++
+		}
+	}
+	return counts
+}
+
+// entityOwnerTeams maps each catalog entity ID to the ID of its first team owner.
+func entityOwnerTeams(entities []client.EntityListItem) map[string]string {
+	owners := make(map[string]string, len(entities))
+	for _, e := range entities {
+		for _, o := range e.Owner {
+			if strings.EqualFold(o.Type, "team") && o.ID != "" {
+				owners[e.Service.ID] = o.ID
+				break
+			}
+		}
+	}
+	return owners
+}
+
+// lookupOwnerTeam finds the owning team for a governance entity ID. Governance
+// actions may reference entities as "<type>:<id>", so the bare ID is tried too.
+func lookupOwnerTeam(owners map[string]string, entityID string) string {
+	if team, ok := owners[entityID]; ok {
+		return team
+	}
+	if i := strings.LastIndex(entityID, ":"); i >= 0 {
+		return owners[entityID[i+1:]]
+	}
+	return ""
+}
+
+// countMapValue converts a map of counts into a Terraform map of numbers.
+func countMapValue(counts map[string]int, diags *diag.Diagnostics) types.Map {
+	values := make(map[string]attr.Value, len(counts))
+	for k, v := range counts {
+		values[k] = types.Int64Value(int64(v))
+	}
+	m, d := types.MapValue(types.Int64Type, values)
+	diags.Append(d...)
+	return m
+}
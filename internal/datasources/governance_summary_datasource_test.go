@@ -0,0 +1,87 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func TestGovernanceSummaryDataSource_Metadata(t *testing.T) {
+	d := NewGovernanceSummaryDataSource()
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_governance_summary" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_governance_summary")
+	}
+}
+
+func TestGovernanceSummaryDataSource_Schema_HasExpectedAttributes(t *testing.T) {
+	d := NewGovernanceSummaryDataSource()
+	resp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	expectedAttrs := []string{
+		"entity_id", "source_type", "total", "by_status", "by_priority", "by_source_type",
+		"overdue", "critical_overdue", "overdue_by_priority", "overdue_by_entity", "overdue_by_owner_team",
+	}
+	for _, name := range expectedAttrs {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("schema missing %q attribute", name)
+		}
+	}
+}
+
+func TestGovernanceSummaryDataSource_Configure_WrongType(t *testing.T) {
+	d := &GovernanceSummaryDataSource{}
+
+	resp := &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: "not a client",
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected error for wrong provider data type")
+	}
+}
+
+func TestCountOverdue(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	sla := 5
+
+	actions := []client.GovernanceAction{
+		// Past its due date.
+		{ID: "a1", EntityID: "service:payments", Priority: "critical", Status: "open", DueDate: "2026-03-01T00:00:00Z"},
+		// No due date, but created_at + sla_days has passed.
+		{ID: "a2", EntityID: "payments", Priority: "high", Status: "in_progress", SLADays: &sla, CreatedAt: "2026-03-01T00:00:00Z"},
+		// Closed actions are never overdue.
+		{ID: "a3", EntityID: "payments", Priority: "critical", Status: "resolved", DueDate: "2026-03-01T00:00:00Z"},
+		// Not due yet.
+		{ID: "a4", EntityID: "search", Priority: "critical", Status: "open", DueDate: "2026-04-01"},
+		// Overdue on an entity without a team owner.
+		{ID: "a5", EntityID: "legacy", Priority: "low", Status: "open", DueDate: "2026-03-09"},
+	}
+	owners := entityOwnerTeams([]client.EntityListItem{
+		{Service: client.EntityService{ID: "payments"}, Owner: []client.OwnerInfo{{Type: "user", ID: "alice"}, {Type: "team", ID: "team-payments"}}},
+		{Service: client.EntityService{ID: "search"}, Owner: []client.OwnerInfo{{Type: "team", ID: "team-search"}}},
+		{Service: client.EntityService{ID: "legacy"}, Owner: []client.OwnerInfo{{Type: "user", ID: "bob"}}},
+	})
+
+	got := countOverdue(actions, owners, now)
+
+	if got.total != 3 {
+		t.Errorf("total = %d, want 3", got.total)
+	}
+	if got.byPriority["critical"] != 1 || got.byPriority["high"] != 1 || got.byPriority["low"] != 1 {
+		t.Errorf("byPriority = %v", got.byPriority)
+	}
+	if got.byEntity["service:payments"] != 1 || got.byEntity["payments"] != 1 || got.byEntity["legacy"] != 1 {
+		t.Errorf("byEntity = %v", got.byEntity)
+	}
+	if len(got.byTeam) != 1 || got.byTeam["team-payments"] != 2 {
+		t.Errorf("byTeam = %v, want map[team-payments:2]", got.byTeam)
+	}
+}
@@ -0,0 +1,182 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ datasource.DataSource = &MarketplaceItemDataSource{}
+
+// MarketplaceItemDataSource defines the data source implementation.
+type MarketplaceItemDataSource struct {
+	client *client.Client
+}
+
+// MarketplaceItemDataSourceModel describes the data source data model.
+type MarketplaceItemDataSourceModel struct {
+	Slug              types.String                  `tfsdk:"slug"`
+	VersionConstraint types.String                  `tfsdk:"version_constraint"`
+	Kind              types.String                  `tfsdk:"kind"`
+	Name              types.String                  `tfsdk:"name"`
+	Description       types.String                  `tfsdk:"description"`
+	Category          types.String                  `tfsdk:"category"`
+	LatestVersion     types.String                  `tfsdk:"latest_version"`
+	MatchingVersion   types.String                  `tfsdk:"matching_version"`
+	Changelog         types.String                  `tfsdk:"changelog"`
+	Versions          []MarketplaceItemVersionModel `tfsdk:"versions"`
+}
+
+// MarketplaceItemVersionModel describes a single published version of a marketplace item.
+type MarketplaceItemVersionModel struct {
+	Version    types.String `tfsdk:"version"`
+	ReleasedAt types.String `tfsdk:"released_at"`
+	Changelog  types.String `tfsdk:"changelog"`
+}
+
+// NewMarketplaceItemDataSource creates a new marketplace item data source.
+func NewMarketplaceItemDataSource() datasource.DataSource {
+	return &MarketplaceItemDataSource{}
+}
+
+func (d *MarketplaceItemDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_marketplace_item"
+}
+
+func (d *MarketplaceItemDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves a single marketplace catalog item with its available versions and changelog.",
+		Attributes: map[string]schema.Attribute{
+			"slug": schema.StringAttribute{
+				Description: "The unique slug of the marketplace item.",
+				Required:    true,
+			},
+			"version_constraint": schema.StringAttribute{
+				Description: "Optional version constraint, in the same format as shoehorn_marketplace_installation.version_constraint, used to compute matching_version.",
+				Optional:    true,
+			},
+			"kind": schema.StringAttribute{
+				Description: "The kind of marketplace item.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The display name of the marketplace item.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the marketplace item.",
+				Computed:    true,
+			},
+			"category": schema.StringAttribute{
+				Description: "The category of the marketplace item.",
+				Computed:    true,
+			},
+			"latest_version": schema.StringAttribute{
+				Description: "The current catalog version of the marketplace item.",
+				Computed:    true,
+			},
+			"matching_version": schema.StringAttribute{
+				Description: "The newest version satisfying version_constraint. Null when no constraint is set.",
+				Computed:    true,
+			},
+			"changelog": schema.StringAttribute{
+				Description: "The changelog of matching_version, or of latest_version when no constraint is set.",
+				Computed:    true,
+			},
+			"versions": schema.ListNestedAttribute{
+				Description: "The published versions of the marketplace item.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							Description: "The version number.",
+							Computed:    true,
+						},
+						"released_at": schema.StringAttribute{
+							Description: "The release timestamp.",
+							Computed:    true,
+						},
+						"changelog": schema.StringAttribute{
+							Description: "The changes introduced in this version.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MarketplaceItemDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *MarketplaceItemDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "reading marketplace item data source")
+
+	var config MarketplaceItemDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slug := config.Slug.ValueString()
+	item, err := d.client.GetMarketplaceItem(ctx, slug)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Marketplace Item", fmt.Sprintf("Could not read marketplace item %q: %s", slug, err))
+		return
+	}
+
+	state := MarketplaceItemDataSourceModel{
+		Slug:              config.Slug,
+		VersionConstraint: config.VersionConstraint,
+		Kind:              types.StringValue(item.Kind),
+		Name:              types.StringValue(item.Name),
+		Description:       types.StringValue(item.Description),
+		Category:          types.StringValue(item.Category),
+		LatestVersion:     types.StringValue(item.Version),
+		MatchingVersion:   types.StringNull(),
+		Versions:          []MarketplaceItemVersionModel{},
+	}
+
+	changelogVersion := item.Version
+	if !config.VersionConstraint.IsNull() && !config.VersionConstraint.IsUnknown() {
+		matching, err := item.LatestMatchingVersion(config.VersionConstraint.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("version_constraint"), "No Matching Marketplace Item Version", err.Error())
+			return
+		}
+		state.MatchingVersion = types.StringValue(matching)
+		changelogVersion = matching
+	}
+	state.Changelog = types.StringValue(item.Changelog(changelogVersion))
+
+	for _, v := range item.Versions {
+		state.Versions = append(state.Versions, MarketplaceItemVersionModel{
+			Version:    types.StringValue(v.Version),
+			ReleasedAt: types.StringValue(v.ReleasedAt),
+			Changelog:  types.StringValue(v.Changelog),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,69 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func TestMarketplaceItemDataSource_Metadata(t *testing.T) {
+	d := NewMarketplaceItemDataSource()
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_marketplace_item" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_marketplace_item")
+	}
+}
+
+func TestMarketplaceItemDataSource_Schema_HasExpectedAttributes(t *testing.T) {
+	d := NewMarketplaceItemDataSource()
+	resp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	expectedAttrs := []string{
+		"slug", "version_constraint", "kind", "name", "description", "category",
+		"latest_version", "matching_version", "changelog", "versions",
+	}
+	for _, name := range expectedAttrs {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("schema missing %q attribute", name)
+		}
+	}
+	if !resp.Schema.Attributes["slug"].IsRequired() {
+		t.Error("slug should be required")
+	}
+}
+
+func TestMarketplaceItemDataSource_Configure_WithValidClient(t *testing.T) {
+	d := &MarketplaceItemDataSource{}
+	c := client.NewClient("https://test.example.com", "key", 30*time.Second)
+
+	resp := &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: c,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if d.client != c {
+		t.Error("client not set correctly")
+	}
+}
+
+func TestMarketplaceItemDataSource_Configure_WrongType(t *testing.T) {
+	d := &MarketplaceItemDataSource{}
+
+	resp := &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: "not a client",
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected error for wrong provider data type")
+	}
+}
@@ -0,0 +1,144 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ datasource.DataSource = &RolesDataSource{}
+
+// RolesDataSource defines the data source implementation.
+type RolesDataSource struct {
+	client *client.Client
+}
+
+// RolesDataSourceModel describes the data source data model.
+type RolesDataSourceModel struct {
+	Roles []RoleModel `tfsdk:"roles"`
+}
+
+// RoleModel describes a single role in the list.
+type RoleModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Description types.String `tfsdk:"description"`
+	Color       types.String `tfsdk:"color"`
+	Icon        types.String `tfsdk:"icon"`
+	Permissions []string     `tfsdk:"permissions"`
+	System      types.Bool   `tfsdk:"system"`
+}
+
+// NewRolesDataSource creates a new roles data source.
+func NewRolesDataSource() datasource.DataSource {
+	return &RolesDataSource{}
+}
+
+func (d *RolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+func (d *RolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all Shoehorn roles (permission bundles), both built-in and custom, with their permissions.",
+		Attributes: map[string]schema.Attribute{
+			"roles": schema.ListNestedAttribute{
+				Description: "The list of roles, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the role.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The role name used in role assignments and group mappings.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "The human-readable name of the role.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The role description.",
+							Computed:    true,
+						},
+						"color": schema.StringAttribute{
+							Description: "The badge color of the role.",
+							Computed:    true,
+						},
+						"icon": schema.StringAttribute{
+							Description: "The icon of the role.",
+							Computed:    true,
+						},
+						"permissions": schema.ListAttribute{
+							Description: "The permissions granted by the role.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"system": schema.BoolAttribute{
+							Description: "Whether the role is built in. Built-in roles cannot be managed with shoehorn_role.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *RolesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "reading roles data source")
+
+	bundles, err := d.client.ListBundles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Roles", fmt.Sprintf("Could not list roles: %s", err))
+		return
+	}
+
+	sort.Slice(bundles, func(i, j int) bool { return bundles[i].Name < bundles[j].Name })
+
+	state := RolesDataSourceModel{Roles: []RoleModel{}}
+	for _, b := range bundles {
+		permissions := b.Permissions
+		if permissions == nil {
+			permissions = []string{}
+		}
+		state.Roles = append(state.Roles, RoleModel{
+			ID:          types.StringValue(b.ID),
+			Name:        types.StringValue(b.Name),
+			DisplayName: types.StringValue(b.DisplayName),
+			Description: types.StringValue(b.Description),
+			Color:       types.StringValue(b.Color),
+			Icon:        types.StringValue(b.Icon),
+			Permissions: permissions,
+			System:      types.BoolValue(b.System),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
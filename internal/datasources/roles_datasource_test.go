@@ -0,0 +1,67 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func TestRolesDataSource_Metadata(t *testing.T) {
+	d := NewRolesDataSource()
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_roles" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_roles")
+	}
+}
+
+func TestRolesDataSource_Schema_HasRoleAttributes(t *testing.T) {
+	d := NewRolesDataSource()
+	resp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	roles, ok := resp.Schema.Attributes["roles"].(schema.ListNestedAttribute)
+	if !ok {
+		t.Fatal("schema missing 'roles' list attribute")
+	}
+	for _, name := range []string{"id", "name", "display_name", "description", "color", "icon", "permissions", "system"} {
+		if _, ok := roles.NestedObject.Attributes[name]; !ok {
+			t.Errorf("roles missing attribute %q", name)
+		}
+	}
+}
+
+func TestRolesDataSource_Configure_WithValidClient(t *testing.T) {
+	d := &RolesDataSource{}
+	c := client.NewClient("https://test.example.com", "key", 30*time.Second)
+
+	resp := &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: c,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if d.client != c {
+		t.Error("client not set correctly")
+	}
+}
+
+func TestRolesDataSource_Configure_WrongType(t *testing.T) {
+	d := &RolesDataSource{}
+
+	resp := &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: "not a client",
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected error for wrong provider data type")
+	}
+}
@@ -0,0 +1,156 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ datasource.DataSource = &TeamGroupSyncPreviewDataSource{}
+
+// TeamGroupSyncPreviewDataSource defines the data source implementation.
+type TeamGroupSyncPreviewDataSource struct {
+	client *client.Client
+}
+
+// TeamGroupSyncPreviewDataSourceModel describes the data source data model.
+type TeamGroupSyncPreviewDataSourceModel struct {
+	GroupPaths       []string                   `tfsdk:"group_paths"`
+	IncludeSubgroups types.Bool                 `tfsdk:"include_subgroups"`
+	Role             types.String               `tfsdk:"role"`
+	RoleMappings     map[string]string          `tfsdk:"role_mappings"`
+	MemberCount      types.Int64                `tfsdk:"member_count"`
+	Members          []TeamGroupSyncMemberModel `tfsdk:"members"`
+}
+
+// TeamGroupSyncMemberModel describes a single member in the preview.
+type TeamGroupSyncMemberModel struct {
+	UserID types.String `tfsdk:"user_id"`
+	Email  types.String `tfsdk:"email"`
+	Role   types.String `tfsdk:"role"`
+	Groups []string     `tfsdk:"groups"`
+}
+
+// NewTeamGroupSyncPreviewDataSource creates a new team group sync preview data source.
+func NewTeamGroupSyncPreviewDataSource() datasource.DataSource {
+	return &TeamGroupSyncPreviewDataSource{}
+}
+
+func (d *TeamGroupSyncPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_group_sync_preview"
+}
+
+func (d *TeamGroupSyncPreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Previews the team membership a shoehorn_team_group_sync rule would produce, without applying it.",
+		Attributes: map[string]schema.Attribute{
+			"group_paths": schema.ListAttribute{
+				Description: "IdP group paths whose members would become team members.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"include_subgroups": schema.BoolAttribute{
+				Description: "Whether members of subgroups are included. Defaults to false.",
+				Optional:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "The team role for members without a role mapping. Defaults to member.",
+				Optional:    true,
+			},
+			"role_mappings": schema.MapAttribute{
+				Description: "Team roles keyed by group path.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"member_count": schema.Int64Attribute{
+				Description: "The number of members the rule would produce.",
+				Computed:    true,
+			},
+			"members": schema.ListNestedAttribute{
+				Description: "The members the rule would produce, sorted by user ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Description: "The directory user ID.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The user's email.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The team role the member would get.",
+							Computed:    true,
+						},
+						"groups": schema.ListAttribute{
+							Description: "The synced groups the user is a direct member of.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TeamGroupSyncPreviewDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *TeamGroupSyncPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "reading team group sync preview data source")
+
+	var state TeamGroupSyncPreviewDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := d.client.PreviewTeamGroupSync(ctx, client.TeamGroupSyncRequest{
+		GroupPaths:       state.GroupPaths,
+		IncludeSubgroups: state.IncludeSubgroups.ValueBool(),
+		DefaultRole:      state.Role.ValueString(),
+		RoleMappings:     state.RoleMappings,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Team Group Sync Preview", fmt.Sprintf("Could not preview team group sync: %s", err))
+		return
+	}
+
+	state.Members = []TeamGroupSyncMemberModel{}
+	for _, m := range members {
+		groups := m.Groups
+		if groups == nil {
+			groups = []string{}
+		}
+		state.Members = append(state.Members, TeamGroupSyncMemberModel{
+			UserID: types.StringValue(m.UserID),
+			Email:  types.StringValue(m.Email),
+			Role:   types.StringValue(m.Role),
+			Groups: groups,
+		})
+	}
+	state.MemberCount = types.Int64Value(int64(len(members)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
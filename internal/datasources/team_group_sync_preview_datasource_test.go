@@ -0,0 +1,66 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func TestTeamGroupSyncPreviewDataSource_Metadata(t *testing.T) {
+	d := NewTeamGroupSyncPreviewDataSource()
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_team_group_sync_preview" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_team_group_sync_preview")
+	}
+}
+
+func TestTeamGroupSyncPreviewDataSource_Schema(t *testing.T) {
+	d := NewTeamGroupSyncPreviewDataSource()
+	resp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	attrs := resp.Schema.Attributes
+	for _, name := range []string{"group_paths", "include_subgroups", "role", "role_mappings", "member_count", "members"} {
+		if _, ok := attrs[name]; !ok {
+			t.Errorf("schema missing attribute %q", name)
+		}
+	}
+	if !attrs["group_paths"].IsRequired() {
+		t.Error("group_paths should be required")
+	}
+}
+
+func TestTeamGroupSyncPreviewDataSource_Configure_WithValidClient(t *testing.T) {
+	d := &TeamGroupSyncPreviewDataSource{}
+	c := client.NewClient("https://test.example.com", "key", 30*time.Second)
+
+	resp := &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: c,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if d.client != c {
+		t.Error("client not set correctly")
+	}
+}
+
+func TestTeamGroupSyncPreviewDataSource_Configure_WrongType(t *testing.T) {
+	d := &TeamGroupSyncPreviewDataSource{}
+
+	resp := &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: "not a client",
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected error for wrong provider data type")
+	}
+}
@@ -0,0 +1,424 @@
+// Package export generates Terraform configuration for the objects in an
+// existing Shoehorn tenant, so a tenant that was set up by hand can be adopted
+// by the provider.
+//
+// Export walks the client's List endpoints and returns one Block per object: a
+// resource block filled in from the API and an import block with the ID the
+// resource's ImportState accepts. Write lays the blocks out in .tf files,
+// either one file per resource type or one file per owning team. Running
+// terraform plan on the result imports every object and should show no
+// changes, apart from integration secrets the API masks.
+package export
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+// Kinds are the object kinds Export knows, in the order they are exported.
+var Kinds = []string{"team", "entity", "feature_flag", "platform_policy", "integration", "forge_mold", "announcement"}
+
+// Groupings accepted by Write.
+const (
+	GroupByType  = "type"
+	GroupByOwner = "owner"
+)
+
+// tenantFile holds blocks without an owning team when grouping by owner.
+const tenantFile = "tenant.tf"
+
+// Options controls what Export reads.
+type Options struct {
+	// Kinds limits the export to these kinds. Empty means all Kinds.
+	Kinds []string
+}
+
+// exporter lists one kind of object. teams maps team IDs to slugs so objects
+// that reference a team by ID can be grouped under it.
+type exporter func(ctx context.Context, c *client.Client, teams map[string]string) ([]Block, error)
+
+var exporters = map[string]exporter{
+	"team":            exportTeams,
+	"entity":          exportEntities,
+	"feature_flag":    exportFeatureFlags,
+	"platform_policy": exportPolicies,
+	"integration":     exportIntegrations,
+	"forge_mold":      exportForgeMolds,
+	"announcement":    exportAnnouncements,
+}
+
+// Export reads the tenant and returns a block for each object. Resource names
+// are derived from slugs, keys or names and made unique per type.
+func Export(ctx context.Context, c *client.Client, opts Options) ([]Block, error) {
+	kinds := opts.Kinds
+	if len(kinds) == 0 {
+		kinds = Kinds
+	}
+	for _, k := range kinds {
+		if _, ok := exporters[k]; !ok {
+			return nil, fmt.Errorf("unknown kind %q, expected one of %s", k, strings.Join(Kinds, ", "))
+		}
+	}
+
+	teamList, err := c.ListTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list teams: %w", err)
+	}
+	teams := make(map[string]string, len(teamList))
+	for _, t := range teamList {
+		teams[t.ID] = t.Slug
+	}
+
+	var blocks []Block
+	for _, k := range Kinds {
+		if !slices.Contains(kinds, k) {
+			continue
+		}
+		kb, err := exporters[k](ctx, c, teams)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, kb...)
+	}
+	uniqueNames(blocks)
+	return blocks, nil
+}
+
+// Write writes blocks to .tf files in dir, grouped by resource type or by
+// owning team. It returns the names of the files written.
+func Write(dir string, blocks []Block, groupBy string) ([]string, error) {
+	files := map[string][]Block{}
+	for _, b := range blocks {
+		var name string
+		switch groupBy {
+		case GroupByType:
+			name = b.Type + ".tf"
+		case GroupByOwner:
+			name = tenantFile
+			if b.Owner != "" {
+				name = "team_" + resourceName(b.Owner) + ".tf"
+			}
+		default:
+			return nil, fmt.Errorf("unknown grouping %q, expected %q or %q", groupBy, GroupByType, GroupByOwner)
+		}
+		files[name] = append(files[name], b)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		rendered := make([]string, len(files[name]))
+		for i, b := range files[name] {
+			rendered[i] = b.Render()
+		}
+		content := "# Generated by shoehorn-tf-export.\n\n" + strings.Join(rendered, "\n")
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			return nil, err
+		}
+	}
+	return names, nil
+}
+
+// uniqueNames suffixes resource names that collide within a type. A suffix
+// is only used if no other block already has the resulting address.
+func uniqueNames(blocks []Block) {
+	taken := make(map[string]bool, len(blocks))
+	for _, b := range blocks {
+		taken[b.Address()] = true
+	}
+	used := make(map[string]bool, len(blocks))
+	for i := range blocks {
+		if used[blocks[i].Address()] {
+			base := blocks[i].Name
+			for n := 2; ; n++ {
+				blocks[i].Name = fmt.Sprintf("%s_%d", base, n)
+				if addr := blocks[i].Address(); !taken[addr] && !used[addr] {
+					break
+				}
+			}
+		}
+		used[blocks[i].Address()] = true
+	}
+}
+
+// optional appends a string attribute when the value is set.
+func optional(attrs []Attr, name, value string) []Attr {
+	if value == "" {
+		return attrs
+	}
+	return append(attrs, Attr{Name: name, Value: value})
+}
+
+func exportTeams(ctx context.Context, c *client.Client, _ map[string]string) ([]Block, error) {
+	list, err := c.ListTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list teams: %w", err)
+	}
+
+	blocks := make([]Block, 0, len(list))
+	for _, t := range list {
+		// The list omits members, so read each team the way import does.
+		team, err := c.GetTeam(ctx, t.ID)
+		if err != nil {
+			return nil, fmt.Errorf("get team %s: %w", t.Slug, err)
+		}
+
+		attrs := []Attr{{"name", team.Name}, {"slug", team.Slug}}
+		attrs = optional(attrs, "display_name", team.DisplayName)
+		attrs = optional(attrs, "description", team.Description)
+		if len(team.Metadata) > 0 {
+			attrs = append(attrs, Attr{"metadata", JSON{team.Metadata}})
+		}
+		// Members added by a group sync rule belong to shoehorn_team_group_sync.
+		var members []map[string]string
+		for _, m := range team.Members {
+			if m.Source == client.TeamMemberSourceGroupSync {
+				continue
+			}
+			member := map[string]string{"user_id": m.UserID}
+			if m.Role != "" {
+				member["role"] = m.Role
+			}
+			members = append(members, member)
+		}
+		if len(members) > 0 {
+			attrs = append(attrs, Attr{"members", JSON{members}})
+		}
+
+		blocks = append(blocks, Block{
+			Type:     "shoehorn_team",
+			Name:     resourceName(team.Slug),
+			ImportID: team.ID,
+			Owner:    team.Slug,
+			Attrs:    attrs,
+		})
+	}
+	return blocks, nil
+}
+
+func exportEntities(ctx context.Context, c *client.Client, _ map[string]string) ([]Block, error) {
+	list, err := c.ListEntities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list entities: %w", err)
+	}
+
+	blocks := make([]Block, 0, len(list))
+	for _, item := range list {
+		// The list omits links, relations and interfaces.
+		e, err := c.GetEntity(ctx, item.Service.ID)
+		if err != nil {
+			return nil, fmt.Errorf("get entity %s: %w", item.Service.ID, err)
+		}
+
+		attrs := []Attr{{"name", e.Service.Name}, {"type", e.Service.Type}}
+		attrs = optional(attrs, "description", e.Description)
+		attrs = optional(attrs, "entity_lifecycle", e.Lifecycle)
+		attrs = optional(attrs, "tier", e.Service.Tier)
+		var owner string
+		if len(e.Owner) > 0 {
+			owner = e.Owner[0].ID
+			attrs = append(attrs, Attr{"owner", owner})
+		}
+		if len(e.Tags) > 0 {
+			attrs = append(attrs, Attr{"tags", e.Tags})
+		}
+		if len(e.Links) > 0 {
+			attrs = append(attrs, Attr{"links", JSON{e.Links}})
+		}
+		if len(e.Relations) > 0 {
+			relations := make([]map[string]string, len(e.Relations))
+			for i, r := range e.Relations {
+				relations[i] = map[string]string{"type": r.Type, "target": r.TargetType + ":" + r.TargetID}
+			}
+			attrs = append(attrs, Attr{"relations", JSON{relations}})
+		}
+		if len(e.Interfaces) > 0 {
+			attrs = append(attrs, Attr{"interfaces", JSON{e.Interfaces}})
+		}
+		attrs = optional(attrs, "repository_path", e.RepositoryPath)
+		if e.Integrations != nil {
+			if e.Integrations.Changelog != nil {
+				attrs = optional(attrs, "changelog_path", e.Integrations.Changelog.Path)
+			}
+			if len(e.Integrations.Licenses) > 0 {
+				attrs = append(attrs, Attr{"licenses", JSON{e.Integrations.Licenses}})
+			}
+		}
+
+		blocks = append(blocks, Block{
+			Type:     "shoehorn_entity",
+			Name:     resourceName(e.Service.ID),
+			ImportID: e.Service.ID,
+			Owner:    owner,
+			Attrs:    attrs,
+		})
+	}
+	return blocks, nil
+}
+
+func exportFeatureFlags(ctx context.Context, c *client.Client, _ map[string]string) ([]Block, error) {
+	list, err := c.ListFeatureFlags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list feature flags: %w", err)
+	}
+
+	blocks := make([]Block, 0, len(list))
+	for _, f := range list {
+		attrs := []Attr{{"key", f.Key}, {"name", f.Name}}
+		attrs = optional(attrs, "description", f.Description)
+		attrs = append(attrs, Attr{"default_enabled", f.DefaultEnabled})
+		blocks = append(blocks, Block{
+			Type:     "shoehorn_feature_flag",
+			Name:     resourceName(f.Key),
+			ImportID: f.Key,
+			Attrs:    attrs,
+		})
+	}
+	return blocks, nil
+}
+
+func exportPolicies(ctx context.Context, c *client.Client, _ map[string]string) ([]Block, error) {
+	list, err := c.ListPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list policies: %w", err)
+	}
+
+	blocks := make([]Block, 0, len(list))
+	for _, p := range list {
+		blocks = append(blocks, Block{
+			Type:     "shoehorn_platform_policy",
+			Name:     resourceName(p.Key),
+			ImportID: p.Key,
+			Attrs: []Attr{
+				{"key", p.Key},
+				{"enabled", p.Enabled},
+				{"enforcement", p.Enforcement},
+			},
+		})
+	}
+	return blocks, nil
+}
+
+func exportIntegrations(ctx context.Context, c *client.Client, teams map[string]string) ([]Block, error) {
+	list, err := c.ListIntegrations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list integrations: %w", err)
+	}
+
+	blocks := make([]Block, 0, len(list))
+	for _, i := range list {
+		config := i.Config
+		if config == nil {
+			config = map[string]interface{}{}
+		}
+		attrs := []Attr{{"name", i.Name}, {"type", i.Type}, {"config_json", JSON{config}}}
+		attrs = optional(attrs, "team_id", i.TeamID)
+		blocks = append(blocks, Block{
+			Type:     "shoehorn_integration",
+			Name:     resourceName(i.Name),
+			ImportID: strconv.Itoa(i.ID),
+			Owner:    teams[i.TeamID],
+			Comments: []string{"Secrets in config_json are masked by the API. Replace them before applying."},
+			Attrs:    attrs,
+		})
+	}
+	return blocks, nil
+}
+
+func exportForgeMolds(ctx context.Context, c *client.Client, _ map[string]string) ([]Block, error) {
+	list, err := c.ListForgeMolds(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list forge molds: %w", err)
+	}
+
+	blocks := make([]Block, 0, len(list))
+	for _, item := range list {
+		// The list omits the schema and defaults.
+		m, err := c.GetForgeMold(ctx, item.Slug)
+		if err != nil {
+			return nil, fmt.Errorf("get forge mold %s: %w", item.Slug, err)
+		}
+
+		attrs := []Attr{
+			{"slug", m.Slug},
+			{"name", m.Name},
+			{"version", m.Version},
+			{"visibility", m.Visibility},
+			{"category", m.Category},
+		}
+		attrs = optional(attrs, "description", m.Description)
+		attrs = optional(attrs, "icon", m.Icon)
+		if len(m.Tags) > 0 {
+			attrs = append(attrs, Attr{"tags", m.Tags})
+		}
+		if len(m.Schema) > 0 {
+			attrs = append(attrs, Attr{"schema_json", JSON{m.Schema}})
+		}
+		if len(m.Defaults) > 0 {
+			attrs = append(attrs, Attr{"defaults_json", JSON{m.Defaults}})
+		}
+		actions := make([]Object, len(m.Actions))
+		for i, a := range m.Actions {
+			action := Object{{"action", a.Action}, {"label", a.Label}}
+			action = optional(action, "description", a.Description)
+			if a.Primary {
+				action = append(action, Attr{"primary", true})
+			}
+			actions[i] = action
+		}
+		attrs = append(attrs, Attr{"actions", actions})
+
+		blocks = append(blocks, Block{
+			Type:     "shoehorn_forge_mold",
+			Name:     resourceName(m.Slug),
+			ImportID: m.Slug,
+			Attrs:    attrs,
+		})
+	}
+	return blocks, nil
+}
+
+func exportAnnouncements(ctx context.Context, c *client.Client, _ map[string]string) ([]Block, error) {
+	list, err := c.ListAnnouncements(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list announcements: %w", err)
+	}
+
+	blocks := make([]Block, 0, len(list))
+	for _, a := range list {
+		attrs := []Attr{{"message", a.Message}}
+		attrs = optional(attrs, "type", a.Type)
+		attrs = optional(attrs, "link_url", a.LinkURL)
+		attrs = optional(attrs, "link_text", a.LinkText)
+		attrs = append(attrs, Attr{"dismissible", a.Dismissible})
+		attrs = optional(attrs, "starts_at", a.StartsAt)
+		attrs = optional(attrs, "ends_at", a.EndsAt)
+		if len(a.Audience.Teams) > 0 {
+			attrs = append(attrs, Attr{"audience_teams", a.Audience.Teams})
+		}
+		if len(a.Audience.Roles) > 0 {
+			attrs = append(attrs, Attr{"audience_roles", a.Audience.Roles})
+		}
+		blocks = append(blocks, Block{
+			Type:     "shoehorn_announcement",
+			Name:     "announcement_" + resourceName(a.ID),
+			ImportID: a.ID,
+			Attrs:    attrs,
+		})
+	}
+	return blocks, nil
+}
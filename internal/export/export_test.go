@@ -0,0 +1,218 @@
+package export
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+// seedTenant creates one object of each kind, owned by the payments team
+// where the kind has an owner.
+func seedTenant(t *testing.T) *client.Client {
+	t.Helper()
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	ctx := context.Background()
+
+	team, err := c.CreateTeam(ctx, client.CreateTeamRequest{Name: "Payments", Slug: "payments"})
+	if err != nil {
+		t.Fatalf("CreateTeam() error = %v", err)
+	}
+	if _, err := c.UpdateTeam(ctx, team.ID, client.UpdateTeamRequest{AddMembers: []client.AddMemberRequest{{UserID: "u-1", Role: "manager"}}}); err != nil {
+		t.Fatalf("UpdateTeam() error = %v", err)
+	}
+	manifest := "schemaVersion: 1\nservice:\n  id: payments-api\n  name: payments-api\n  type: service\nowner:\n  - type: team\n    id: payments\nrelations:\n  - type: depends_on\n    target: service:ledger\n"
+	if _, err := c.CreateEntity(ctx, client.CreateEntityRequest{Content: manifest, Source: "terraform"}); err != nil {
+		t.Fatalf("CreateEntity() error = %v", err)
+	}
+	if _, err := c.CreateFeatureFlag(ctx, client.CreateFeatureFlagRequest{Key: "new-ui", Name: "New UI", DefaultEnabled: true}); err != nil {
+		t.Fatalf("CreateFeatureFlag() error = %v", err)
+	}
+	api.AddPolicy(client.PlatformPolicy{ID: "p-1", Key: "require-owner", Name: "Require owner", Enabled: true, Enforcement: "warn"})
+	if _, err := c.CreateIntegration(ctx, client.CreateIntegrationRequest{Name: "Payments GitHub", Type: "github", TeamID: team.ID, Config: map[string]interface{}{"org": "acme"}}); err != nil {
+		t.Fatalf("CreateIntegration() error = %v", err)
+	}
+	return c
+}
+
+func TestExport_WritesImportAndResourceBlocks(t *testing.T) {
+	c := seedTenant(t)
+	blocks, err := Export(context.Background(), c, Options{})
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	var addrs []string
+	for _, b := range blocks {
+		addrs = append(addrs, b.Address())
+	}
+	for _, want := range []string{
+		"shoehorn_team.payments",
+		"shoehorn_entity.payments_api",
+		"shoehorn_feature_flag.new_ui",
+		"shoehorn_platform_policy.require_owner",
+		"shoehorn_integration.payments_github",
+	} {
+		if !slices.Contains(addrs, want) {
+			t.Errorf("Export() addresses = %v, missing %s", addrs, want)
+		}
+	}
+
+	dir := t.TempDir()
+	files, err := Write(dir, blocks, GroupByOwner)
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if want := []string{"team_payments.tf", "tenant.tf"}; !slices.Equal(files, want) {
+		t.Fatalf("Write() files = %v, want %v", files, want)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "team_payments.tf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"import {\n  to = shoehorn_entity.payments_api\n  id = \"payments-api\"\n}",
+		"  owner = \"payments\"",
+		"  target = \"service:ledger\"",
+		"  role    = \"manager\"",
+		"resource \"shoehorn_integration\" \"payments_github\"",
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("team_payments.tf does not contain %q:\n%s", want, content)
+		}
+	}
+}
+
+func TestExport_Kinds(t *testing.T) {
+	c := seedTenant(t)
+	blocks, err := Export(context.Background(), c, Options{Kinds: []string{"feature_flag"}})
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if len(blocks) != 1 || blocks[0].Type != "shoehorn_feature_flag" {
+		t.Errorf("Export() = %+v, want only the feature flag", blocks)
+	}
+
+	if _, err := Export(context.Background(), c, Options{Kinds: []string{"widget"}}); err == nil {
+		t.Error("Export() should reject an unknown kind")
+	}
+}
+
+func TestWrite_GroupByType(t *testing.T) {
+	blocks := []Block{
+		{Type: "shoehorn_team", Name: "a", ImportID: "1", Owner: "a", Attrs: []Attr{{"name", "A"}}},
+		{Type: "shoehorn_feature_flag", Name: "b", ImportID: "b", Attrs: []Attr{{"key", "b"}}},
+	}
+	files, err := Write(t.TempDir(), blocks, GroupByType)
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if want := []string{"shoehorn_feature_flag.tf", "shoehorn_team.tf"}; !slices.Equal(files, want) {
+		t.Errorf("Write() files = %v, want %v", files, want)
+	}
+	if _, err := Write(t.TempDir(), blocks, "team"); err == nil {
+		t.Error("Write() should reject an unknown grouping")
+	}
+}
+
+func TestBlock_Render(t *testing.T) {
+	b := Block{
+		Type:     "shoehorn_forge_mold",
+		Name:     "svc",
+		ImportID: "svc",
+		Comments: []string{"Generated."},
+		Attrs: []Attr{
+			{"slug", "svc"},
+			{"published_version", "1.0.0"},
+			{"tags", []string{"go"}},
+			{"schema_json", JSON{map[string]any{"type": "object", "x-order": 1}}},
+			{"actions", []Object{{{"action", "create"}, {"primary", true}}}},
+		},
+	}
+	want := `import {
+  to = shoehorn_forge_mold.svc
+  id = "svc"
+}
+
+# Generated.
+resource "shoehorn_forge_mold" "svc" {
+  slug              = "svc"
+  published_version = "1.0.0"
+  tags              = ["go"]
+  schema_json = jsonencode({
+    type    = "object"
+    x-order = 1
+  })
+  actions = [
+    {
+      action  = "create"
+      primary = true
+    },
+  ]
+}
+`
+	if got := b.Render(); got != want {
+		t.Errorf("Render() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	tests := map[string]string{
+		`plain`:         `"plain"`,
+		`say "hi"`:      `"say \"hi\""`,
+		"two\nlines":    `"two\nlines"`,
+		`${var.x}`:      `"$${var.x}"`,
+		`%{if}`:         `"%%{if}"`,
+		`cost: $5`:      `"cost: $5"`,
+		"bell\a":        `"bell\u0007"`,
+		`C:\path`:       `"C:\\path"`,
+		`ünïcödé`:       `"ünïcödé"`,
+		`100% {braces}`: `"100% {braces}"`,
+	}
+	for in, want := range tests {
+		if got := quote(in); got != want {
+			t.Errorf("quote(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	tests := map[string]string{
+		"payments-api":    "payments_api",
+		"Payments GitHub": "payments_github",
+		"42-ids":          "_42_ids",
+		"--":              "unnamed",
+		"a..b__c":         "a_b_c",
+	}
+	for in, want := range tests {
+		if got := resourceName(in); got != want {
+			t.Errorf("resourceName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestUniqueNames(t *testing.T) {
+	blocks := []Block{
+		{Type: "shoehorn_team", Name: "api"},
+		{Type: "shoehorn_team", Name: "api"},
+		{Type: "shoehorn_team", Name: "api_2"},
+		{Type: "shoehorn_team", Name: "api"},
+		{Type: "shoehorn_entity", Name: "api"},
+	}
+	uniqueNames(blocks)
+
+	want := []string{"shoehorn_team.api", "shoehorn_team.api_3", "shoehorn_team.api_2", "shoehorn_team.api_4", "shoehorn_entity.api"}
+	for i, b := range blocks {
+		if b.Address() != want[i] {
+			t.Errorf("block %d address = %q, want %q", i, b.Address(), want[i])
+		}
+	}
+}
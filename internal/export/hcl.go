@@ -0,0 +1,249 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Attr is one attribute of a generated resource block.
+type Attr struct {
+	Name string
+	// Value is a string, bool, int, []string, JSON or []Object.
+	Value any
+}
+
+// Object is an object in a list of nested attributes, such as one forge mold
+// action.
+type Object []Attr
+
+// JSON is a value rendered as jsonencode(...), for the provider's *_json and
+// JSON string attributes.
+type JSON struct {
+	Value any
+}
+
+// Block is a generated resource block and the import block that adopts it.
+type Block struct {
+	// Type is the resource type, e.g. shoehorn_team.
+	Type string
+	// Name is the resource name, unique per type once Export has run.
+	Name string
+	// ImportID is the ID passed to the resource's ImportState.
+	ImportID string
+	// Owner is the slug of the owning team, or empty for tenant-wide objects.
+	Owner string
+	// Comments are written above the resource block.
+	Comments []string
+	Attrs    []Attr
+}
+
+// Address returns the resource address of the block.
+func (b Block) Address() string {
+	return b.Type + "." + b.Name
+}
+
+// Render returns the import and resource blocks in HCL.
+func (b Block) Render() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "import {\n  to = %s\n  id = %s\n}\n\n", b.Address(), quote(b.ImportID))
+	for _, c := range b.Comments {
+		fmt.Fprintf(&sb, "# %s\n", c)
+	}
+	fmt.Fprintf(&sb, "resource %s %s {\n", quote(b.Type), quote(b.Name))
+	writeAttrs(&sb, b.Attrs, 1)
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// writeAttrs writes attrs at the given indent level, aligning the equals signs
+// of consecutive single-line attributes the way terraform fmt does.
+func writeAttrs(sb *strings.Builder, attrs []Attr, level int) {
+	indent := strings.Repeat("  ", level)
+	rendered := make([]string, len(attrs))
+	for i, a := range attrs {
+		rendered[i] = renderValue(a.Value, level)
+	}
+
+	for i := 0; i < len(attrs); {
+		// A run of single-line attributes shares one alignment width.
+		j, width := i, 0
+		for j < len(attrs) && !strings.Contains(rendered[j], "\n") {
+			width = max(width, len(attrs[j].Name))
+			j++
+		}
+		if j == i {
+			j, width = i+1, len(attrs[i].Name)
+		}
+		for k := i; k < j; k++ {
+			fmt.Fprintf(sb, "%s%-*s = %s\n", indent, width, attrs[k].Name, rendered[k])
+		}
+		i = j
+	}
+}
+
+func renderValue(v any, level int) string {
+	switch v := v.(type) {
+	case rawExpr:
+		return string(v)
+	case string:
+		return quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case []string:
+		items := make([]string, len(v))
+		for i, s := range v {
+			items[i] = quote(s)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case JSON:
+		return "jsonencode(" + renderJSON(v.Value, level) + ")"
+	case []Object:
+		indent := strings.Repeat("  ", level)
+		var sb strings.Builder
+		sb.WriteString("[\n")
+		for _, o := range v {
+			sb.WriteString(indent + "  {\n")
+			writeAttrs(&sb, o, level+2)
+			sb.WriteString(indent + "  },\n")
+		}
+		sb.WriteString(indent + "]")
+		return sb.String()
+	}
+	panic(fmt.Sprintf("export: unsupported attribute value %T", v))
+}
+
+// renderJSON renders a decoded JSON value as an HCL expression.
+func renderJSON(v any, level int) string {
+	indent := strings.Repeat("  ", level)
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		return v.String()
+	case []any:
+		if len(v) == 0 {
+			return "[]"
+		}
+		var sb strings.Builder
+		sb.WriteString("[\n")
+		for _, item := range v {
+			sb.WriteString(indent + "  " + renderJSON(item, level+1) + ",\n")
+		}
+		sb.WriteString(indent + "]")
+		return sb.String()
+	case map[string]any:
+		if len(v) == 0 {
+			return "{}"
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		attrs := make([]Attr, len(keys))
+		for i, k := range keys {
+			name := k
+			if !isIdentifier(k) {
+				name = quote(k)
+			}
+			attrs[i] = Attr{Name: name, Value: rawExpr(renderJSON(v[k], level+1))}
+		}
+		var sb strings.Builder
+		sb.WriteString("{\n")
+		writeAttrs(&sb, attrs, level+1)
+		sb.WriteString(indent + "}")
+		return sb.String()
+	}
+	// Anything else, e.g. a struct from the client package, is normalised
+	// through encoding/json first.
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("export: %s", err))
+	}
+	var decoded any
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		panic(fmt.Sprintf("export: %s", err))
+	}
+	return renderJSON(decoded, level)
+}
+
+// rawExpr is an already rendered expression.
+type rawExpr string
+
+// quote returns s as an HCL string literal. Template sequences are escaped so
+// values such as "${var}" are written literally.
+func quote(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for i, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\r':
+			sb.WriteString(`\r`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i+1:], "{"):
+			sb.WriteRune(r)
+			sb.WriteRune(r)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&sb, `\u%04x`, r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case i > 0 && (r == '-' || r >= '0' && r <= '9'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// resourceName turns s into a valid, lower-case Terraform resource name.
+func resourceName(s string) string {
+	var sb strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(s) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			sb.WriteRune(r)
+			underscore = false
+		} else if !underscore && sb.Len() > 0 {
+			sb.WriteByte('_')
+			underscore = true
+		}
+	}
+	name := strings.TrimSuffix(sb.String(), "_")
+	if name == "" {
+		return "unnamed"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		return "_" + name
+	}
+	return name
+}
@@ -0,0 +1,385 @@
+package fakeapi
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func (s *Server) registerAdminRoutes(mux *http.ServeMux) {
+	s.handle(mux, "GET /api/v1/admin/features", s.listFeatures)
+	s.handle(mux, "POST /api/v1/admin/features", s.createFeature)
+	s.handle(mux, "PUT /api/v1/admin/features/{key}", s.updateFeature)
+	s.handle(mux, "DELETE /api/v1/admin/features/{key}", s.deleteFeature)
+
+	s.handle(mux, "GET /api/v1/admin/settings", s.getSettings)
+	s.handle(mux, "PUT /api/v1/admin/settings", s.updateSettings)
+	s.handle(mux, "PATCH /api/v1/admin/settings", s.patchSettings)
+	s.handle(mux, "POST /api/v1/admin/settings/reset", s.resetSettings)
+
+	s.handle(mux, "GET /api/v1/admin/announcements", s.listAnnouncements)
+	s.handle(mux, "GET /api/v1/admin/announcements/{id}", s.getAnnouncement)
+	s.handle(mux, "POST /api/v1/admin/announcements", s.createAnnouncement)
+	s.handle(mux, "PUT /api/v1/admin/announcements/{id}", s.updateAnnouncement)
+	s.handle(mux, "DELETE /api/v1/admin/announcements/{id}", s.deleteAnnouncement)
+
+	s.handle(mux, "GET /api/v1/admin/api-keys", s.listAPIKeys)
+	s.handle(mux, "POST /api/v1/admin/api-keys", s.createAPIKey)
+	s.handle(mux, "POST /api/v1/admin/api-keys/{id}/revoke", s.revokeAPIKey)
+
+	s.handle(mux, "GET /api/v1/admin/policies", s.listPolicies)
+	s.handle(mux, "PUT /api/v1/admin/policies/{id}", s.updatePolicy)
+	s.handle(mux, "POST /api/v1/admin/policies/{id}/reset", s.resetPolicy)
+}
+
+// AddPolicy seeds a platform policy. Policies are built in to Shoehorn and
+// cannot be created through the API, only toggled. The seeded enabled state
+// and enforcement level are what a reset returns to.
+func (s *Server) AddPolicy(p client.PlatformPolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if p.ID == "" {
+		p.ID = s.nextID("policy")
+	}
+	s.policies[p.ID] = &p
+	s.policyDefaults[p.ID] = p
+}
+
+// SetSettings replaces the tenant settings.
+func (s *Server) SetSettings(settings client.TenantSettings) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settings = settings
+}
+
+func (s *Server) listFeatures(w http.ResponseWriter, _ *http.Request) {
+	flags := make([]client.FeatureFlag, 0, len(s.features))
+	for _, f := range s.features {
+		flags = append(flags, *f)
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Key < flags[j].Key })
+	writeJSON(w, http.StatusOK, map[string]interface{}{"flags": flags})
+}
+
+func (s *Server) createFeature(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateFeatureFlagRequest
+	if !decode(w, r, &req) || !required(w, map[string]string{"key": req.Key, "name": req.Name}) {
+		return
+	}
+	if _, exists := s.features[req.Key]; exists {
+		conflict(w, "feature flag", req.Key)
+		return
+	}
+
+	now := s.timestamp()
+	f := &client.FeatureFlag{
+		ID:             s.nextID("flag"),
+		Key:            req.Key,
+		Name:           req.Name,
+		Description:    req.Description,
+		DefaultEnabled: req.DefaultEnabled,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	s.features[f.Key] = f
+	writeJSON(w, http.StatusCreated, f)
+}
+
+func (s *Server) updateFeature(w http.ResponseWriter, r *http.Request) {
+	f, ok := s.features[r.PathValue("key")]
+	if !ok {
+		notFound(w, "feature flag", r.PathValue("key"))
+		return
+	}
+	if !preconditionMet(w, r, "feature flag", f.Key, f.UpdatedAt) {
+		return
+	}
+
+	var req client.UpdateFeatureFlagRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name != "" {
+		f.Name = req.Name
+	}
+	if req.Description != "" {
+		f.Description = req.Description
+	}
+	if req.DefaultEnabled != nil {
+		f.DefaultEnabled = *req.DefaultEnabled
+	}
+	f.UpdatedAt = s.timestamp()
+	writeJSON(w, http.StatusOK, f)
+}
+
+func (s *Server) deleteFeature(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+	if _, ok := s.features[key]; !ok {
+		notFound(w, "feature flag", key)
+		return
+	}
+	delete(s.features, key)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getSettings(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, s.settings)
+}
+
+func (s *Server) updateSettings(w http.ResponseWriter, r *http.Request) {
+	if !preconditionMet(w, r, "settings", s.settings.ID, s.settings.UpdatedAt) {
+		return
+	}
+	var req client.UpdateSettingsRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	now := s.timestamp()
+	s.settings.Appearance = req.Appearance
+	if req.Announcement != nil {
+		s.settings.Announcement = *req.Announcement
+		s.settings.Announcement.UpdatedAt = now
+	}
+	if req.Forge != nil {
+		s.settings.Forge = *req.Forge
+	}
+	if s.settings.CreatedAt == "" {
+		s.settings.CreatedAt = now
+	}
+	s.settings.UpdatedAt = now
+	writeJSON(w, http.StatusOK, s.settings)
+}
+
+func (s *Server) patchSettings(w http.ResponseWriter, r *http.Request) {
+	var req client.PatchSettingsRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.UpdatedAt != "" && req.UpdatedAt != s.settings.UpdatedAt {
+		writeError(w, http.StatusPreconditionFailed, "precondition_failed",
+			"settings were updated at "+s.settings.UpdatedAt+", not "+req.UpdatedAt)
+		return
+	}
+
+	now := s.timestamp()
+	if req.Appearance != nil {
+		s.settings.Appearance = *req.Appearance
+	}
+	if req.Announcement != nil {
+		s.settings.Announcement = *req.Announcement
+		s.settings.Announcement.UpdatedAt = now
+	}
+	if req.Forge != nil {
+		s.settings.Forge = *req.Forge
+	}
+	if s.settings.CreatedAt == "" {
+		s.settings.CreatedAt = now
+	}
+	s.settings.UpdatedAt = now
+	writeJSON(w, http.StatusOK, s.settings)
+}
+
+func (s *Server) resetSettings(w http.ResponseWriter, _ *http.Request) {
+	s.settings.Appearance = client.AppearanceSettings{}
+	s.settings.Announcement = client.AnnouncementSettings{}
+	s.settings.Forge = client.ForgeSettings{}
+	s.settings.UpdatedAt = s.timestamp()
+	writeJSON(w, http.StatusOK, s.settings)
+}
+
+func (s *Server) listAnnouncements(w http.ResponseWriter, _ *http.Request) {
+	announcements := make([]client.Announcement, 0, len(s.announcements))
+	for _, a := range s.announcements {
+		announcements = append(announcements, *a)
+	}
+	sort.Slice(announcements, func(i, j int) bool { return announcements[i].ID < announcements[j].ID })
+	writeJSON(w, http.StatusOK, map[string]interface{}{"items": announcements})
+}
+
+func (s *Server) getAnnouncement(w http.ResponseWriter, r *http.Request) {
+	a, ok := s.announcements[r.PathValue("id")]
+	if !ok {
+		notFound(w, "announcement", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, a)
+}
+
+func (s *Server) createAnnouncement(w http.ResponseWriter, r *http.Request) {
+	var req client.AnnouncementRequest
+	if !decode(w, r, &req) || !required(w, map[string]string{"message": req.Message}) || !validAnnouncementWindow(w, req) {
+		return
+	}
+
+	now := s.timestamp()
+	a := &client.Announcement{ID: s.nextID("announcement"), CreatedAt: now}
+	applyAnnouncementRequest(a, req, now)
+	s.announcements[a.ID] = a
+	writeJSON(w, http.StatusCreated, a)
+}
+
+func (s *Server) updateAnnouncement(w http.ResponseWriter, r *http.Request) {
+	a, ok := s.announcements[r.PathValue("id")]
+	if !ok {
+		notFound(w, "announcement", r.PathValue("id"))
+		return
+	}
+	if !preconditionMet(w, r, "announcement", a.ID, a.UpdatedAt) {
+		return
+	}
+
+	var req client.AnnouncementRequest
+	if !decode(w, r, &req) || !required(w, map[string]string{"message": req.Message}) || !validAnnouncementWindow(w, req) {
+		return
+	}
+	applyAnnouncementRequest(a, req, s.timestamp())
+	writeJSON(w, http.StatusOK, a)
+}
+
+func (s *Server) deleteAnnouncement(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.announcements[id]; !ok {
+		notFound(w, "announcement", id)
+		return
+	}
+	delete(s.announcements, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validAnnouncementWindow rejects windows with malformed bounds or that end
+// before they start, writing a 400 response.
+func validAnnouncementWindow(w http.ResponseWriter, req client.AnnouncementRequest) bool {
+	var start, end time.Time
+	for _, bound := range []struct {
+		name  string
+		value string
+		t     *time.Time
+	}{{"starts_at", req.StartsAt, &start}, {"ends_at", req.EndsAt, &end}} {
+		if bound.value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, bound.value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "validation_error", bound.name+" must be an RFC 3339 timestamp")
+			return false
+		}
+		*bound.t = t
+	}
+	if !start.IsZero() && !end.IsZero() && !end.After(start) {
+		writeError(w, http.StatusBadRequest, "validation_error", "ends_at must be after starts_at")
+		return false
+	}
+	return true
+}
+
+func applyAnnouncementRequest(a *client.Announcement, req client.AnnouncementRequest, now string) {
+	a.Message = req.Message
+	a.Type = req.Type
+	if a.Type == "" {
+		a.Type = "info"
+	}
+	a.LinkURL = req.LinkURL
+	a.LinkText = req.LinkText
+	a.Dismissible = req.Dismissible
+	a.StartsAt = req.StartsAt
+	a.EndsAt = req.EndsAt
+	a.Audience = req.Audience
+	a.UpdatedAt = now
+}
+
+func (s *Server) listAPIKeys(w http.ResponseWriter, _ *http.Request) {
+	keys := make([]client.APIKey, 0, len(s.apiKeys))
+	for _, k := range s.apiKeys {
+		keys = append(keys, *k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+	writeJSON(w, http.StatusOK, map[string]interface{}{"keys": keys, "total": len(keys)})
+}
+
+func (s *Server) createAPIKey(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateAPIKeyRequest
+	if !decode(w, r, &req) || !required(w, map[string]string{"name": req.Name}) {
+		return
+	}
+
+	id := s.nextID("key")
+	raw := "shk_" + id + "_secret"
+	k := &client.APIKey{
+		ID:          id,
+		TenantID:    s.settings.TenantID,
+		Name:        req.Name,
+		Description: req.Description,
+		KeyPrefix:   raw[:8],
+		Scopes:      req.Scopes,
+		CreatedBy:   "terraform",
+		CreatedAt:   s.timestamp(),
+		UpdatedAt:   s.timestamp(),
+	}
+	if req.ExpiresInDays != nil {
+		k.ExpiresAt = s.now().AddDate(0, 0, *req.ExpiresInDays).Format(time.RFC3339)
+	}
+	s.apiKeys[id] = k
+	writeJSON(w, http.StatusCreated, client.CreateAPIKeyResponse{Key: *k, RawKey: raw})
+}
+
+func (s *Server) revokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	k, ok := s.apiKeys[r.PathValue("id")]
+	if !ok {
+		notFound(w, "api key", r.PathValue("id"))
+		return
+	}
+	if k.RevokedAt == "" {
+		k.RevokedAt = s.timestamp()
+		k.UpdatedAt = k.RevokedAt
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"message": "API key revoked"})
+}
+
+func (s *Server) listPolicies(w http.ResponseWriter, _ *http.Request) {
+	policies := make([]client.PlatformPolicy, 0, len(s.policies))
+	for _, p := range s.policies {
+		policies = append(policies, *p)
+	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i].Key < policies[j].Key })
+	writeJSON(w, http.StatusOK, map[string]interface{}{"policies": policies})
+}
+
+func (s *Server) updatePolicy(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.policies[r.PathValue("id")]
+	if !ok {
+		notFound(w, "policy", r.PathValue("id"))
+		return
+	}
+	if !preconditionMet(w, r, "policy", p.ID, p.UpdatedAt) {
+		return
+	}
+
+	var req client.UpdatePolicyRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Enabled != nil {
+		p.Enabled = *req.Enabled
+	}
+	if req.Enforcement != "" {
+		p.Enforcement = req.Enforcement
+	}
+	p.UpdatedAt = s.timestamp()
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) resetPolicy(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.policies[r.PathValue("id")]
+	if !ok {
+		notFound(w, "policy", r.PathValue("id"))
+		return
+	}
+	defaults := s.policyDefaults[p.ID]
+	p.Enabled = defaults.Enabled
+	p.Enforcement = defaults.Enforcement
+	p.UpdatedAt = s.timestamp()
+	writeJSON(w, http.StatusOK, p)
+}
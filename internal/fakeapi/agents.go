@@ -0,0 +1,223 @@
+package fakeapi
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func (s *Server) registerK8sAgentRoutes(mux *http.ServeMux) {
+	s.handle(mux, "GET /api/v1/k8s/agents", s.listK8sAgents)
+	s.handle(mux, "POST /api/v1/k8s/agents/register", s.registerK8sAgent)
+	s.handle(mux, "GET /api/v1/k8s/agents/{clusterId}", s.getK8sAgent)
+	s.handle(mux, "POST /api/v1/k8s/agents/{clusterId}/revoke", s.revokeK8sAgent)
+	s.handle(mux, "DELETE /api/v1/k8s/agents/{clusterId}", s.deleteK8sAgent)
+}
+
+func (s *Server) registerIntegrationRoutes(mux *http.ServeMux) {
+	s.handle(mux, "GET /api/v1/integrations", s.integrationsStatus)
+	s.handle(mux, "POST /api/v1/integrations", s.createIntegration)
+	s.handle(mux, "GET /api/v1/integrations/configs", s.listIntegrations)
+	s.handle(mux, "GET /api/v1/integrations/{id}", s.getIntegration)
+	s.handle(mux, "PUT /api/v1/integrations/{id}", s.updateIntegration)
+	s.handle(mux, "POST /api/v1/integrations/{id}/sync", s.syncIntegration)
+	s.handle(mux, "DELETE /api/v1/integrations/{id}", s.deleteIntegration)
+}
+
+func (s *Server) listK8sAgents(w http.ResponseWriter, _ *http.Request) {
+	agents := make([]client.K8sAgent, 0, len(s.agents))
+	for _, a := range s.agents {
+		agents = append(agents, *a)
+	}
+	sort.Slice(agents, func(i, j int) bool { return agents[i].ClusterID < agents[j].ClusterID })
+	writeJSON(w, http.StatusOK, map[string]interface{}{"agents": agents, "total": len(agents)})
+}
+
+func (s *Server) getK8sAgent(w http.ResponseWriter, r *http.Request) {
+	a, ok := s.agents[r.PathValue("clusterId")]
+	if !ok {
+		notFound(w, "agent", r.PathValue("clusterId"))
+		return
+	}
+	writeJSON(w, http.StatusOK, a)
+}
+
+func (s *Server) registerK8sAgent(w http.ResponseWriter, r *http.Request) {
+	var req client.RegisterK8sAgentRequest
+	if !decode(w, r, &req) || !required(w, map[string]string{"clusterId": req.ClusterID, "name": req.Name}) {
+		return
+	}
+	if _, exists := s.agents[req.ClusterID]; exists {
+		conflict(w, "agent", req.ClusterID)
+		return
+	}
+
+	s.sequence++
+	a := &client.K8sAgent{
+		ID:           s.sequence,
+		ClusterID:    req.ClusterID,
+		Name:         req.Name,
+		Description:  req.Description,
+		TokenPrefix:  "shp_agent_",
+		Status:       "active",
+		OnlineStatus: "offline",
+		CreatedAt:    s.timestamp(),
+	}
+	if req.ExpiresIn != nil {
+		a.ExpiresAt = s.now().AddDate(0, 0, *req.ExpiresIn).Format(time.RFC3339)
+	}
+	s.agents[a.ClusterID] = a
+
+	writeJSON(w, http.StatusCreated, client.RegisterK8sAgentResponse{
+		Token:       a.TokenPrefix + strconv.Itoa(a.ID) + "secret",
+		TokenPrefix: a.TokenPrefix,
+		ClusterID:   a.ClusterID,
+		Name:        a.Name,
+		ExpiresAt:   a.ExpiresAt,
+		CreatedAt:   a.CreatedAt,
+	})
+}
+
+func (s *Server) revokeK8sAgent(w http.ResponseWriter, r *http.Request) {
+	a, ok := s.agents[r.PathValue("clusterId")]
+	if !ok {
+		notFound(w, "agent", r.PathValue("clusterId"))
+		return
+	}
+	a.Status = "revoked"
+	writeJSON(w, http.StatusOK, map[string]string{"status": "revoked", "message": "Agent token revoked successfully"})
+}
+
+func (s *Server) deleteK8sAgent(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("clusterId")
+	if _, ok := s.agents[id]; !ok {
+		notFound(w, "agent", id)
+		return
+	}
+	delete(s.agents, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findIntegration resolves the {id} path value, writing a 404 and returning nil if it is unknown.
+func (s *Server) findIntegration(w http.ResponseWriter, r *http.Request) *client.Integration {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err == nil {
+		if i, ok := s.integrations[id]; ok {
+			return i
+		}
+	}
+	notFound(w, "integration", r.PathValue("id"))
+	return nil
+}
+
+func (s *Server) sortedIntegrations() []client.Integration {
+	list := make([]client.Integration, 0, len(s.integrations))
+	for _, i := range s.integrations {
+		list = append(list, *i)
+	}
+	sort.Slice(list, func(a, b int) bool { return list[a].ID < list[b].ID })
+	return list
+}
+
+func (s *Server) integrationsStatus(w http.ResponseWriter, _ *http.Request) {
+	statuses := []client.IntegrationStatus{}
+	healthy := 0
+	for _, i := range s.sortedIntegrations() {
+		statuses = append(statuses, client.IntegrationStatus{Type: i.Type, Provider: i.Name, Status: i.Status, LastSync: i.LastSyncAt})
+		if i.Status == "active" {
+			healthy++
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"integrations": statuses,
+		"total":        len(statuses),
+		"healthy":      healthy,
+		"last_updated": s.timestamp(),
+	})
+}
+
+func (s *Server) listIntegrations(w http.ResponseWriter, _ *http.Request) {
+	list := s.sortedIntegrations()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"integrations": list, "count": len(list)})
+}
+
+func (s *Server) getIntegration(w http.ResponseWriter, r *http.Request) {
+	if i := s.findIntegration(w, r); i != nil {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"integration": i})
+	}
+}
+
+func (s *Server) createIntegration(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateIntegrationRequest
+	if !decode(w, r, &req) || !required(w, map[string]string{"name": req.Name, "type": req.Type}) {
+		return
+	}
+
+	s.sequence++
+	now := s.timestamp()
+	i := &client.Integration{
+		ID:        s.sequence,
+		Name:      req.Name,
+		Type:      req.Type,
+		Status:    "active",
+		Config:    req.Config,
+		TeamID:    req.TeamID,
+		CreatedBy: "terraform",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	s.integrations[i.ID] = i
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"integration": i})
+}
+
+func (s *Server) updateIntegration(w http.ResponseWriter, r *http.Request) {
+	i := s.findIntegration(w, r)
+	if i == nil {
+		return
+	}
+	if !preconditionMet(w, r, "integration", r.PathValue("id"), i.UpdatedAt) {
+		return
+	}
+
+	var req client.UpdateIntegrationRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name != "" {
+		i.Name = req.Name
+	}
+	if req.Status != "" {
+		i.Status = req.Status
+	}
+	if req.Config != nil {
+		i.Config = req.Config
+	}
+	i.UpdatedAt = s.timestamp()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"integration": i})
+}
+
+// syncIntegration records a successful sync. Integrations that are not active
+// fail to sync and keep their previous last sync time.
+func (s *Server) syncIntegration(w http.ResponseWriter, r *http.Request) {
+	i := s.findIntegration(w, r)
+	if i == nil {
+		return
+	}
+	if i.Status != "active" {
+		i.LastError = "integration is " + i.Status
+	} else {
+		i.LastSyncAt = s.timestamp()
+		i.LastError = ""
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"integration": i})
+}
+
+func (s *Server) deleteIntegration(w http.ResponseWriter, r *http.Request) {
+	if i := s.findIntegration(w, r); i != nil {
+		delete(s.integrations, i.ID)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
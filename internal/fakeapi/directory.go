@@ -0,0 +1,298 @@
+package fakeapi
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func (s *Server) registerDirectoryRoutes(mux *http.ServeMux) {
+	s.handle(mux, "GET /api/v1/users", s.listUsers)
+	s.handle(mux, "GET /api/v1/users/{id}", s.getUser)
+
+	s.handle(mux, "GET /api/v1/groups", s.listGroups)
+	s.handle(mux, "GET /api/v1/groups/{name}/roles", s.getGroupRoles)
+	s.handle(mux, "POST /api/v1/groups/{name}/roles", s.assignGroupRole)
+	s.handle(mux, "DELETE /api/v1/groups/{name}/roles/{role}", s.removeGroupRole)
+
+	s.handle(mux, "GET /api/v1/roles", s.listRoles)
+	s.handle(mux, "POST /api/v1/roles/users/{id}/roles", s.addUserRole)
+	s.handle(mux, "DELETE /api/v1/roles/users/{id}/roles/{role}", s.removeUserRole)
+
+	s.handle(mux, "GET /api/v1/admin/bundles", s.listBundles)
+	s.handle(mux, "GET /api/v1/admin/bundles/{name}", s.getBundle)
+	s.handle(mux, "POST /api/v1/admin/bundles", s.createBundle)
+	s.handle(mux, "PUT /api/v1/admin/bundles/{name}", s.updateBundle)
+	s.handle(mux, "DELETE /api/v1/admin/bundles/{name}", s.deleteBundle)
+}
+
+// AddUser seeds a user in the identity provider directory.
+func (s *Server) AddUser(u client.DirectoryUser) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if u.ID == "" {
+		u.ID = s.nextID("user")
+	}
+	s.users[u.ID] = &u
+}
+
+// AddBundle seeds a permission bundle. Seeded bundles are marked as built-in
+// and cannot be changed or deleted through the API.
+func (s *Server) AddBundle(b client.Bundle) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if b.ID == "" {
+		b.ID = s.nextID("bundle")
+	}
+	b.System = true
+	s.bundles[b.Name] = &b
+}
+
+// AddGroup seeds a group in the identity provider directory.
+func (s *Server) AddGroup(g client.Group) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if g.ID == "" {
+		g.ID = s.nextID("group")
+	}
+	if g.Path == "" {
+		g.Path = "/" + g.Name
+	}
+	s.groups[g.Name] = &g
+}
+
+// AddGroupMember seeds userID as a direct member of the group at groupPath.
+func (s *Server) AddGroupMember(groupPath, userID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.groupMembers[groupPath] = append(s.groupMembers[groupPath], userID)
+}
+
+// groupPaths returns the paths of all seeded groups and their subgroups.
+// Callers must hold s.mu.
+func (s *Server) groupPaths() []string {
+	var paths []string
+	var walk func(groups []client.Group)
+	walk = func(groups []client.Group) {
+		for _, g := range groups {
+			paths = append(paths, g.Path)
+			walk(g.SubGroups)
+		}
+	}
+	for _, g := range s.groups {
+		walk([]client.Group{*g})
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, _ *http.Request) {
+	users := make([]client.DirectoryUser, 0, len(s.users))
+	for _, u := range s.users {
+		users = append(users, *u)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Username < users[j].Username })
+	writeJSON(w, http.StatusOK, map[string]interface{}{"items": users, "provider": "fake"})
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	u, ok := s.users[r.PathValue("id")]
+	if !ok {
+		notFound(w, "user", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, u)
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, _ *http.Request) {
+	groups := make([]client.Group, 0, len(s.groups))
+	for _, g := range s.groups {
+		group := *g
+		group.Roles = s.groupRoles[g.Name]
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	writeJSON(w, http.StatusOK, map[string]interface{}{"items": groups})
+}
+
+func (s *Server) getGroupRoles(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if _, ok := s.groups[name]; !ok {
+		notFound(w, "group", name)
+		return
+	}
+	roles := s.groupRoles[name]
+	if roles == nil {
+		roles = []client.GroupRoleInfo{}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"roles": roles})
+}
+
+func (s *Server) assignGroupRole(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if _, ok := s.groups[name]; !ok {
+		notFound(w, "group", name)
+		return
+	}
+
+	var req client.GroupRoleRequest
+	if !decode(w, r, &req) || !required(w, map[string]string{"role_name": req.RoleName}) {
+		return
+	}
+	for _, existing := range s.groupRoles[name] {
+		if existing.RoleName == req.RoleName {
+			conflict(w, "group role", name+"/"+req.RoleName)
+			return
+		}
+	}
+	s.groupRoles[name] = append(s.groupRoles[name], client.GroupRoleInfo{RoleName: req.RoleName, Provider: req.Provider})
+	writeJSON(w, http.StatusCreated, map[string]string{"message": "role assigned"})
+}
+
+func (s *Server) removeGroupRole(w http.ResponseWriter, r *http.Request) {
+	name, role := r.PathValue("name"), r.PathValue("role")
+	roles := s.groupRoles[name]
+	for i, existing := range roles {
+		if existing.RoleName == role {
+			s.groupRoles[name] = append(roles[:i:i], roles[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	notFound(w, "group role", name+"/"+role)
+}
+
+func (s *Server) listRoles(w http.ResponseWriter, _ *http.Request) {
+	roles := []client.UserRole{}
+	for userID, assigned := range s.userRoles {
+		email := ""
+		if u, ok := s.users[userID]; ok {
+			email = u.Email
+		}
+		for _, role := range assigned {
+			roles = append(roles, client.UserRole{UserID: userID, Email: email, Role: role})
+		}
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		if roles[i].UserID != roles[j].UserID {
+			return roles[i].UserID < roles[j].UserID
+		}
+		return roles[i].Role < roles[j].Role
+	})
+	writeJSON(w, http.StatusOK, map[string]interface{}{"roles": roles, "count": len(roles)})
+}
+
+func (s *Server) addUserRole(w http.ResponseWriter, r *http.Request) {
+	userID := r.PathValue("id")
+	var req client.RoleRequest
+	if !decode(w, r, &req) || !required(w, map[string]string{"role": req.Role}) {
+		return
+	}
+	for _, existing := range s.userRoles[userID] {
+		if existing == req.Role {
+			conflict(w, "user role", userID+"/"+req.Role)
+			return
+		}
+	}
+	s.userRoles[userID] = append(s.userRoles[userID], req.Role)
+	writeJSON(w, http.StatusCreated, map[string]string{"message": "role added"})
+}
+
+func (s *Server) removeUserRole(w http.ResponseWriter, r *http.Request) {
+	userID, role := r.PathValue("id"), r.PathValue("role")
+	roles := s.userRoles[userID]
+	for i, existing := range roles {
+		if existing == role {
+			s.userRoles[userID] = append(roles[:i:i], roles[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	notFound(w, "user role", userID+"/"+role)
+}
+
+func (s *Server) listBundles(w http.ResponseWriter, _ *http.Request) {
+	bundles := make([]client.Bundle, 0, len(s.bundles))
+	for _, b := range s.bundles {
+		bundles = append(bundles, *b)
+	}
+	sort.Slice(bundles, func(i, j int) bool { return bundles[i].Name < bundles[j].Name })
+	writeJSON(w, http.StatusOK, map[string]interface{}{"items": bundles})
+}
+
+func (s *Server) getBundle(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.bundles[r.PathValue("name")]
+	if !ok {
+		notFound(w, "bundle", r.PathValue("name"))
+		return
+	}
+	writeJSON(w, http.StatusOK, b)
+}
+
+func (s *Server) createBundle(w http.ResponseWriter, r *http.Request) {
+	var req client.BundleRequest
+	if !decode(w, r, &req) || !required(w, map[string]string{"name": req.Name, "displayName": req.DisplayName}) {
+		return
+	}
+	if _, exists := s.bundles[req.Name]; exists {
+		conflict(w, "bundle", req.Name)
+		return
+	}
+
+	now := s.timestamp()
+	b := &client.Bundle{ID: s.nextID("bundle"), CreatedAt: now}
+	applyBundleRequest(b, req, now)
+	s.bundles[b.Name] = b
+	writeJSON(w, http.StatusCreated, b)
+}
+
+func (s *Server) updateBundle(w http.ResponseWriter, r *http.Request) {
+	b, ok := s.bundles[r.PathValue("name")]
+	if !ok {
+		notFound(w, "bundle", r.PathValue("name"))
+		return
+	}
+	if b.System {
+		writeError(w, http.StatusForbidden, "forbidden", "built-in bundles cannot be modified")
+		return
+	}
+	if !preconditionMet(w, r, "bundle", b.Name, b.UpdatedAt) {
+		return
+	}
+
+	var req client.BundleRequest
+	if !decode(w, r, &req) || !required(w, map[string]string{"displayName": req.DisplayName}) {
+		return
+	}
+	req.Name = b.Name
+	applyBundleRequest(b, req, s.timestamp())
+	writeJSON(w, http.StatusOK, b)
+}
+
+func (s *Server) deleteBundle(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	b, ok := s.bundles[name]
+	if !ok {
+		notFound(w, "bundle", name)
+		return
+	}
+	if b.System {
+		writeError(w, http.StatusForbidden, "forbidden", "built-in bundles cannot be deleted")
+		return
+	}
+	delete(s.bundles, name)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// applyBundleRequest copies the fields of a create or replace request onto b.
+func applyBundleRequest(b *client.Bundle, req client.BundleRequest, now string) {
+	b.Name = req.Name
+	b.DisplayName = req.DisplayName
+	b.Description = req.Description
+	b.Color = req.Color
+	b.Icon = req.Icon
+	b.Permissions = append([]string{}, req.Permissions...)
+	sort.Strings(b.Permissions)
+	b.UpdatedAt = now
+}
@@ -0,0 +1,235 @@
+package fakeapi
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func (s *Server) registerEntityRoutes(mux *http.ServeMux) {
+	s.handle(mux, "GET /api/v1/entities", s.listEntities)
+	s.handle(mux, "GET /api/v1/entities/{id}", s.getEntity)
+	s.handle(mux, "POST /api/v1/manifests/entities", s.createEntity)
+	s.handle(mux, "PUT /api/v1/manifests/entities/{id}", s.updateEntity)
+	s.handle(mux, "DELETE /api/v1/manifests/entities/{id}", s.deleteEntity)
+}
+
+// entityManifest is the subset of the catalog manifest format the fake understands.
+type entityManifest struct {
+	Service struct {
+		ID   string `yaml:"id"`
+		Name string `yaml:"name"`
+		Type string `yaml:"type"`
+		Tier string `yaml:"tier"`
+	} `yaml:"service"`
+	Description string             `yaml:"description"`
+	Lifecycle   string             `yaml:"lifecycle"`
+	Owner       []client.OwnerInfo `yaml:"owner"`
+	Tags        []string           `yaml:"tags"`
+	Links       []client.LinkInfo  `yaml:"links"`
+	Relations   []struct {
+		Type   string `yaml:"type"`
+		Target string `yaml:"target"`
+		Via    string `yaml:"via"`
+	} `yaml:"relations"`
+	Integrations *struct {
+		Changelog *struct {
+			Path string `yaml:"path"`
+		} `yaml:"changelog"`
+		Licenses []struct {
+			Title     string `yaml:"title"`
+			Vendor    string `yaml:"vendor"`
+			Purchased string `yaml:"purchased"`
+			Expires   string `yaml:"expires"`
+			Seats     int    `yaml:"seats"`
+			Cost      string `yaml:"cost"`
+			Contract  string `yaml:"contract"`
+			Notes     string `yaml:"notes"`
+		} `yaml:"licenses"`
+	} `yaml:"integrations"`
+	Interfaces map[string]interface{} `yaml:"interfaces"`
+}
+
+// toEntity converts a parsed manifest to the entity shape returned by GET.
+func (m *entityManifest) toEntity() *client.Entity {
+	e := &client.Entity{
+		Service: client.EntityService{
+			ID:   m.Service.ID,
+			Name: m.Service.Name,
+			Type: m.Service.Type,
+			Tier: m.Service.Tier,
+		},
+		Description: m.Description,
+		Lifecycle:   m.Lifecycle,
+		Owner:       m.Owner,
+		Tags:        m.Tags,
+		Links:       m.Links,
+		Interfaces:  m.Interfaces,
+	}
+	for _, rel := range m.Relations {
+		targetType, targetID, _ := strings.Cut(rel.Target, ":")
+		e.Relations = append(e.Relations, client.RelationInfo{
+			Type:       rel.Type,
+			TargetType: targetType,
+			TargetID:   targetID,
+			Via:        rel.Via,
+		})
+	}
+	if m.Integrations != nil {
+		e.Integrations = &client.Integrations{}
+		if m.Integrations.Changelog != nil {
+			e.Integrations.Changelog = &client.ChangelogIntegration{Path: m.Integrations.Changelog.Path}
+		}
+		for _, lic := range m.Integrations.Licenses {
+			e.Integrations.Licenses = append(e.Integrations.Licenses, client.LicenseInfo(lic))
+		}
+	}
+	return e
+}
+
+// parseManifest decodes a manifest upload, writing a 400 and returning nil if it is invalid.
+func parseManifest(w http.ResponseWriter, r *http.Request) *entityManifest {
+	var req client.CreateEntityRequest
+	if !decode(w, r, &req) {
+		return nil
+	}
+
+	var m entityManifest
+	if err := yaml.Unmarshal([]byte(req.Content), &m); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_manifest", err.Error())
+		return nil
+	}
+	if !required(w, map[string]string{"service.id": m.Service.ID, "service.name": m.Service.Name, "service.type": m.Service.Type}) {
+		return nil
+	}
+	return &m
+}
+
+// manifestResponse builds the create/update manifest response for an entity. Callers must hold s.mu.
+func (s *Server) manifestResponse(e *client.Entity) client.ManifestEntityResponse {
+	var resp client.ManifestEntityResponse
+	resp.Success = true
+	resp.Entity.ID = s.entityIDs[e.Service.ID]
+	resp.Entity.ServiceID = e.Service.ID
+	resp.Entity.Name = e.Service.Name
+	resp.Entity.Type = e.Service.Type
+	resp.Entity.Lifecycle = e.Lifecycle
+	resp.Entity.Description = e.Description
+	resp.Entity.Source = "terraform"
+	resp.Entity.CreatedAt = e.CreatedAt
+	resp.Entity.UpdatedAt = e.UpdatedAt
+	return resp
+}
+
+func (s *Server) listEntities(w http.ResponseWriter, r *http.Request) {
+	ids := make([]string, 0, len(s.entities))
+	for id := range s.entities {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 100
+	}
+	start := 0
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+		start = sort.SearchStrings(ids, cursor)
+	}
+
+	items := []client.EntityListItem{}
+	var next *string
+	for i := start; i < len(ids); i++ {
+		if len(items) == limit {
+			next = &ids[i]
+			break
+		}
+		e := s.entities[ids[i]]
+		items = append(items, client.EntityListItem{
+			Service:     e.Service,
+			Description: e.Description,
+			Owner:       e.Owner,
+			Lifecycle:   e.Lifecycle,
+			Tags:        e.Tags,
+			CreatedAt:   e.CreatedAt,
+			UpdatedAt:   e.UpdatedAt,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"entities": items,
+		"page":     map[string]interface{}{"total": len(ids), "limit": limit, "nextCursor": next},
+	})
+}
+
+func (s *Server) getEntity(w http.ResponseWriter, r *http.Request) {
+	e, ok := s.entities[r.PathValue("id")]
+	if !ok {
+		notFound(w, "entity", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"entity": e})
+}
+
+func (s *Server) createEntity(w http.ResponseWriter, r *http.Request) {
+	m := parseManifest(w, r)
+	if m == nil {
+		return
+	}
+	if _, exists := s.entities[m.Service.ID]; exists {
+		conflict(w, "entity", m.Service.ID)
+		return
+	}
+
+	e := m.toEntity()
+	e.CreatedAt = s.timestamp()
+	e.UpdatedAt = e.CreatedAt
+	s.entities[e.Service.ID] = e
+	s.sequence++
+	s.entityIDs[e.Service.ID] = s.sequence
+
+	writeJSON(w, http.StatusCreated, s.manifestResponse(e))
+}
+
+func (s *Server) updateEntity(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	existing, ok := s.entities[id]
+	if !ok {
+		notFound(w, "entity", id)
+		return
+	}
+	if !preconditionMet(w, r, "entity", id, existing.UpdatedAt) {
+		return
+	}
+	m := parseManifest(w, r)
+	if m == nil {
+		return
+	}
+	if m.Service.ID != id {
+		writeError(w, http.StatusBadRequest, "validation_error", "service.id cannot be changed")
+		return
+	}
+
+	e := m.toEntity()
+	e.CreatedAt = existing.CreatedAt
+	e.UpdatedAt = s.timestamp()
+	s.entities[id] = e
+
+	writeJSON(w, http.StatusOK, s.manifestResponse(e))
+}
+
+func (s *Server) deleteEntity(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.entities[id]; !ok {
+		notFound(w, "entity", id)
+		return
+	}
+	delete(s.entities, id)
+	delete(s.entityIDs, id)
+	w.WriteHeader(http.StatusNoContent)
+}
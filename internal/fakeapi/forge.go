@@ -0,0 +1,345 @@
+package fakeapi
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func (s *Server) registerForgeRoutes(mux *http.ServeMux) {
+	s.handle(mux, "GET /api/v1/forge/molds", s.listMolds)
+	s.handle(mux, "POST /api/v1/forge/molds", s.createMold)
+	s.handle(mux, "GET /api/v1/forge/molds/{slug}", s.getMold)
+	s.handle(mux, "PUT /api/v1/forge/molds/{slug}", s.updateMold)
+	s.handle(mux, "DELETE /api/v1/forge/molds/{slug}", s.deleteMold)
+	s.handle(mux, "POST /api/v1/forge/molds/{slug}/publish", s.publishMold)
+
+	s.handle(mux, "GET /api/v1/forge/approval-policies", s.listApprovalPolicies)
+	s.handle(mux, "POST /api/v1/forge/approval-policies", s.createApprovalPolicy)
+	s.handle(mux, "GET /api/v1/forge/approval-policies/{id}", s.getApprovalPolicy)
+	s.handle(mux, "PUT /api/v1/forge/approval-policies/{id}", s.updateApprovalPolicy)
+	s.handle(mux, "DELETE /api/v1/forge/approval-policies/{id}", s.deleteApprovalPolicy)
+
+	s.handle(mux, "POST /api/v1/forge/runs", s.createRun)
+	s.handle(mux, "GET /api/v1/forge/runs/{id}", s.getRun)
+	s.handle(mux, "POST /api/v1/forge/runs/{id}/cancel", s.cancelRun)
+}
+
+// SetForgeRunStatus moves a run to the given status, as an approver or the
+// runner would. Runs that need approval stay pending until this is called.
+func (s *Server) SetForgeRunStatus(id, status string, outputs map[string]interface{}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	run, ok := s.runs[id]
+	if !ok {
+		return false
+	}
+	run.Status = status
+	if outputs != nil {
+		run.Outputs = outputs
+	}
+	run.UpdatedAt = s.timestamp()
+	if run.IsTerminal() {
+		run.CompletedAt = run.UpdatedAt
+	}
+	return true
+}
+
+// PendingForgeRuns returns the IDs of runs waiting for approval, in order.
+func (s *Server) PendingForgeRuns() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []string
+	for id, run := range s.runs {
+		if run.Status == client.ForgeRunStatusPendingApproval {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (s *Server) listMolds(w http.ResponseWriter, _ *http.Request) {
+	molds := make([]client.ForgeMold, 0, len(s.molds))
+	for _, m := range s.molds {
+		molds = append(molds, *m)
+	}
+	sort.Slice(molds, func(i, j int) bool { return molds[i].Slug < molds[j].Slug })
+	writeJSON(w, http.StatusOK, map[string]interface{}{"molds": molds})
+}
+
+func (s *Server) getMold(w http.ResponseWriter, r *http.Request) {
+	m, ok := s.molds[r.PathValue("slug")]
+	if !ok {
+		notFound(w, "mold", r.PathValue("slug"))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"mold": m})
+}
+
+func (s *Server) createMold(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateForgeMoldRequest
+	if !decode(w, r, &req) || !required(w, map[string]string{"slug": req.Slug, "name": req.Name, "version": req.Version}) {
+		return
+	}
+	if _, exists := s.molds[req.Slug]; exists {
+		conflict(w, "mold", req.Slug)
+		return
+	}
+
+	now := s.timestamp()
+	m := &client.ForgeMold{
+		ID:          s.nextID("mold"),
+		Slug:        req.Slug,
+		Name:        req.Name,
+		Description: req.Description,
+		Version:     req.Version,
+		Visibility:  req.Visibility,
+		Tags:        req.Tags,
+		Icon:        req.Icon,
+		Category:    req.Category,
+		Schema:      req.Schema,
+		Defaults:    req.Defaults,
+		Actions:     req.Actions,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	s.molds[m.Slug] = m
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"mold": m})
+}
+
+func (s *Server) updateMold(w http.ResponseWriter, r *http.Request) {
+	m, ok := s.molds[r.PathValue("slug")]
+	if !ok {
+		notFound(w, "mold", r.PathValue("slug"))
+		return
+	}
+	if !preconditionMet(w, r, "mold", m.Slug, m.UpdatedAt) {
+		return
+	}
+
+	var req client.UpdateForgeMoldRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Version != "" && req.Version != m.Version {
+		m.Version = req.Version
+		m.Published = false
+	}
+	if req.Name != "" {
+		m.Name = req.Name
+	}
+	if req.Description != "" {
+		m.Description = req.Description
+	}
+	if req.Tags != nil {
+		m.Tags = req.Tags
+	}
+	if req.Icon != "" {
+		m.Icon = req.Icon
+	}
+	if req.Schema != nil {
+		m.Schema = req.Schema
+	}
+	if req.Defaults != nil {
+		m.Defaults = req.Defaults
+	}
+	m.UpdatedAt = s.timestamp()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"mold": m})
+}
+
+func (s *Server) deleteMold(w http.ResponseWriter, r *http.Request) {
+	slug := r.PathValue("slug")
+	m, ok := s.molds[slug]
+	if !ok {
+		notFound(w, "mold", slug)
+		return
+	}
+	if v := r.URL.Query().Get("version"); v != "" && v != m.Version {
+		notFound(w, "mold version", slug+"@"+v)
+		return
+	}
+	delete(s.molds, slug)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) publishMold(w http.ResponseWriter, r *http.Request) {
+	m, ok := s.molds[r.PathValue("slug")]
+	if !ok {
+		notFound(w, "mold", r.PathValue("slug"))
+		return
+	}
+
+	var req struct {
+		Version string `json:"version"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Version != "" && req.Version != m.Version {
+		notFound(w, "mold version", m.Slug+"@"+req.Version)
+		return
+	}
+	m.Published = true
+	m.UpdatedAt = s.timestamp()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"mold": m})
+}
+
+func (s *Server) listApprovalPolicies(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"policies": s.sortedApprovalPolicies()})
+}
+
+func (s *Server) sortedApprovalPolicies() []client.ForgeApprovalPolicy {
+	policies := make([]client.ForgeApprovalPolicy, 0, len(s.approvalPolicies))
+	for _, p := range s.approvalPolicies {
+		policies = append(policies, *p)
+	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i].ID < policies[j].ID })
+	return policies
+}
+
+func (s *Server) getApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.approvalPolicies[r.PathValue("id")]
+	if !ok {
+		notFound(w, "approval policy", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"policy": p})
+}
+
+func (s *Server) createApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateApprovalPolicyRequest
+	if !decode(w, r, &req) || !required(w, map[string]string{"name": req.Name}) {
+		return
+	}
+
+	now := s.timestamp()
+	p := &client.ForgeApprovalPolicy{
+		ID:               s.nextID("approval-policy"),
+		Name:             req.Name,
+		Description:      req.Description,
+		Enabled:          req.Enabled,
+		Priority:         req.Priority,
+		ApprovalChain:    req.ApprovalChain,
+		AutoApproveAfter: req.AutoApproveAfter,
+		Match:            req.Match,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	s.approvalPolicies[p.ID] = p
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"policy": p})
+}
+
+func (s *Server) updateApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.approvalPolicies[r.PathValue("id")]
+	if !ok {
+		notFound(w, "approval policy", r.PathValue("id"))
+		return
+	}
+	if !preconditionMet(w, r, "approval policy", p.ID, p.UpdatedAt) {
+		return
+	}
+
+	var req client.UpdateApprovalPolicyRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name != "" {
+		p.Name = req.Name
+	}
+	if req.Description != "" {
+		p.Description = req.Description
+	}
+	if req.Enabled != nil {
+		p.Enabled = *req.Enabled
+	}
+	if req.Priority != nil {
+		p.Priority = *req.Priority
+	}
+	if req.ApprovalChain != nil {
+		p.ApprovalChain = req.ApprovalChain
+	}
+	if req.AutoApproveAfter != nil {
+		p.AutoApproveAfter = *req.AutoApproveAfter
+	}
+	if req.Match != nil {
+		p.Match = req.Match
+	}
+	p.UpdatedAt = s.timestamp()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"policy": p})
+}
+
+func (s *Server) deleteApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.approvalPolicies[id]; !ok {
+		notFound(w, "approval policy", id)
+		return
+	}
+	delete(s.approvalPolicies, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// createRun starts a mold run. Runs governed by an approval policy with an
+// approval chain wait in pending_approval; all others succeed immediately.
+func (s *Server) createRun(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateForgeRunRequest
+	if !decode(w, r, &req) || !required(w, map[string]string{"mold_slug": req.MoldSlug}) {
+		return
+	}
+	mold, ok := s.molds[req.MoldSlug]
+	if !ok {
+		notFound(w, "mold", req.MoldSlug)
+		return
+	}
+	if req.Version != "" && req.Version != mold.Version {
+		notFound(w, "mold version", mold.Slug+"@"+req.Version)
+		return
+	}
+
+	now := s.timestamp()
+	run := &client.ForgeRun{
+		ID:          s.nextID("run"),
+		MoldSlug:    mold.Slug,
+		MoldVersion: mold.Version,
+		Team:        req.Team,
+		Status:      client.ForgeRunStatusSucceeded,
+		Inputs:      req.Inputs,
+		RequestedBy: "terraform",
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		CompletedAt: now,
+	}
+	if policy := client.ResolveApprovalPolicy(s.sortedApprovalPolicies(), mold, req.Team); policy != nil {
+		run.ApprovalPolicyID = policy.ID
+		if len(policy.ApprovalChain) > 0 {
+			run.Status = client.ForgeRunStatusPendingApproval
+			run.CompletedAt = ""
+		}
+	}
+	s.runs[run.ID] = run
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"run": run})
+}
+
+func (s *Server) getRun(w http.ResponseWriter, r *http.Request) {
+	run, ok := s.runs[r.PathValue("id")]
+	if !ok {
+		notFound(w, "run", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"run": run})
+}
+
+func (s *Server) cancelRun(w http.ResponseWriter, r *http.Request) {
+	run, ok := s.runs[r.PathValue("id")]
+	if !ok {
+		notFound(w, "run", r.PathValue("id"))
+		return
+	}
+	if !run.IsTerminal() {
+		run.Status = client.ForgeRunStatusCancelled
+		run.UpdatedAt = s.timestamp()
+		run.CompletedAt = run.UpdatedAt
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"run": run})
+}
@@ -0,0 +1,106 @@
+package fakeapi
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func (s *Server) registerGitOpsRoutes(mux *http.ServeMux) {
+	s.handle(mux, "GET /api/v1/operations/gitops", s.listGitOpsResources)
+	s.handle(mux, "GET /api/v1/operations/gitops/stats", s.gitOpsStats)
+	s.handle(mux, "GET /api/v1/operations/gitops/{id}", s.getGitOpsResource)
+}
+
+// AddGitOpsResource seeds or replaces a GitOps resource. GitOps resources are
+// discovered by cluster agents, so the API exposes them read-only.
+func (s *Server) AddGitOpsResource(res client.GitOpsResource) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if res.ID == "" {
+		res.ID = s.nextID("gitops")
+	}
+	s.gitops[res.ID] = &res
+}
+
+func (s *Server) listGitOpsResources(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	resources := []client.GitOpsResource{}
+	for _, res := range s.gitops {
+		if v := q.Get("cluster_id"); v != "" && res.ClusterID != v {
+			continue
+		}
+		if v := q.Get("tool"); v != "" && res.Tool != v {
+			continue
+		}
+		if v := q.Get("sync_status"); v != "" && !strings.EqualFold(res.SyncStatus, v) {
+			continue
+		}
+		if v := q.Get("health_status"); v != "" && !strings.EqualFold(res.HealthStatus, v) {
+			continue
+		}
+		if v := q.Get("entity_id"); v != "" && res.EntityID != v {
+			continue
+		}
+		if v := q.Get("owner_team"); v != "" && res.OwnerTeam != v {
+			continue
+		}
+		if v := q.Get("namespace"); v != "" && res.Namespace != v {
+			continue
+		}
+		if v := q.Get("kind"); v != "" && !strings.EqualFold(res.Kind, v) {
+			continue
+		}
+		if v := q.Get("suspended"); v != "" && res.Suspended != (v == "true") {
+			continue
+		}
+		if v := q.Get("auto_sync"); v != "" && res.AutoSync != (v == "true") {
+			continue
+		}
+		resources = append(resources, *res)
+	}
+	sort.Slice(resources, func(i, j int) bool { return resources[i].ID < resources[j].ID })
+
+	total := len(resources)
+	offset, _ := strconv.Atoi(q.Get("offset"))
+	resources = resources[min(offset, total):]
+	if limit, err := strconv.Atoi(q.Get("limit")); err == nil && limit > 0 && limit < len(resources) {
+		resources = resources[:limit]
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"resources": resources, "total": total})
+}
+
+func (s *Server) getGitOpsResource(w http.ResponseWriter, r *http.Request) {
+	res, ok := s.gitops[r.PathValue("id")]
+	if !ok {
+		notFound(w, "gitops resource", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"resource": res})
+}
+
+func (s *Server) gitOpsStats(w http.ResponseWriter, r *http.Request) {
+	var stats client.GitOpsStats
+	for _, res := range s.gitops {
+		if v := r.URL.Query().Get("cluster_id"); v != "" && res.ClusterID != v {
+			continue
+		}
+		stats.Total++
+		switch {
+		case res.Suspended:
+			stats.Suspended++
+		case strings.EqualFold(res.SyncStatus, "failed") || strings.EqualFold(res.HealthStatus, "degraded"):
+			stats.Failed++
+		case strings.EqualFold(res.SyncStatus, "synced"):
+			stats.Synced++
+		case strings.EqualFold(res.SyncStatus, "outofsync") || strings.EqualFold(res.SyncStatus, "out_of_sync"):
+			stats.OutOfSync++
+		default:
+			stats.Unknown++
+		}
+	}
+	writeJSON(w, http.StatusOK, stats)
+}
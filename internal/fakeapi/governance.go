@@ -0,0 +1,183 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func (s *Server) registerGovernanceRoutes(mux *http.ServeMux) {
+	s.handle(mux, "GET /api/v1/governance/actions", s.listGovernanceActions)
+	s.handle(mux, "POST /api/v1/governance/actions", s.createGovernanceAction)
+	s.handle(mux, "GET /api/v1/governance/actions/{id}", s.getGovernanceAction)
+	s.handle(mux, "PATCH /api/v1/governance/actions/{id}", s.updateGovernanceAction)
+	s.handle(mux, "DELETE /api/v1/governance/actions/{id}", s.deleteGovernanceAction)
+}
+
+// overdue reports whether an open action is past its due date.
+func (s *Server) overdue(a *client.GovernanceAction) bool {
+	return a.IsOverdue(s.now())
+}
+
+func (s *Server) listGovernanceActions(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	actions := []client.GovernanceAction{}
+	byStatus := map[string]int{}
+	byPriority := map[string]int{}
+	bySourceType := map[string]int{}
+	overdueCount := 0
+
+	for _, a := range s.governance {
+		if v := q.Get("entity_id"); v != "" && a.EntityID != v {
+			continue
+		}
+		if v := q.Get("status"); v != "" && a.Status != v {
+			continue
+		}
+		if v := q.Get("priority"); v != "" && a.Priority != v {
+			continue
+		}
+		if v := q.Get("source_type"); v != "" && a.SourceType != v {
+			continue
+		}
+		if v := q.Get("overdue"); v != "" && s.overdue(a) != (v == "true") {
+			continue
+		}
+		actions = append(actions, *a)
+		byStatus[a.Status]++
+		byPriority[a.Priority]++
+		bySourceType[a.SourceType]++
+		if s.overdue(a) {
+			overdueCount++
+		}
+	}
+	sort.Slice(actions, func(i, j int) bool { return actions[i].ID < actions[j].ID })
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"actions": actions,
+		"total":   len(actions),
+		"summary": map[string]interface{}{
+			"by_status":      byStatus,
+			"by_priority":    byPriority,
+			"by_source_type": bySourceType,
+			"overdue":        overdueCount,
+		},
+	})
+}
+
+func (s *Server) getGovernanceAction(w http.ResponseWriter, r *http.Request) {
+	a, ok := s.governance[r.PathValue("id")]
+	if !ok {
+		notFound(w, "governance action", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, a)
+}
+
+func (s *Server) createGovernanceAction(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateGovernanceActionRequest
+	if !decode(w, r, &req) || !required(w, map[string]string{
+		"entity_id":   req.EntityID,
+		"title":       req.Title,
+		"priority":    req.Priority,
+		"source_type": req.SourceType,
+	}) {
+		return
+	}
+
+	now := s.now()
+	a := &client.GovernanceAction{
+		ID:          s.nextID("action"),
+		EntityID:    req.EntityID,
+		EntityName:  req.EntityName,
+		Title:       req.Title,
+		Description: req.Description,
+		Priority:    req.Priority,
+		Status:      client.GovernanceStatusOpen,
+		SourceType:  req.SourceType,
+		SourceID:    req.SourceID,
+		SLADays:     req.SLADays,
+		CreatedBy:   "terraform",
+		CreatedAt:   now.Format(time.RFC3339),
+		UpdatedAt:   now.Format(time.RFC3339),
+		History: []client.GovernanceStatusChange{
+			{Status: client.GovernanceStatusOpen, Actor: "terraform", ChangedAt: now.Format(time.RFC3339)},
+		},
+	}
+	if req.AssignedTo != nil {
+		a.AssignedTo = *req.AssignedTo
+	}
+	if req.SLADays != nil {
+		a.DueDate = now.AddDate(0, 0, *req.SLADays).Format(time.RFC3339)
+	}
+	s.governance[a.ID] = a
+	writeJSON(w, http.StatusCreated, map[string]string{"id": a.ID, "message": "Governance action created"})
+}
+
+func (s *Server) updateGovernanceAction(w http.ResponseWriter, r *http.Request) {
+	a, ok := s.governance[r.PathValue("id")]
+	if !ok {
+		notFound(w, "governance action", r.PathValue("id"))
+		return
+	}
+	if !preconditionMet(w, r, "governance action", a.ID, a.UpdatedAt) {
+		return
+	}
+
+	var req client.UpdateGovernanceActionRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Status != nil && *req.Status != a.Status {
+		to := *req.Status
+		if !client.ValidGovernanceTransition(a.Status, to) {
+			writeError(w, http.StatusUnprocessableEntity, "invalid_transition",
+				fmt.Sprintf("cannot move from %s to %s", a.Status, to))
+			return
+		}
+		note := ""
+		if req.ResolutionNote != nil {
+			note = *req.ResolutionNote
+		}
+		if client.IsClosedGovernanceStatus(to) && strings.TrimSpace(note) == "" {
+			writeError(w, http.StatusUnprocessableEntity, "validation_error", "resolution_note is required to close an action")
+			return
+		}
+		a.Status = to
+		a.History = append(a.History, client.GovernanceStatusChange{Status: to, Actor: "terraform", Note: note, ChangedAt: s.timestamp()})
+	}
+	if req.Priority != nil {
+		a.Priority = *req.Priority
+	}
+	if req.AssignedTo != nil {
+		a.AssignedTo = *req.AssignedTo
+	}
+	if req.DueDate != nil {
+		a.DueDate = *req.DueDate
+	}
+	if req.SLADays != nil {
+		a.SLADays = req.SLADays
+		if created, err := time.Parse(time.RFC3339, a.CreatedAt); err == nil {
+			a.DueDate = created.AddDate(0, 0, *req.SLADays).Format(time.RFC3339)
+		}
+	}
+	if req.ResolutionNote != nil {
+		a.ResolutionNote = *req.ResolutionNote
+	}
+	a.UpdatedAt = s.timestamp()
+	writeJSON(w, http.StatusOK, map[string]string{"message": "Governance action updated"})
+}
+
+func (s *Server) deleteGovernanceAction(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.governance[id]; !ok {
+		notFound(w, "governance action", id)
+		return
+	}
+	delete(s.governance, id)
+	w.WriteHeader(http.StatusNoContent)
+}
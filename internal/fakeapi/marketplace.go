@@ -0,0 +1,197 @@
+package fakeapi
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func (s *Server) registerMarketplaceRoutes(mux *http.ServeMux) {
+	s.handle(mux, "GET /api/v1/marketplace", s.listMarketplaceItems)
+	s.handle(mux, "GET /api/v1/marketplace/installed", s.listInstallations)
+	s.handle(mux, "GET /api/v1/marketplace/installed/{slug}", s.getInstallation)
+	s.handle(mux, "POST /api/v1/marketplace/install", s.installItem)
+	s.handle(mux, "POST /api/v1/marketplace/{slug}/upgrade", s.upgradeItem)
+	s.handle(mux, "DELETE /api/v1/marketplace/{slug}/uninstall", s.uninstallItem)
+	s.handle(mux, "POST /api/v1/marketplace/{slug}/enable", s.setItemEnabled(true))
+	s.handle(mux, "POST /api/v1/marketplace/{slug}/disable", s.setItemEnabled(false))
+	s.handle(mux, "PUT /api/v1/marketplace/{slug}/config", s.updateItemConfig)
+}
+
+// AddMarketplaceItem seeds an item in the marketplace catalog.
+func (s *Server) AddMarketplaceItem(item client.MarketplaceItem) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.marketplace[item.Slug] = &item
+}
+
+// SetMarketplaceSyncStatus overrides the sync status of an installation, for
+// example to simulate a sync that is still running or has failed.
+func (s *Server) SetMarketplaceSyncStatus(slug, status, syncError string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	inst, ok := s.installations[slug]
+	if !ok {
+		return false
+	}
+	inst.SyncStatus = status
+	inst.SyncError = syncError
+	inst.LastSyncAt = s.timestamp()
+	return true
+}
+
+func (s *Server) listMarketplaceItems(w http.ResponseWriter, r *http.Request) {
+	kind := r.URL.Query().Get("kind")
+	category := r.URL.Query().Get("category")
+
+	items := []client.MarketplaceItem{}
+	for _, item := range s.marketplace {
+		if (kind == "" || item.Kind == kind) && (category == "" || item.Category == category) {
+			items = append(items, *item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Slug < items[j].Slug })
+	writeJSON(w, http.StatusOK, map[string]interface{}{"items": items})
+}
+
+func (s *Server) listInstallations(w http.ResponseWriter, _ *http.Request) {
+	installations := make([]client.MarketplaceInstallation, 0, len(s.installations))
+	for _, inst := range s.installations {
+		installations = append(installations, *inst)
+	}
+	sort.Slice(installations, func(i, j int) bool { return installations[i].Slug < installations[j].Slug })
+	writeJSON(w, http.StatusOK, map[string]interface{}{"installations": installations})
+}
+
+// findInstallation resolves the {slug} path value, writing a 404 and returning nil if it is not installed.
+func (s *Server) findInstallation(w http.ResponseWriter, r *http.Request) *client.MarketplaceInstallation {
+	inst, ok := s.installations[r.PathValue("slug")]
+	if !ok {
+		notFound(w, "installation", r.PathValue("slug"))
+		return nil
+	}
+	return inst
+}
+
+// checkItemVersion reports whether version is published for item, writing a 400 if not.
+func checkItemVersion(w http.ResponseWriter, item *client.MarketplaceItem, version string) bool {
+	for _, v := range item.AvailableVersions() {
+		if v == version {
+			return true
+		}
+	}
+	writeError(w, http.StatusBadRequest, "invalid_version", "version "+version+" of "+item.Slug+" does not exist")
+	return false
+}
+
+func (s *Server) getInstallation(w http.ResponseWriter, r *http.Request) {
+	if inst := s.findInstallation(w, r); inst != nil {
+		writeJSON(w, http.StatusOK, inst)
+	}
+}
+
+func (s *Server) installItem(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Slug    string `json:"slug"`
+		Version string `json:"version"`
+	}
+	if !decode(w, r, &req) || !required(w, map[string]string{"slug": req.Slug}) {
+		return
+	}
+	item, ok := s.marketplace[req.Slug]
+	if !ok {
+		notFound(w, "marketplace item", req.Slug)
+		return
+	}
+	if _, installed := s.installations[req.Slug]; installed {
+		conflict(w, "installation", req.Slug)
+		return
+	}
+	version := req.Version
+	if version == "" {
+		version = item.Version
+	}
+	if !checkItemVersion(w, item, version) {
+		return
+	}
+
+	now := s.timestamp()
+	inst := &client.MarketplaceInstallation{
+		ID:          s.nextID("installation"),
+		Slug:        item.Slug,
+		Kind:        item.Kind,
+		Version:     version,
+		Enabled:     true,
+		SyncStatus:  client.MarketplaceSyncStatusSynced,
+		LastSyncAt:  now,
+		InstalledBy: "terraform",
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	s.installations[inst.Slug] = inst
+	writeJSON(w, http.StatusCreated, inst)
+}
+
+func (s *Server) upgradeItem(w http.ResponseWriter, r *http.Request) {
+	inst := s.findInstallation(w, r)
+	if inst == nil {
+		return
+	}
+
+	var req struct {
+		Version string `json:"version"`
+	}
+	if !preconditionMet(w, r, "marketplace installation", inst.Slug, inst.UpdatedAt) ||
+		!decode(w, r, &req) || !required(w, map[string]string{"version": req.Version}) {
+		return
+	}
+	if item, ok := s.marketplace[inst.Slug]; ok && !checkItemVersion(w, item, req.Version) {
+		return
+	}
+	inst.Version = req.Version
+	inst.SyncStatus = client.MarketplaceSyncStatusSynced
+	inst.SyncError = ""
+	inst.UpdatedAt = s.timestamp()
+	inst.LastSyncAt = inst.UpdatedAt
+	writeJSON(w, http.StatusOK, inst)
+}
+
+func (s *Server) uninstallItem(w http.ResponseWriter, r *http.Request) {
+	if inst := s.findInstallation(w, r); inst != nil {
+		delete(s.installations, inst.Slug)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) setItemEnabled(enabled bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		inst := s.findInstallation(w, r)
+		if inst == nil || !preconditionMet(w, r, "marketplace installation", inst.Slug, inst.UpdatedAt) {
+			return
+		}
+		inst.Enabled = enabled
+		inst.UpdatedAt = s.timestamp()
+		writeJSON(w, http.StatusOK, inst)
+	}
+}
+
+func (s *Server) updateItemConfig(w http.ResponseWriter, r *http.Request) {
+	inst := s.findInstallation(w, r)
+	if inst == nil {
+		return
+	}
+
+	var req struct {
+		Config map[string]interface{} `json:"config"`
+	}
+	if !preconditionMet(w, r, "marketplace installation", inst.Slug, inst.UpdatedAt) || !decode(w, r, &req) {
+		return
+	}
+	inst.Config = req.Config
+	inst.SyncStatus = client.MarketplaceSyncStatusSynced
+	inst.SyncError = ""
+	inst.UpdatedAt = s.timestamp()
+	inst.LastSyncAt = inst.UpdatedAt
+	writeJSON(w, http.StatusOK, inst)
+}
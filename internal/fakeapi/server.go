@@ -0,0 +1,214 @@
+// Package fakeapi implements a stateful, in-memory fake of the Shoehorn REST
+// API. It serves the same routes and JSON shapes the client package consumes,
+// so provider resources can be exercised end to end (plan, apply, import,
+// destroy) without a real Shoehorn deployment.
+//
+// A Server starts on a random local port. Objects created through the API are
+// kept in memory until the server is closed; read-only catalogs such as users,
+// groups, built-in permission bundles, platform policies, marketplace items and
+// GitOps resources are seeded with the Add* methods.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+// Server is an in-memory Shoehorn API served over HTTP.
+type Server struct {
+	// URL is the base URL of the server, suitable for client.NewClient or the provider host attribute.
+	URL string
+
+	// APIKey is the bearer token the server accepts. Requests without it get a 401.
+	APIKey string
+
+	server *httptest.Server
+	now    func() time.Time
+
+	mu       sync.Mutex
+	sequence int
+
+	teams            map[string]*client.Team
+	entities         map[string]*client.Entity
+	entityIDs        map[string]int
+	features         map[string]*client.FeatureFlag
+	settings         client.TenantSettings
+	apiKeys          map[string]*client.APIKey
+	agents           map[string]*client.K8sAgent
+	integrations     map[int]*client.Integration
+	policies         map[string]*client.PlatformPolicy
+	molds            map[string]*client.ForgeMold
+	approvalPolicies map[string]*client.ForgeApprovalPolicy
+	runs             map[string]*client.ForgeRun
+	marketplace      map[string]*client.MarketplaceItem
+	installations    map[string]*client.MarketplaceInstallation
+	governance       map[string]*client.GovernanceAction
+	gitops           map[string]*client.GitOpsResource
+	users            map[string]*client.DirectoryUser
+	groups           map[string]*client.Group
+	groupRoles       map[string][]client.GroupRoleInfo
+	userRoles        map[string][]string
+	bundles          map[string]*client.Bundle
+	groupMembers     map[string][]string
+	groupSyncs       map[string]*client.TeamGroupSync
+	policyDefaults   map[string]client.PlatformPolicy
+	announcements    map[string]*client.Announcement
+}
+
+// DefaultAPIKey is the bearer token accepted by servers created with NewServer.
+const DefaultAPIKey = "fake-api-key"
+
+// NewServer starts a fake Shoehorn API. Callers must Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		APIKey:           DefaultAPIKey,
+		now:              func() time.Time { return time.Now().UTC() },
+		teams:            map[string]*client.Team{},
+		entities:         map[string]*client.Entity{},
+		entityIDs:        map[string]int{},
+		features:         map[string]*client.FeatureFlag{},
+		apiKeys:          map[string]*client.APIKey{},
+		agents:           map[string]*client.K8sAgent{},
+		integrations:     map[int]*client.Integration{},
+		policies:         map[string]*client.PlatformPolicy{},
+		molds:            map[string]*client.ForgeMold{},
+		approvalPolicies: map[string]*client.ForgeApprovalPolicy{},
+		runs:             map[string]*client.ForgeRun{},
+		marketplace:      map[string]*client.MarketplaceItem{},
+		installations:    map[string]*client.MarketplaceInstallation{},
+		governance:       map[string]*client.GovernanceAction{},
+		gitops:           map[string]*client.GitOpsResource{},
+		users:            map[string]*client.DirectoryUser{},
+		groups:           map[string]*client.Group{},
+		groupRoles:       map[string][]client.GroupRoleInfo{},
+		userRoles:        map[string][]string{},
+		bundles:          map[string]*client.Bundle{},
+		groupMembers:     map[string][]string{},
+		groupSyncs:       map[string]*client.TeamGroupSync{},
+		policyDefaults:   map[string]client.PlatformPolicy{},
+		announcements:    map[string]*client.Announcement{},
+	}
+	s.settings = client.TenantSettings{ID: "settings-1", TenantID: "tenant-1"}
+
+	mux := http.NewServeMux()
+	s.registerTeamRoutes(mux)
+	s.registerEntityRoutes(mux)
+	s.registerAdminRoutes(mux)
+	s.registerK8sAgentRoutes(mux)
+	s.registerIntegrationRoutes(mux)
+	s.registerForgeRoutes(mux)
+	s.registerMarketplaceRoutes(mux)
+	s.registerGovernanceRoutes(mux)
+	s.registerGitOpsRoutes(mux)
+	s.registerDirectoryRoutes(mux)
+
+	s.server = httptest.NewServer(s.authenticate(mux))
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns an API client pointed at the server.
+func (s *Server) Client() *client.Client {
+	return client.NewClient(s.URL, s.APIKey, 10*time.Second)
+}
+
+// authenticate rejects requests that do not carry the server's API key.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+s.APIKey {
+			writeError(w, http.StatusUnauthorized, "unauthorized", "missing or invalid API key")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handle registers a handler that runs with the store lock held.
+func (s *Server) handle(mux *http.ServeMux, pattern string, h http.HandlerFunc) {
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		h(w, r)
+	})
+}
+
+// nextID returns a new identifier with the given prefix. Callers must hold s.mu.
+func (s *Server) nextID(prefix string) string {
+	s.sequence++
+	return fmt.Sprintf("%s-%d", prefix, s.sequence)
+}
+
+// timestamp returns the current time formatted the way the API does.
+func (s *Server) timestamp() string {
+	return s.now().Format(time.RFC3339)
+}
+
+// writeJSON writes v as a JSON response with the given status code.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an API error body in the {code, message} format the client parses.
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]string{"code": code, "message": message})
+}
+
+// notFound writes a 404 for the named object.
+func notFound(w http.ResponseWriter, kind, id string) {
+	writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("%s %q not found", kind, id))
+}
+
+// conflict writes a 409 for an object that already exists.
+func conflict(w http.ResponseWriter, kind, id string) {
+	writeError(w, http.StatusConflict, "already_exists", fmt.Sprintf("%s %q already exists", kind, id))
+}
+
+// preconditionMet checks a request's If-Match header against the updated_at of
+// the object it writes, writing a 412 and returning false if they differ.
+// Requests without If-Match are unconditional.
+func preconditionMet(w http.ResponseWriter, r *http.Request, kind, id, updatedAt string) bool {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" || ifMatch == "*" || strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`) == updatedAt {
+		return true
+	}
+	writeError(w, http.StatusPreconditionFailed, "precondition_failed",
+		fmt.Sprintf("%s %q was updated at %s, not %s", kind, id, updatedAt, ifMatch))
+	return false
+}
+
+// decode reads a JSON request body into v, writing a 400 and returning false on failure.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if r.Body == nil || r.ContentLength == 0 {
+		return true
+	}
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_body", err.Error())
+		return false
+	}
+	return true
+}
+
+// required writes a 400 naming the first empty field, returning false if any is empty.
+func required(w http.ResponseWriter, fields map[string]string) bool {
+	for name, value := range fields {
+		if strings.TrimSpace(value) == "" {
+			writeError(w, http.StatusBadRequest, "validation_error", fmt.Sprintf("%s is required", name))
+			return false
+		}
+	}
+	return true
+}
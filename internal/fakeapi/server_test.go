@@ -0,0 +1,757 @@
+package fakeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+// newTestAPI starts a fake API and returns it with a client pointed at it.
+func newTestAPI(t *testing.T) (*Server, *client.Client) {
+	t.Helper()
+	api := NewServer()
+	t.Cleanup(api.Close)
+	return api, api.Client()
+}
+
+func testCtx() context.Context {
+	return context.Background()
+}
+
+func TestServer_RejectsMissingAPIKey(t *testing.T) {
+	api, _ := newTestAPI(t)
+
+	c := client.NewClient(api.URL, "wrong-key", 5*time.Second)
+	_, err := c.ListTeams(testCtx())
+	if err == nil {
+		t.Fatal("expected error for invalid API key, got nil")
+	}
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("error = %v, want 401 API error", err)
+	}
+
+	resp, err := http.Get(api.URL + "/api/v1/admin/teams")
+	if err != nil {
+		t.Fatalf("GET without auth: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+}
+
+func TestTeams_Lifecycle(t *testing.T) {
+	_, c := newTestAPI(t)
+
+	team, err := c.CreateTeam(testCtx(), client.CreateTeamRequest{Name: "Platform", Slug: "platform"})
+	if err != nil {
+		t.Fatalf("CreateTeam() error = %v", err)
+	}
+	if team.ID == "" {
+		t.Fatal("CreateTeam() returned empty ID")
+	}
+
+	if _, err := c.CreateTeam(testCtx(), client.CreateTeamRequest{Name: "Platform", Slug: "platform"}); !client.IsAlreadyExists(err) {
+		t.Errorf("duplicate CreateTeam() error = %v, want already exists", err)
+	}
+
+	updated, err := c.UpdateTeam(testCtx(), team.ID, client.UpdateTeamRequest{
+		Description: "Platform engineering",
+		AddMembers:  []client.AddMemberRequest{{UserID: "u1", Role: "lead"}, {UserID: "u2"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateTeam() error = %v", err)
+	}
+	if updated.Description != "Platform engineering" || len(updated.Members) != 2 {
+		t.Errorf("UpdateTeam() = %+v, want description and 2 members", updated)
+	}
+
+	updated, err = c.UpdateTeam(testCtx(), team.ID, client.UpdateTeamRequest{RemoveMembers: []string{"u2"}})
+	if err != nil {
+		t.Fatalf("UpdateTeam() remove error = %v", err)
+	}
+	if len(updated.Members) != 1 || updated.Members[0].UserID != "u1" {
+		t.Errorf("members after removal = %+v, want only u1", updated.Members)
+	}
+
+	got, err := c.GetTeam(testCtx(), "platform")
+	if err != nil {
+		t.Fatalf("GetTeam() by slug error = %v", err)
+	}
+	if got.ID != team.ID {
+		t.Errorf("GetTeam() ID = %q, want %q", got.ID, team.ID)
+	}
+
+	if err := c.DeleteTeam(testCtx(), team.ID); err != nil {
+		t.Fatalf("DeleteTeam() error = %v", err)
+	}
+	if _, err := c.GetTeam(testCtx(), team.ID); !client.IsNotFound(err) {
+		t.Errorf("GetTeam() after delete error = %v, want not found", err)
+	}
+}
+
+func TestEntities_ManifestRoundTrip(t *testing.T) {
+	_, c := newTestAPI(t)
+
+	manifest := `schemaVersion: 1
+
+service:
+  id: "payments"
+  name: "payments"
+  type: "service"
+  tier: "1"
+
+description: "Payment processing"
+
+owner:
+  - type: team
+    id: "platform"
+
+tags:
+  - "go"
+
+relations:
+  - type: "depends_on"
+    target: "service:ledger"
+
+integrations:
+  changelog:
+    path: "CHANGELOG.md"
+`
+	created, err := c.CreateEntity(testCtx(), client.CreateEntityRequest{Content: manifest, Source: "terraform"})
+	if err != nil {
+		t.Fatalf("CreateEntity() error = %v", err)
+	}
+	if !created.Success || created.Entity.ServiceID != "payments" {
+		t.Errorf("CreateEntity() = %+v, want success for payments", created)
+	}
+
+	entity, err := c.GetEntity(testCtx(), "payments")
+	if err != nil {
+		t.Fatalf("GetEntity() error = %v", err)
+	}
+	if entity.Service.Tier != "1" || entity.Description != "Payment processing" {
+		t.Errorf("GetEntity() service = %+v, description = %q", entity.Service, entity.Description)
+	}
+	if len(entity.Owner) != 1 || entity.Owner[0].ID != "platform" {
+		t.Errorf("Owner = %+v, want platform", entity.Owner)
+	}
+	if len(entity.Relations) != 1 || entity.Relations[0].TargetType != "service" || entity.Relations[0].TargetID != "ledger" {
+		t.Errorf("Relations = %+v, want service:ledger", entity.Relations)
+	}
+	if entity.Integrations == nil || entity.Integrations.Changelog == nil || entity.Integrations.Changelog.Path != "CHANGELOG.md" {
+		t.Errorf("Integrations = %+v, want changelog path", entity.Integrations)
+	}
+
+	list, err := c.ListEntities(testCtx())
+	if err != nil {
+		t.Fatalf("ListEntities() error = %v", err)
+	}
+	if len(list) != 1 {
+		t.Errorf("ListEntities() count = %d, want 1", len(list))
+	}
+
+	if err := c.DeleteEntity(testCtx(), "payments"); err != nil {
+		t.Fatalf("DeleteEntity() error = %v", err)
+	}
+	if _, err := c.GetEntity(testCtx(), "payments"); !client.IsNotFound(err) {
+		t.Errorf("GetEntity() after delete error = %v, want not found", err)
+	}
+}
+
+func TestEntities_ListPaginates(t *testing.T) {
+	_, c := newTestAPI(t)
+
+	for i := 0; i < 150; i++ {
+		id := fmt.Sprintf("svc-%03d", i)
+		_, err := c.CreateEntity(testCtx(), client.CreateEntityRequest{
+			Content: "service:\n  id: " + id + "\n  name: " + id + "\n  type: service\n",
+		})
+		if err != nil {
+			t.Fatalf("CreateEntity(%s) error = %v", id, err)
+		}
+	}
+
+	list, err := c.ListEntities(testCtx())
+	if err != nil {
+		t.Fatalf("ListEntities() error = %v", err)
+	}
+	if len(list) != 150 {
+		t.Errorf("ListEntities() count = %d, want 150 across pages", len(list))
+	}
+}
+
+func TestFeatureFlags_Lifecycle(t *testing.T) {
+	_, c := newTestAPI(t)
+
+	if _, err := c.CreateFeatureFlag(testCtx(), client.CreateFeatureFlagRequest{Key: "beta", Name: "Beta"}); err != nil {
+		t.Fatalf("CreateFeatureFlag() error = %v", err)
+	}
+	enabled := true
+	flag, err := c.UpdateFeatureFlag(testCtx(), "beta", client.UpdateFeatureFlagRequest{DefaultEnabled: &enabled})
+	if err != nil {
+		t.Fatalf("UpdateFeatureFlag() error = %v", err)
+	}
+	if !flag.DefaultEnabled {
+		t.Error("DefaultEnabled = false after update, want true")
+	}
+
+	got, err := c.GetFeatureFlag(testCtx(), "beta")
+	if err != nil || !got.DefaultEnabled {
+		t.Fatalf("GetFeatureFlag() = %+v, %v", got, err)
+	}
+
+	if err := c.DeleteFeatureFlag(testCtx(), "beta"); err != nil {
+		t.Fatalf("DeleteFeatureFlag() error = %v", err)
+	}
+	if _, err := c.GetFeatureFlag(testCtx(), "beta"); !client.IsNotFound(err) {
+		t.Errorf("GetFeatureFlag() after delete error = %v, want not found", err)
+	}
+}
+
+func TestSettings_UpdateKeepsOmittedSections(t *testing.T) {
+	_, c := newTestAPI(t)
+
+	_, err := c.UpdateSettings(testCtx(), client.UpdateSettingsRequest{
+		Appearance: client.AppearanceSettings{PlatformName: "Acme"},
+		Forge:      &client.ForgeSettings{DefaultOrg: "acme"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateSettings() error = %v", err)
+	}
+
+	settings, err := c.UpdateSettings(testCtx(), client.UpdateSettingsRequest{
+		Appearance: client.AppearanceSettings{PlatformName: "Acme Portal"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateSettings() error = %v", err)
+	}
+	if settings.Appearance.PlatformName != "Acme Portal" {
+		t.Errorf("PlatformName = %q, want %q", settings.Appearance.PlatformName, "Acme Portal")
+	}
+	if settings.Forge.DefaultOrg != "acme" {
+		t.Errorf("Forge.DefaultOrg = %q, want it preserved", settings.Forge.DefaultOrg)
+	}
+}
+
+func TestSettings_PatchChecksUpdatedAt(t *testing.T) {
+	api, c := newTestAPI(t)
+	api.SetSettings(client.TenantSettings{
+		Appearance: client.AppearanceSettings{PlatformName: "Acme"},
+		UpdatedAt:  "2025-01-15T12:00:00Z",
+	})
+
+	_, err := c.PatchSettings(testCtx(), client.PatchSettingsRequest{
+		Forge:     &client.ForgeSettings{DefaultOrg: "acme"},
+		UpdatedAt: "2025-01-15T11:00:00Z",
+	})
+	if !client.IsPreconditionFailed(err) {
+		t.Fatalf("PatchSettings() with a stale updated_at error = %v, want precondition failed", err)
+	}
+
+	settings, err := c.PatchSettings(testCtx(), client.PatchSettingsRequest{
+		Forge:     &client.ForgeSettings{DefaultOrg: "acme"},
+		UpdatedAt: "2025-01-15T12:00:00Z",
+	})
+	if err != nil {
+		t.Fatalf("PatchSettings() error = %v", err)
+	}
+	if settings.Forge.DefaultOrg != "acme" || settings.Appearance.PlatformName != "Acme" {
+		t.Errorf("settings = %+v, want forge patched and appearance kept", settings)
+	}
+}
+
+func TestTeams_UpdateChecksIfMatch(t *testing.T) {
+	_, c := newTestAPI(t)
+
+	team, err := c.CreateTeam(testCtx(), client.CreateTeamRequest{Name: "Platform", Slug: "platform"})
+	if err != nil {
+		t.Fatalf("CreateTeam() error = %v", err)
+	}
+
+	_, err = c.UpdateTeam(client.WithIfMatch(testCtx(), "2020-01-01T00:00:00Z"), team.ID, client.UpdateTeamRequest{Description: "stale"})
+	if !client.IsPreconditionFailed(err) {
+		t.Fatalf("UpdateTeam() with a stale version error = %v, want precondition failed", err)
+	}
+
+	updated, err := c.UpdateTeam(client.WithIfMatch(testCtx(), team.UpdatedAt), team.ID, client.UpdateTeamRequest{Description: "current"})
+	if err != nil {
+		t.Fatalf("UpdateTeam() with the current version error = %v", err)
+	}
+	if updated.Description != "current" {
+		t.Errorf("Description = %q, want %q", updated.Description, "current")
+	}
+}
+
+func TestAnnouncements_RejectsInvertedWindow(t *testing.T) {
+	_, c := newTestAPI(t)
+
+	_, err := c.CreateAnnouncement(testCtx(), client.AnnouncementRequest{
+		Message:  "Maintenance",
+		StartsAt: "2026-03-01T13:00:00Z",
+		EndsAt:   "2026-03-01T11:00:00Z",
+	})
+	if err == nil {
+		t.Fatal("CreateAnnouncement() succeeded, want an error for an inverted window")
+	}
+
+	created, err := c.CreateAnnouncement(testCtx(), client.AnnouncementRequest{Message: "Welcome"})
+	if err != nil {
+		t.Fatalf("CreateAnnouncement() error = %v", err)
+	}
+	if created.Type != "info" {
+		t.Errorf("Type = %q, want the info default", created.Type)
+	}
+}
+
+func TestAPIKeys_RevokeMarksKey(t *testing.T) {
+	_, c := newTestAPI(t)
+
+	days := 30
+	created, err := c.CreateAPIKey(testCtx(), client.CreateAPIKeyRequest{Name: "ci", Scopes: []string{"read"}, ExpiresInDays: &days})
+	if err != nil {
+		t.Fatalf("CreateAPIKey() error = %v", err)
+	}
+	if created.RawKey == "" || created.Key.ExpiresAt == "" {
+		t.Errorf("CreateAPIKey() = %+v, want raw key and expiry", created)
+	}
+
+	if err := c.RevokeAPIKey(testCtx(), created.Key.ID); err != nil {
+		t.Fatalf("RevokeAPIKey() error = %v", err)
+	}
+	key, err := c.GetAPIKey(testCtx(), created.Key.ID)
+	if err != nil {
+		t.Fatalf("GetAPIKey() error = %v", err)
+	}
+	if key.RevokedAt == "" {
+		t.Error("RevokedAt is empty after revoke")
+	}
+}
+
+func TestK8sAgents_Lifecycle(t *testing.T) {
+	_, c := newTestAPI(t)
+
+	reg, err := c.RegisterK8sAgent(testCtx(), client.RegisterK8sAgentRequest{ClusterID: "prod", Name: "Prod"})
+	if err != nil {
+		t.Fatalf("RegisterK8sAgent() error = %v", err)
+	}
+	if reg.Token == "" {
+		t.Error("Token is empty")
+	}
+	if err := c.RevokeK8sAgent(testCtx(), "prod"); err != nil {
+		t.Fatalf("RevokeK8sAgent() error = %v", err)
+	}
+	agent, err := c.GetK8sAgent(testCtx(), "prod")
+	if err != nil {
+		t.Fatalf("GetK8sAgent() error = %v", err)
+	}
+	if agent.Status != "revoked" {
+		t.Errorf("Status = %q, want revoked", agent.Status)
+	}
+	if err := c.DeleteK8sAgent(testCtx(), "prod"); err != nil {
+		t.Fatalf("DeleteK8sAgent() error = %v", err)
+	}
+	if _, err := c.GetK8sAgent(testCtx(), "prod"); !client.IsNotFound(err) {
+		t.Errorf("GetK8sAgent() after delete error = %v, want not found", err)
+	}
+}
+
+func TestIntegrations_Lifecycle(t *testing.T) {
+	_, c := newTestAPI(t)
+
+	integration, err := c.CreateIntegration(testCtx(), client.CreateIntegrationRequest{
+		Name: "GitHub", Type: "github", Config: map[string]interface{}{"org": "acme"},
+	})
+	if err != nil {
+		t.Fatalf("CreateIntegration() error = %v", err)
+	}
+	if _, err := c.UpdateIntegration(testCtx(), integration.ID, client.UpdateIntegrationRequest{Status: "inactive"}); err != nil {
+		t.Fatalf("UpdateIntegration() error = %v", err)
+	}
+	got, err := c.GetIntegration(testCtx(), integration.ID)
+	if err != nil {
+		t.Fatalf("GetIntegration() error = %v", err)
+	}
+	if got.Status != "inactive" || got.Config["org"] != "acme" {
+		t.Errorf("GetIntegration() = %+v", got)
+	}
+
+	statuses, total, healthy, err := c.GetIntegrationsStatus(testCtx())
+	if err != nil {
+		t.Fatalf("GetIntegrationsStatus() error = %v", err)
+	}
+	if len(statuses) != 1 || total != 1 || healthy != 0 {
+		t.Errorf("GetIntegrationsStatus() = %d statuses, total %d, healthy %d", len(statuses), total, healthy)
+	}
+
+	if err := c.DeleteIntegration(testCtx(), integration.ID); err != nil {
+		t.Fatalf("DeleteIntegration() error = %v", err)
+	}
+	if _, err := c.GetIntegration(testCtx(), integration.ID); !client.IsNotFound(err) {
+		t.Errorf("GetIntegration() after delete error = %v, want not found", err)
+	}
+}
+
+func TestPolicies_SeededAndToggled(t *testing.T) {
+	api, c := newTestAPI(t)
+	api.AddPolicy(client.PlatformPolicy{ID: "p1", Key: "require-owner", Name: "Require owner", Enforcement: "warn"})
+
+	enabled := true
+	if _, err := c.UpdatePolicy(testCtx(), "p1", client.UpdatePolicyRequest{Enabled: &enabled, Enforcement: "block"}); err != nil {
+		t.Fatalf("UpdatePolicy() error = %v", err)
+	}
+	policy, err := c.GetPolicy(testCtx(), "require-owner")
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	if !policy.Enabled || policy.Enforcement != "block" {
+		t.Errorf("GetPolicy() = %+v, want enabled and blocking", policy)
+	}
+}
+
+func TestForge_RunWaitsForApproval(t *testing.T) {
+	api, c := newTestAPI(t)
+
+	if _, err := c.CreateForgeMold(testCtx(), client.CreateForgeMoldRequest{
+		Slug: "service", Name: "Service", Version: "1.0.0", Visibility: "public", Category: "backend",
+	}); err != nil {
+		t.Fatalf("CreateForgeMold() error = %v", err)
+	}
+	mold, err := c.PublishForgeMold(testCtx(), "service", "1.0.0")
+	if err != nil {
+		t.Fatalf("PublishForgeMold() error = %v", err)
+	}
+	if !mold.Published {
+		t.Error("Published = false after publish")
+	}
+
+	run, err := c.CreateForgeRun(testCtx(), client.CreateForgeRunRequest{MoldSlug: "service"})
+	if err != nil {
+		t.Fatalf("CreateForgeRun() error = %v", err)
+	}
+	if run.Status != client.ForgeRunStatusSucceeded {
+		t.Errorf("run without policy Status = %q, want succeeded", run.Status)
+	}
+
+	policy, err := c.CreateApprovalPolicy(testCtx(), client.CreateApprovalPolicyRequest{
+		Name:          "backend",
+		Enabled:       true,
+		ApprovalChain: []client.ApprovalStep{{Name: "lead", Approvers: []string{"team:platform"}}},
+		Match:         &client.ApprovalPolicyMatch{Categories: []string{"backend"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateApprovalPolicy() error = %v", err)
+	}
+
+	run, err = c.CreateForgeRun(testCtx(), client.CreateForgeRunRequest{MoldSlug: "service"})
+	if err != nil {
+		t.Fatalf("CreateForgeRun() error = %v", err)
+	}
+	if run.Status != client.ForgeRunStatusPendingApproval || run.ApprovalPolicyID != policy.ID {
+		t.Errorf("run = %+v, want pending approval under %s", run, policy.ID)
+	}
+
+	if !api.SetForgeRunStatus(run.ID, client.ForgeRunStatusSucceeded, map[string]interface{}{"repo": "acme/service"}) {
+		t.Fatal("SetForgeRunStatus() = false")
+	}
+	run, err = c.GetForgeRun(testCtx(), run.ID)
+	if err != nil {
+		t.Fatalf("GetForgeRun() error = %v", err)
+	}
+	if !run.IsTerminal() || run.Outputs["repo"] != "acme/service" {
+		t.Errorf("run after approval = %+v", run)
+	}
+
+	if err := c.DeleteForgeMold(testCtx(), "service", "1.0.0"); err != nil {
+		t.Fatalf("DeleteForgeMold() error = %v", err)
+	}
+	if err := c.DeleteApprovalPolicy(testCtx(), policy.ID); err != nil {
+		t.Fatalf("DeleteApprovalPolicy() error = %v", err)
+	}
+}
+
+func TestMarketplace_InstallUpgradeUninstall(t *testing.T) {
+	api, c := newTestAPI(t)
+	api.AddMarketplaceItem(client.MarketplaceItem{
+		Slug: "pagerduty", Kind: "integration", Name: "PagerDuty", Version: "1.1.0",
+		Versions: []client.MarketplaceItemVersion{{Version: "1.0.0"}, {Version: "1.1.0"}},
+	})
+
+	if _, err := c.InstallMarketplaceItem(testCtx(), "pagerduty", "2.0.0"); err == nil {
+		t.Error("InstallMarketplaceItem() with unknown version succeeded, want error")
+	}
+	inst, err := c.InstallMarketplaceItem(testCtx(), "pagerduty", "1.0.0")
+	if err != nil {
+		t.Fatalf("InstallMarketplaceItem() error = %v", err)
+	}
+	if inst.Version != "1.0.0" || !inst.SyncSettled() {
+		t.Errorf("installation = %+v, want 1.0.0 synced", inst)
+	}
+
+	if _, err := c.UpgradeMarketplaceItem(testCtx(), "pagerduty", "1.1.0"); err != nil {
+		t.Fatalf("UpgradeMarketplaceItem() error = %v", err)
+	}
+	if err := c.DisableMarketplaceItem(testCtx(), "pagerduty"); err != nil {
+		t.Fatalf("DisableMarketplaceItem() error = %v", err)
+	}
+	if _, err := c.UpdateMarketplaceItemConfig(testCtx(), "pagerduty", map[string]interface{}{"region": "eu"}); err != nil {
+		t.Fatalf("UpdateMarketplaceItemConfig() error = %v", err)
+	}
+
+	inst, err = c.GetMarketplaceInstallation(testCtx(), "pagerduty")
+	if err != nil {
+		t.Fatalf("GetMarketplaceInstallation() error = %v", err)
+	}
+	if inst.Version != "1.1.0" || inst.Enabled || inst.Config["region"] != "eu" {
+		t.Errorf("installation = %+v, want 1.1.0, disabled, region eu", inst)
+	}
+
+	if err := c.UninstallMarketplaceItem(testCtx(), "pagerduty"); err != nil {
+		t.Fatalf("UninstallMarketplaceItem() error = %v", err)
+	}
+	if _, err := c.GetMarketplaceInstallation(testCtx(), "pagerduty"); !client.IsNotFound(err) {
+		t.Errorf("GetMarketplaceInstallation() after uninstall error = %v, want not found", err)
+	}
+}
+
+func TestGovernance_CreateFilterResolve(t *testing.T) {
+	_, c := newTestAPI(t)
+
+	sla := 7
+	action, err := c.CreateGovernanceAction(testCtx(), client.CreateGovernanceActionRequest{
+		EntityID: "payments", Title: "Add owner", Priority: "high", SourceType: "scorecard", SourceID: "owner", SLADays: &sla,
+	})
+	if err != nil {
+		t.Fatalf("CreateGovernanceAction() error = %v", err)
+	}
+	if action.Status != client.GovernanceStatusOpen || action.DueDate == "" {
+		t.Errorf("action = %+v, want open with due date", action)
+	}
+
+	status := client.GovernanceStatusResolved
+	note := "fixed"
+	if err := c.UpdateGovernanceAction(testCtx(), action.ID, client.UpdateGovernanceActionRequest{Status: &status, ResolutionNote: &note}); err == nil {
+		t.Error("resolving an open action succeeded, want invalid transition")
+	}
+	inProgress := client.GovernanceStatusInProgress
+	if err := c.UpdateGovernanceAction(testCtx(), action.ID, client.UpdateGovernanceActionRequest{Status: &inProgress}); err != nil {
+		t.Fatalf("UpdateGovernanceAction(in_progress) error = %v", err)
+	}
+	if err := c.UpdateGovernanceAction(testCtx(), action.ID, client.UpdateGovernanceActionRequest{Status: &status}); err == nil {
+		t.Error("resolving without a note succeeded, want validation error")
+	}
+	if err := c.UpdateGovernanceAction(testCtx(), action.ID, client.UpdateGovernanceActionRequest{Status: &status, ResolutionNote: &note}); err != nil {
+		t.Fatalf("UpdateGovernanceAction(resolved) error = %v", err)
+	}
+
+	open, total, err := c.ListGovernanceActions(testCtx(), &client.GovernanceActionFilters{Status: client.GovernanceStatusOpen})
+	if err != nil {
+		t.Fatalf("ListGovernanceActions() error = %v", err)
+	}
+	if len(open) != 0 || total != 0 {
+		t.Errorf("open actions = %d, want 0", len(open))
+	}
+
+	got, err := c.GetGovernanceAction(testCtx(), action.ID)
+	if err != nil {
+		t.Fatalf("GetGovernanceAction() error = %v", err)
+	}
+	if !got.IsClosed() || got.ResolutionNote != "fixed" {
+		t.Errorf("action = %+v, want resolved with note", got)
+	}
+	if len(got.History) != 3 || got.History[2].Status != client.GovernanceStatusResolved {
+		t.Errorf("History = %+v, want open, in_progress, resolved", got.History)
+	}
+}
+
+func TestGitOps_FiltersAndStats(t *testing.T) {
+	api, c := newTestAPI(t)
+	api.AddGitOpsResource(client.GitOpsResource{ID: "a", ClusterID: "prod", Tool: "argocd", Name: "api", SyncStatus: "Synced", HealthStatus: "Healthy"})
+	api.AddGitOpsResource(client.GitOpsResource{ID: "b", ClusterID: "prod", Tool: "flux", Name: "web", SyncStatus: "OutOfSync", HealthStatus: "Healthy"})
+	api.AddGitOpsResource(client.GitOpsResource{ID: "c", ClusterID: "dev", Tool: "argocd", Name: "api", SyncStatus: "Synced", HealthStatus: "Degraded"})
+
+	resources, total, err := c.ListGitOpsResources(testCtx(), client.ListGitOpsResourcesParams{ClusterID: "prod", Tool: "argocd"})
+	if err != nil {
+		t.Fatalf("ListGitOpsResources() error = %v", err)
+	}
+	if total != 1 || resources[0].ID != "a" {
+		t.Errorf("ListGitOpsResources() = %+v, want only a", resources)
+	}
+
+	res, err := c.GetGitOpsResource(testCtx(), "b")
+	if err != nil || res.Tool != "flux" {
+		t.Fatalf("GetGitOpsResource() = %+v, %v", res, err)
+	}
+
+	stats, err := c.GetGitOpsStats(testCtx())
+	if err != nil {
+		t.Fatalf("GetGitOpsStats() error = %v", err)
+	}
+	if stats.Total != 3 || stats.Synced != 1 || stats.OutOfSync != 1 || stats.Failed != 1 {
+		t.Errorf("GetGitOpsStats() = %+v", stats)
+	}
+}
+
+func TestGitOps_PagingAndEntityFilters(t *testing.T) {
+	api, c := newTestAPI(t)
+	for i := 0; i < 250; i++ {
+		api.AddGitOpsResource(client.GitOpsResource{
+			ID: fmt.Sprintf("res-%03d", i), ClusterID: "prod", Tool: "flux", Namespace: "flux-system",
+			Name: fmt.Sprintf("app-%03d", i), Kind: "Kustomization", SyncStatus: "Synced", HealthStatus: "Healthy",
+			OwnerTeam: "platform", AutoSync: true,
+		})
+	}
+	api.AddGitOpsResource(client.GitOpsResource{
+		ID: "payments", ClusterID: "dev", Tool: "argocd", Namespace: "argocd", Name: "payments", Kind: "Application",
+		SyncStatus: "OutOfSync", EntityID: "service:payments", OwnerTeam: "payments", Suspended: true,
+	})
+
+	resources, total, err := c.ListGitOpsResources(testCtx(), client.ListGitOpsResourcesParams{})
+	if err != nil {
+		t.Fatalf("ListGitOpsResources() error = %v", err)
+	}
+	if total != 251 || len(resources) != 251 {
+		t.Errorf("ListGitOpsResources() returned %d (total %d), want all 251 across pages", len(resources), total)
+	}
+
+	suspended := true
+	resources, _, err = c.ListGitOpsResources(testCtx(), client.ListGitOpsResourcesParams{
+		EntityID: "service:payments", OwnerTeam: "payments", Namespace: "argocd", Kind: "application", Suspended: &suspended,
+	})
+	if err != nil || len(resources) != 1 || resources[0].ID != "payments" {
+		t.Errorf("ListGitOpsResources() = %+v, %v; want only payments", resources, err)
+	}
+
+	autoSync := false
+	resources, _, err = c.ListGitOpsResources(testCtx(), client.ListGitOpsResourcesParams{OwnerTeam: "platform", AutoSync: &autoSync})
+	if err != nil || len(resources) != 0 {
+		t.Errorf("ListGitOpsResources() = %d resources, %v; want none", len(resources), err)
+	}
+
+	stats, err := c.GetGitOpsClusterStats(testCtx(), "dev")
+	if err != nil {
+		t.Fatalf("GetGitOpsClusterStats() error = %v", err)
+	}
+	if stats.Total != 1 || stats.Suspended != 1 {
+		t.Errorf("GetGitOpsClusterStats() = %+v, want one suspended resource", stats)
+	}
+}
+
+func TestDirectory_GroupAndUserRoles(t *testing.T) {
+	api, c := newTestAPI(t)
+	api.AddUser(client.DirectoryUser{ID: "u1", Username: "ada", Email: "ada@example.com", Enabled: true})
+	api.AddGroup(client.Group{Name: "platform"})
+
+	if err := c.AssignGroupRole(testCtx(), "platform", client.GroupRoleRequest{RoleName: "admin"}); err != nil {
+		t.Fatalf("AssignGroupRole() error = %v", err)
+	}
+	if err := c.AssignGroupRole(testCtx(), "platform", client.GroupRoleRequest{RoleName: "admin"}); !client.IsAlreadyExists(err) {
+		t.Errorf("duplicate AssignGroupRole() error = %v, want already exists", err)
+	}
+	roles, err := c.GetGroupRoles(testCtx(), "platform")
+	if err != nil || len(roles) != 1 {
+		t.Fatalf("GetGroupRoles() = %+v, %v", roles, err)
+	}
+	if err := c.RemoveGroupRole(testCtx(), "platform", "admin"); err != nil {
+		t.Fatalf("RemoveGroupRole() error = %v", err)
+	}
+
+	if err := c.AddUserRole(testCtx(), "u1", client.RoleRequest{Role: "editor"}); err != nil {
+		t.Fatalf("AddUserRole() error = %v", err)
+	}
+	role, err := c.GetUserRole(testCtx(), "u1", "editor")
+	if err != nil {
+		t.Fatalf("GetUserRole() error = %v", err)
+	}
+	if role.Email != "ada@example.com" {
+		t.Errorf("Email = %q, want ada@example.com", role.Email)
+	}
+	if err := c.RemoveUserRole(testCtx(), "u1", client.RoleRequest{Role: "editor"}); err != nil {
+		t.Fatalf("RemoveUserRole() error = %v", err)
+	}
+	if _, err := c.GetUserRole(testCtx(), "u1", "editor"); !client.IsNotFound(err) {
+		t.Errorf("GetUserRole() after removal error = %v, want not found", err)
+	}
+
+	user, err := c.GetDirectoryUser(testCtx(), "u1")
+	if err != nil || user.Username != "ada" {
+		t.Fatalf("GetDirectoryUser() = %+v, %v", user, err)
+	}
+}
+
+func TestTeams_GroupSync(t *testing.T) {
+	api, c := newTestAPI(t)
+	api.AddUser(client.DirectoryUser{ID: "u1", Email: "ada@example.com"})
+	api.AddUser(client.DirectoryUser{ID: "u2", Email: "grace@example.com"})
+	api.AddGroup(client.Group{Name: "eng", Path: "/eng", SubGroups: []client.Group{
+		{Name: "payments", Path: "/eng/payments", SubGroups: []client.Group{{Name: "leads", Path: "/eng/payments/leads"}}},
+	}})
+	api.AddGroupMember("/eng/payments", "u1")
+	api.AddGroupMember("/eng/payments", "u2")
+	api.AddGroupMember("/eng/payments/leads", "u2")
+
+	team, err := c.CreateTeam(testCtx(), client.CreateTeamRequest{Name: "Payments", Slug: "payments"})
+	if err != nil {
+		t.Fatalf("CreateTeam() error = %v", err)
+	}
+	if _, err := c.UpdateTeam(testCtx(), team.ID, client.UpdateTeamRequest{AddMembers: []client.AddMemberRequest{{UserID: "u9", Role: "admin"}}}); err != nil {
+		t.Fatalf("UpdateTeam() error = %v", err)
+	}
+
+	req := client.TeamGroupSyncRequest{
+		GroupPaths:       []string{"/eng/payments"},
+		IncludeSubgroups: true,
+		RoleMappings:     map[string]string{"/eng/payments/leads": "manager"},
+	}
+	preview, err := c.PreviewTeamGroupSync(testCtx(), req)
+	if err != nil {
+		t.Fatalf("PreviewTeamGroupSync() error = %v", err)
+	}
+	if len(preview) != 2 || preview[0].Role != "member" || preview[1].Role != "manager" || len(preview[1].Groups) != 2 {
+		t.Errorf("preview = %+v, want u1 as member and u2 as manager in both groups", preview)
+	}
+
+	sync, err := c.SetTeamGroupSync(testCtx(), "payments", req)
+	if err != nil {
+		t.Fatalf("SetTeamGroupSync() error = %v", err)
+	}
+	if sync.TeamID != team.ID || sync.MemberCount != 2 || sync.DefaultRole != "member" {
+		t.Errorf("SetTeamGroupSync() = %+v", sync)
+	}
+	got, _ := c.GetTeam(testCtx(), team.ID)
+	if len(got.Members) != 3 {
+		t.Errorf("members = %+v, want the manual member and 2 synced members", got.Members)
+	}
+
+	req.IncludeSubgroups = false
+	if _, err := c.SetTeamGroupSync(testCtx(), team.ID, req); err != nil {
+		t.Fatalf("SetTeamGroupSync() without subgroups error = %v", err)
+	}
+	got, _ = c.GetTeam(testCtx(), team.ID)
+	for _, m := range got.Members {
+		if m.Role == "manager" {
+			t.Errorf("member %s kept the subgroup role mapping after subgroups were excluded", m.UserID)
+		}
+	}
+
+	if _, err := c.SetTeamGroupSync(testCtx(), team.ID, client.TeamGroupSyncRequest{GroupPaths: []string{"/missing"}}); err == nil {
+		t.Error("SetTeamGroupSync() with an unknown group should fail")
+	}
+
+	if err := c.DeleteTeamGroupSync(testCtx(), team.ID); err != nil {
+		t.Fatalf("DeleteTeamGroupSync() error = %v", err)
+	}
+	got, _ = c.GetTeam(testCtx(), team.ID)
+	if len(got.Members) != 1 || got.Members[0].UserID != "u9" {
+		t.Errorf("members after delete = %+v, want only the manual member", got.Members)
+	}
+	if _, err := c.GetTeamGroupSync(testCtx(), team.ID); !client.IsNotFound(err) {
+		t.Errorf("GetTeamGroupSync() after delete error = %v, want not found", err)
+	}
+}
@@ -0,0 +1,332 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func (s *Server) registerTeamRoutes(mux *http.ServeMux) {
+	s.handle(mux, "GET /api/v1/admin/teams", s.listTeams)
+	s.handle(mux, "POST /api/v1/admin/teams", s.createTeam)
+	s.handle(mux, "GET /api/v1/admin/teams/{id}", s.getTeam)
+	s.handle(mux, "PUT /api/v1/admin/teams/{id}", s.updateTeam)
+	s.handle(mux, "DELETE /api/v1/admin/teams/{id}", s.deleteTeam)
+
+	s.handle(mux, "GET /api/v1/admin/teams/{id}/group-sync", s.getTeamGroupSync)
+	s.handle(mux, "PUT /api/v1/admin/teams/{id}/group-sync", s.setTeamGroupSync)
+	s.handle(mux, "DELETE /api/v1/admin/teams/{id}/group-sync", s.deleteTeamGroupSync)
+	s.handle(mux, "POST /api/v1/admin/teams/group-sync/preview", s.previewTeamGroupSync)
+}
+
+// findTeam looks a team up by ID or slug. Callers must hold s.mu.
+func (s *Server) findTeam(idOrSlug string) *client.Team {
+	if t, ok := s.teams[idOrSlug]; ok {
+		return t
+	}
+	for _, t := range s.teams {
+		if t.Slug == idOrSlug {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *Server) listTeams(w http.ResponseWriter, _ *http.Request) {
+	teams := make([]client.Team, 0, len(s.teams))
+	for _, t := range s.teams {
+		teams = append(teams, *t)
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Slug < teams[j].Slug })
+	writeJSON(w, http.StatusOK, map[string]interface{}{"teams": teams, "total": len(teams)})
+}
+
+func (s *Server) getTeam(w http.ResponseWriter, r *http.Request) {
+	t := s.findTeam(r.PathValue("id"))
+	if t == nil {
+		notFound(w, "team", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"team": t, "members": t.Members})
+}
+
+func (s *Server) createTeam(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateTeamRequest
+	if !decode(w, r, &req) || !required(w, map[string]string{"name": req.Name, "slug": req.Slug}) {
+		return
+	}
+	if s.findTeam(req.Slug) != nil {
+		conflict(w, "team", req.Slug)
+		return
+	}
+
+	now := s.timestamp()
+	t := &client.Team{
+		ID:          s.nextID("team"),
+		TenantID:    s.settings.TenantID,
+		Name:        req.Name,
+		DisplayName: req.DisplayName,
+		Slug:        req.Slug,
+		Source:      "manual",
+		Description: req.Description,
+		Metadata:    req.Metadata,
+		IsActive:    true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	s.teams[t.ID] = t
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"team": t})
+}
+
+func (s *Server) updateTeam(w http.ResponseWriter, r *http.Request) {
+	t := s.findTeam(r.PathValue("id"))
+	if t == nil {
+		notFound(w, "team", r.PathValue("id"))
+		return
+	}
+	if !preconditionMet(w, r, "team", t.ID, t.UpdatedAt) {
+		return
+	}
+
+	var req client.UpdateTeamRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Name != "" {
+		t.Name = req.Name
+	}
+	if req.DisplayName != "" {
+		t.DisplayName = req.DisplayName
+	}
+	if req.Description != "" {
+		t.Description = req.Description
+	}
+	if req.Metadata != nil {
+		t.Metadata = req.Metadata
+	}
+	if req.ParentTeamID != nil {
+		if *req.ParentTeamID == "" {
+			t.ParentTeamID = nil
+		} else {
+			parent := *req.ParentTeamID
+			t.ParentTeamID = &parent
+		}
+	}
+
+	for _, userID := range req.RemoveMembers {
+		members := t.Members[:0]
+		for _, m := range t.Members {
+			if m.UserID != userID {
+				members = append(members, m)
+			}
+		}
+		t.Members = members
+	}
+	for _, add := range req.AddMembers {
+		replaced := false
+		for i := range t.Members {
+			if t.Members[i].UserID == add.UserID {
+				t.Members[i].Role = add.Role
+				replaced = true
+			}
+		}
+		if !replaced {
+			t.Members = append(t.Members, client.TeamMember{
+				ID:        s.nextID("member"),
+				TeamID:    t.ID,
+				UserID:    add.UserID,
+				Role:      add.Role,
+				CreatedAt: s.timestamp(),
+			})
+		}
+	}
+	t.MemberCount = len(t.Members)
+	t.UpdatedAt = s.timestamp()
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"team": t, "members": t.Members})
+}
+
+func (s *Server) deleteTeam(w http.ResponseWriter, r *http.Request) {
+	t := s.findTeam(r.PathValue("id"))
+	if t == nil {
+		notFound(w, "team", r.PathValue("id"))
+		return
+	}
+	delete(s.teams, t.ID)
+	delete(s.groupSyncs, t.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getTeamGroupSync(w http.ResponseWriter, r *http.Request) {
+	t := s.findTeam(r.PathValue("id"))
+	if t == nil {
+		notFound(w, "team", r.PathValue("id"))
+		return
+	}
+	sync, ok := s.groupSyncs[t.ID]
+	if !ok {
+		notFound(w, "team group sync", t.ID)
+		return
+	}
+	writeJSON(w, http.StatusOK, sync)
+}
+
+func (s *Server) setTeamGroupSync(w http.ResponseWriter, r *http.Request) {
+	t := s.findTeam(r.PathValue("id"))
+	if t == nil {
+		notFound(w, "team", r.PathValue("id"))
+		return
+	}
+
+	var req client.TeamGroupSyncRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	members, ok := s.groupSyncMembers(w, req)
+	if !ok {
+		return
+	}
+
+	// Replace the members the previous rule added, keeping manual members.
+	kept := t.Members[:0]
+	manual := map[string]bool{}
+	for _, m := range t.Members {
+		if m.Source != client.TeamMemberSourceGroupSync {
+			kept = append(kept, m)
+			manual[m.UserID] = true
+		}
+	}
+	t.Members = kept
+	for _, m := range members {
+		if manual[m.UserID] {
+			continue
+		}
+		t.Members = append(t.Members, client.TeamMember{
+			ID:        s.nextID("member"),
+			TeamID:    t.ID,
+			UserID:    m.UserID,
+			Role:      m.Role,
+			Source:    client.TeamMemberSourceGroupSync,
+			CreatedAt: s.timestamp(),
+		})
+	}
+	t.MemberCount = len(t.Members)
+
+	sync := &client.TeamGroupSync{
+		TeamID:           t.ID,
+		GroupPaths:       append([]string{}, req.GroupPaths...),
+		IncludeSubgroups: req.IncludeSubgroups,
+		DefaultRole:      groupSyncDefaultRole(req.DefaultRole),
+		RoleMappings:     req.RoleMappings,
+		MemberCount:      len(members),
+		LastSyncedAt:     s.timestamp(),
+	}
+	sort.Strings(sync.GroupPaths)
+	s.groupSyncs[t.ID] = sync
+	writeJSON(w, http.StatusOK, sync)
+}
+
+func (s *Server) deleteTeamGroupSync(w http.ResponseWriter, r *http.Request) {
+	t := s.findTeam(r.PathValue("id"))
+	if t == nil {
+		notFound(w, "team", r.PathValue("id"))
+		return
+	}
+	if _, ok := s.groupSyncs[t.ID]; !ok {
+		notFound(w, "team group sync", t.ID)
+		return
+	}
+
+	kept := t.Members[:0]
+	for _, m := range t.Members {
+		if m.Source != client.TeamMemberSourceGroupSync {
+			kept = append(kept, m)
+		}
+	}
+	t.Members = kept
+	t.MemberCount = len(t.Members)
+	delete(s.groupSyncs, t.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) previewTeamGroupSync(w http.ResponseWriter, r *http.Request) {
+	var req client.TeamGroupSyncRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	members, ok := s.groupSyncMembers(w, req)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"items": members})
+}
+
+// groupSyncMembers computes the team membership a group sync rule produces.
+// A member's role comes from the mapping of the deepest group they belong to
+// that has one, falling back to the default role. Unknown group paths are
+// rejected with a 400. Callers must hold s.mu.
+func (s *Server) groupSyncMembers(w http.ResponseWriter, req client.TeamGroupSyncRequest) ([]client.TeamGroupSyncMember, bool) {
+	if len(req.GroupPaths) == 0 {
+		writeError(w, http.StatusBadRequest, "validation_error", "groupPaths is required")
+		return nil, false
+	}
+	known := s.groupPaths()
+	exists := make(map[string]bool, len(known))
+	for _, p := range known {
+		exists[p] = true
+	}
+	for _, root := range req.GroupPaths {
+		if !exists[root] {
+			writeError(w, http.StatusBadRequest, "validation_error", fmt.Sprintf("group %q not found", root))
+			return nil, false
+		}
+	}
+
+	byUser := map[string]*client.TeamGroupSyncMember{}
+	mappedDepth := map[string]int{}
+	for _, groupPath := range known {
+		covered := false
+		for _, root := range req.GroupPaths {
+			covered = covered || client.GroupPathWithin(groupPath, root, req.IncludeSubgroups)
+		}
+		if !covered {
+			continue
+		}
+		role, mapped := req.RoleMappings[groupPath]
+		for _, userID := range s.groupMembers[groupPath] {
+			m, ok := byUser[userID]
+			if !ok {
+				m = &client.TeamGroupSyncMember{UserID: userID, Role: groupSyncDefaultRole(req.DefaultRole)}
+				if u, found := s.users[userID]; found {
+					m.Email = u.Email
+				}
+				byUser[userID] = m
+			}
+			m.Groups = append(m.Groups, groupPath)
+			if depth := strings.Count(groupPath, "/"); mapped && depth > mappedDepth[userID] {
+				m.Role = role
+				mappedDepth[userID] = depth
+			}
+		}
+	}
+
+	members := make([]client.TeamGroupSyncMember, 0, len(byUser))
+	for _, m := range byUser {
+		members = append(members, *m)
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].UserID < members[j].UserID })
+	return members, true
+}
+
+// groupSyncDefaultRole returns the role given to synced members without a
+// role mapping.
+func groupSyncDefaultRole(role string) string {
+	if role == "" {
+		return "member"
+	}
+	return role
+}
@@ -0,0 +1,189 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/resources"
+)
+
+// entityAttributeTypes are the configurable shoehorn_entity attributes, which
+// the manifest functions exchange as an object.
+var entityAttributeTypes = map[string]attr.Type{
+	"name":             types.StringType,
+	"type":             types.StringType,
+	"description":      types.StringType,
+	"entity_lifecycle": types.StringType,
+	"tier":             types.StringType,
+	"owner":            types.StringType,
+	"tags":             types.SetType{ElemType: types.StringType},
+	"links":            types.StringType,
+	"relations":        types.StringType,
+	"licenses":         types.StringType,
+	"changelog_path":   types.StringType,
+	"interfaces":       types.StringType,
+}
+
+// entityObjectModel maps entityAttributeTypes onto Go values.
+type entityObjectModel struct {
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	Description   types.String `tfsdk:"description"`
+	Lifecycle     types.String `tfsdk:"entity_lifecycle"`
+	Tier          types.String `tfsdk:"tier"`
+	Owner         types.String `tfsdk:"owner"`
+	Tags          types.Set    `tfsdk:"tags"`
+	Links         types.String `tfsdk:"links"`
+	Relations     types.String `tfsdk:"relations"`
+	Licenses      types.String `tfsdk:"licenses"`
+	ChangelogPath types.String `tfsdk:"changelog_path"`
+	Interfaces    types.String `tfsdk:"interfaces"`
+}
+
+// entityObjectFromModel converts the configurable attributes of an entity
+// model to an object value.
+func entityObjectFromModel(ctx context.Context, m *resources.EntityResourceModel) (types.Object, error) {
+	obj, diags := types.ObjectValueFrom(ctx, entityAttributeTypes, entityObjectModel{
+		Name:          m.Name,
+		Type:          m.Type,
+		Description:   m.Description,
+		Lifecycle:     m.Lifecycle,
+		Tier:          m.Tier,
+		Owner:         m.Owner,
+		Tags:          m.Tags,
+		Links:         m.Links,
+		Relations:     m.Relations,
+		Licenses:      m.Licenses,
+		ChangelogPath: m.ChangelogPath,
+		Interfaces:    m.Interfaces,
+	})
+	if diags.HasError() {
+		return types.ObjectNull(entityAttributeTypes), fmt.Errorf("could not build the entity object: %s", diags.Errors()[0].Detail())
+	}
+	return obj, nil
+}
+
+// entityModelFromValue converts an object or map argument to an entity model.
+// Attributes may be omitted, in which case they are null; attributes the
+// entity does not have are rejected.
+func entityModelFromValue(v attr.Value) (*resources.EntityResourceModel, error) {
+	var attrs map[string]attr.Value
+	switch val := v.(type) {
+	case basetypes.ObjectValue:
+		attrs = val.Attributes()
+	case basetypes.MapValue:
+		attrs = val.Elements()
+	default:
+		return nil, fmt.Errorf("expected an object with shoehorn_entity attributes, got %s", v.Type(context.Background()))
+	}
+
+	m := &resources.EntityResourceModel{
+		ID:             types.StringNull(),
+		Name:           types.StringNull(),
+		Type:           types.StringNull(),
+		Description:    types.StringNull(),
+		Lifecycle:      types.StringNull(),
+		Tier:           types.StringNull(),
+		Owner:          types.StringNull(),
+		Tags:           types.SetNull(types.StringType),
+		Links:          types.StringNull(),
+		Relations:      types.StringNull(),
+		Licenses:       types.StringNull(),
+		ChangelogPath:  types.StringNull(),
+		Interfaces:     types.StringNull(),
+		RepositoryPath: types.StringNull(),
+		CreatedAt:      types.StringNull(),
+		UpdatedAt:      types.StringNull(),
+	}
+	stringAttrs := map[string]*types.String{
+		"name":             &m.Name,
+		"type":             &m.Type,
+		"description":      &m.Description,
+		"entity_lifecycle": &m.Lifecycle,
+		"tier":             &m.Tier,
+		"owner":            &m.Owner,
+		"links":            &m.Links,
+		"relations":        &m.Relations,
+		"licenses":         &m.Licenses,
+		"changelog_path":   &m.ChangelogPath,
+		"interfaces":       &m.Interfaces,
+	}
+
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := attrs[name]
+		if name == "tags" {
+			tags, err := stringSet(value)
+			if err != nil {
+				return nil, fmt.Errorf("tags: %w", err)
+			}
+			m.Tags = tags
+			continue
+		}
+		target, ok := stringAttrs[name]
+		if !ok {
+			return nil, fmt.Errorf("unsupported attribute %q; expected one of %s", name, attributeNames())
+		}
+		s, ok := value.(basetypes.StringValue)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a string", name)
+		}
+		*target = s
+	}
+	return m, nil
+}
+
+// stringSet converts a set, list or tuple of strings to a set value.
+func stringSet(v attr.Value) (types.Set, error) {
+	var elems []attr.Value
+	switch val := v.(type) {
+	case basetypes.SetValue:
+		if val.IsNull() {
+			return types.SetNull(types.StringType), nil
+		}
+		elems = val.Elements()
+	case basetypes.ListValue:
+		if val.IsNull() {
+			return types.SetNull(types.StringType), nil
+		}
+		elems = val.Elements()
+	case basetypes.TupleValue:
+		if val.IsNull() {
+			return types.SetNull(types.StringType), nil
+		}
+		elems = val.Elements()
+	default:
+		return types.SetNull(types.StringType), fmt.Errorf("expected a set of strings")
+	}
+
+	for _, e := range elems {
+		if _, ok := e.(basetypes.StringValue); !ok {
+			return types.SetNull(types.StringType), fmt.Errorf("expected a set of strings")
+		}
+	}
+	set, diags := types.SetValue(types.StringType, elems)
+	if diags.HasError() {
+		return types.SetNull(types.StringType), fmt.Errorf("%s", diags.Errors()[0].Detail())
+	}
+	return set, nil
+}
+
+// attributeNames lists entityAttributeTypes for error messages.
+func attributeNames() string {
+	names := make([]string, 0, len(entityAttributeTypes))
+	for name := range entityAttributeTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
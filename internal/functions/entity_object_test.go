@@ -0,0 +1,73 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction calls f with args and returns its result and error.
+func runFunction(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+
+	def := &function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, def)
+	resp := &function.RunResponse{Result: function.NewResultData(def.Definition.Return.GetType().ValueType(ctx))}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestEntityModelFromValue_PartialObject(t *testing.T) {
+	obj := types.ObjectValueMust(
+		map[string]attr.Type{
+			"name": types.StringType,
+			"type": types.StringType,
+			"tags": types.TupleType{ElemTypes: []attr.Type{types.StringType, types.StringType}},
+		},
+		map[string]attr.Value{
+			"name": types.StringValue("payments-api"),
+			"type": types.StringValue("service"),
+			"tags": types.TupleValueMust(
+				[]attr.Type{types.StringType, types.StringType},
+				[]attr.Value{types.StringValue("payments"), types.StringValue("go")},
+			),
+		},
+	)
+
+	m, err := entityModelFromValue(obj)
+	if err != nil {
+		t.Fatalf("entityModelFromValue() error: %v", err)
+	}
+	if m.Name.ValueString() != "payments-api" || m.Type.ValueString() != "service" {
+		t.Errorf("name/type = %s/%s, want payments-api/service", m.Name, m.Type)
+	}
+	if len(m.Tags.Elements()) != 2 {
+		t.Errorf("tags = %s, want 2 elements", m.Tags)
+	}
+	if !m.Description.IsNull() || !m.Relations.IsNull() {
+		t.Errorf("omitted attributes should be null, got description=%s relations=%s", m.Description, m.Relations)
+	}
+}
+
+func TestEntityModelFromValue_RejectsUnknownAttribute(t *testing.T) {
+	obj := types.ObjectValueMust(
+		map[string]attr.Type{"name": types.StringType, "lifecycle": types.StringType},
+		map[string]attr.Value{"name": types.StringValue("payments-api"), "lifecycle": types.StringValue("production")},
+	)
+
+	_, err := entityModelFromValue(obj)
+	if err == nil || !strings.Contains(err.Error(), `"lifecycle"`) {
+		t.Errorf("entityModelFromValue() error = %v, want unsupported attribute lifecycle", err)
+	}
+}
+
+func TestEntityModelFromValue_RejectsNonObject(t *testing.T) {
+	if _, err := entityModelFromValue(types.StringValue("payments-api")); err == nil {
+		t.Error("entityModelFromValue() should reject a string")
+	}
+}
@@ -0,0 +1,55 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/resources"
+)
+
+var _ function.Function = &EntityRefFunction{}
+
+// EntityRefFunction builds a type:id entity reference.
+type EntityRefFunction struct{}
+
+// NewEntityRefFunction creates a new entity_ref function.
+func NewEntityRefFunction() function.Function {
+	return &EntityRefFunction{}
+}
+
+func (f *EntityRefFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "entity_ref"
+}
+
+func (f *EntityRefFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build an entity reference",
+		Description: "Returns the type:id reference used for shoehorn_entity relation targets, e.g. service:payments-api.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "type",
+				Description: "The entity type, e.g. service or resource.",
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "The entity (service) ID.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *EntityRefFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var kind, id string
+	resp.Error = req.Arguments.Get(ctx, &kind, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	ref, err := resources.FormatEntityRef(kind, id)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, ref)
+}
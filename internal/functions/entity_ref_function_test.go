@@ -0,0 +1,34 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEntityRefFunction_Run(t *testing.T) {
+	got, funcErr := runFunction(t, NewEntityRefFunction(), types.StringValue("service"), types.StringValue("payments-api"))
+	if funcErr != nil {
+		t.Fatalf("Run() error: %s", funcErr)
+	}
+	if !got.Equal(types.StringValue("service:payments-api")) {
+		t.Errorf("Run() = %s, want service:payments-api", got)
+	}
+}
+
+func TestEntityRefFunction_Run_Invalid(t *testing.T) {
+	tests := map[string][2]string{
+		"empty type":       {"", "payments-api"},
+		"empty id":         {"service", ""},
+		"colon in type":    {"service:v2", "payments-api"},
+		"whitespace in id": {"service", "payments api"},
+	}
+	for name, args := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, funcErr := runFunction(t, NewEntityRefFunction(), types.StringValue(args[0]), types.StringValue(args[1]))
+			if funcErr == nil {
+				t.Error("Run() should fail")
+			}
+		})
+	}
+}
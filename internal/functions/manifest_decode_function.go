@@ -0,0 +1,59 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/resources"
+)
+
+var _ function.Function = &ManifestDecodeFunction{}
+
+// ManifestDecodeFunction parses a catalog manifest into shoehorn_entity
+// attributes.
+type ManifestDecodeFunction struct{}
+
+// NewManifestDecodeFunction creates a new manifest_decode function.
+func NewManifestDecodeFunction() function.Function {
+	return &ManifestDecodeFunction{}
+}
+
+func (f *ManifestDecodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "manifest_decode"
+}
+
+func (f *ManifestDecodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Decode a catalog manifest",
+		Description: "Parses a Shoehorn catalog manifest (YAML) into an object with the configurable shoehorn_entity attributes. " +
+			"links, relations, licenses and interfaces are JSON-encoded strings, as in the resource. " +
+			"The result is validated by the same rules as shoehorn_entity configuration.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "manifest",
+				Description: "The manifest YAML, e.g. from file(\"catalog-info.yaml\").",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: entityAttributeTypes},
+	}
+}
+
+func (f *ManifestDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var manifest string
+	resp.Error = req.Arguments.Get(ctx, &manifest)
+	if resp.Error != nil {
+		return
+	}
+
+	model, diags := resources.DecodeEntityManifest(ctx, manifest)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	obj, err := entityObjectFromModel(ctx, model)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, obj)
+}
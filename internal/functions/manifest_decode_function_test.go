@@ -0,0 +1,113 @@
+package functions
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const paymentsManifest = `schemaVersion: 1
+
+service:
+  id: payments-api
+  name: Payments API
+  type: service
+  tier: tier1
+
+description: Handles card payments.
+lifecycle: production
+
+owner:
+  - type: team
+    id: payments
+
+tags:
+  - payments
+  - go
+
+links:
+  - name: Runbook
+    url: https://runbooks.example.com/payments
+
+relations:
+  - type: depends_on
+    target: resource:postgres-primary
+
+integrations:
+  changelog:
+    path: CHANGELOG.md
+  licenses:
+    - title: Stripe
+      seats: 5
+
+interfaces:
+  grpc:
+    package: payments.v1
+`
+
+func TestManifestDecodeFunction_Run(t *testing.T) {
+	got, funcErr := runFunction(t, NewManifestDecodeFunction(), types.StringValue(paymentsManifest))
+	if funcErr != nil {
+		t.Fatalf("Run() error: %s", funcErr)
+	}
+	attrs := got.(types.Object).Attributes()
+
+	wantStrings := map[string]string{
+		"name":             "payments-api",
+		"type":             "service",
+		"tier":             "tier1",
+		"description":      "Handles card payments.",
+		"entity_lifecycle": "production",
+		"owner":            "payments",
+		"changelog_path":   "CHANGELOG.md",
+		"links":            `[{"name":"Runbook","url":"https://runbooks.example.com/payments"}]`,
+		"relations":        `[{"type":"depends_on","target":"resource:postgres-primary"}]`,
+		"licenses":         `[{"title":"Stripe","seats":5}]`,
+		"interfaces":       `{"grpc":{"package":"payments.v1"}}`,
+	}
+	for name, want := range wantStrings {
+		if !attrs[name].Equal(types.StringValue(want)) {
+			t.Errorf("%s = %s, want %q", name, attrs[name], want)
+		}
+	}
+	if n := len(attrs["tags"].(types.Set).Elements()); n != 2 {
+		t.Errorf("tags has %d elements, want 2", n)
+	}
+}
+
+func TestManifestDecodeFunction_Run_OmittedAttributesAreNull(t *testing.T) {
+	got, funcErr := runFunction(t, NewManifestDecodeFunction(), types.StringValue("service:\n  id: docs\n  name: docs\n  type: website\n"))
+	if funcErr != nil {
+		t.Fatalf("Run() error: %s", funcErr)
+	}
+	attrs := got.(types.Object).Attributes()
+	for _, name := range []string{"description", "owner", "tags", "links", "relations", "licenses", "interfaces"} {
+		if !attrs[name].IsNull() {
+			t.Errorf("%s = %s, want null", name, attrs[name])
+		}
+	}
+}
+
+func TestManifestDecodeFunction_Run_Invalid(t *testing.T) {
+	tests := map[string]struct {
+		manifest string
+		want     string
+	}{
+		"not yaml":         {"service: [", "parse"},
+		"missing type":     {"service:\n  id: docs\n", "type"},
+		"bad target":       {"service:\n  id: docs\n  type: website\nrelations:\n  - type: calls\n    target: search\n", "relations"},
+		"license no title": {"service:\n  id: docs\n  type: website\nintegrations:\n  licenses:\n    - vendor: Acme\n", "licenses"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, funcErr := runFunction(t, NewManifestDecodeFunction(), types.StringValue(tt.manifest))
+			if funcErr == nil {
+				t.Fatal("Run() should fail")
+			}
+			if !strings.Contains(funcErr.Text, tt.want) {
+				t.Errorf("Run() error = %q, want it to mention %q", funcErr.Text, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/resources"
+)
+
+var _ function.Function = &ManifestEncodeFunction{}
+
+// ManifestEncodeFunction renders shoehorn_entity attributes as the catalog
+// manifest the resource uploads.
+type ManifestEncodeFunction struct{}
+
+// NewManifestEncodeFunction creates a new manifest_encode function.
+func NewManifestEncodeFunction() function.Function {
+	return &ManifestEncodeFunction{}
+}
+
+func (f *ManifestEncodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "manifest_encode"
+}
+
+func (f *ManifestEncodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Encode a catalog manifest",
+		Description: "Renders an object with shoehorn_entity attributes as the catalog manifest (YAML) the resource would upload. " +
+			"Omitted attributes are treated as null. The object is validated by the same rules as shoehorn_entity configuration.",
+		Parameters: []function.Parameter{
+			// Dynamic, so callers can pass a partial object literal.
+			function.DynamicParameter{
+				Name:        "entity",
+				Description: "An object with shoehorn_entity attributes, e.g. the result of manifest_decode. name and type are required.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ManifestEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var entity types.Dynamic
+	resp.Error = req.Arguments.Get(ctx, &entity)
+	if resp.Error != nil {
+		return
+	}
+
+	model, err := entityModelFromValue(entity.UnderlyingValue())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	manifest, diags := resources.EncodeEntityManifest(model)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, manifest)
+}
@@ -0,0 +1,82 @@
+package functions
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestManifestEncodeFunction_Run_RoundTrip(t *testing.T) {
+	decoded, funcErr := runFunction(t, NewManifestDecodeFunction(), types.StringValue(paymentsManifest))
+	if funcErr != nil {
+		t.Fatalf("decode error: %s", funcErr)
+	}
+	encoded, funcErr := runFunction(t, NewManifestEncodeFunction(), types.DynamicValue(decoded))
+	if funcErr != nil {
+		t.Fatalf("encode error: %s", funcErr)
+	}
+	again, funcErr := runFunction(t, NewManifestDecodeFunction(), encoded)
+	if funcErr != nil {
+		t.Fatalf("second decode error: %s", funcErr)
+	}
+	if !again.Equal(decoded) {
+		t.Errorf("round trip changed the entity:\n got %s\nwant %s", again, decoded)
+	}
+}
+
+func TestManifestEncodeFunction_Run_PartialObject(t *testing.T) {
+	obj := types.ObjectValueMust(
+		map[string]attr.Type{"name": types.StringType, "type": types.StringType, "owner": types.StringType},
+		map[string]attr.Value{"name": types.StringValue("docs"), "type": types.StringValue("website"), "owner": types.StringValue("platform")},
+	)
+
+	got, funcErr := runFunction(t, NewManifestEncodeFunction(), types.DynamicValue(obj))
+	if funcErr != nil {
+		t.Fatalf("Run() error: %s", funcErr)
+	}
+	manifest := got.(types.String).ValueString()
+	for _, want := range []string{"  id: docs\n", "  type: website\n", "owner:\n  - type: team\n    id: platform\n"} {
+		if !strings.Contains(manifest, want) {
+			t.Errorf("manifest missing %q:\n%s", want, manifest)
+		}
+	}
+}
+
+func TestManifestEncodeFunction_Run_Invalid(t *testing.T) {
+	tests := map[string]struct {
+		attrs map[string]attr.Value
+		want  string
+	}{
+		"missing name": {
+			attrs: map[string]attr.Value{"type": types.StringValue("service")},
+			want:  "name",
+		},
+		"bad relations json": {
+			attrs: map[string]attr.Value{"name": types.StringValue("docs"), "type": types.StringValue("website"), "relations": types.StringValue("[")},
+			want:  "relations",
+		},
+		"link without url": {
+			attrs: map[string]attr.Value{"name": types.StringValue("docs"), "type": types.StringValue("website"), "links": types.StringValue(`[{"name":"Home"}]`)},
+			want:  "links",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			attrTypes := map[string]attr.Type{}
+			for k := range tt.attrs {
+				attrTypes[k] = types.StringType
+			}
+			obj := types.ObjectValueMust(attrTypes, tt.attrs)
+
+			_, funcErr := runFunction(t, NewManifestEncodeFunction(), types.DynamicValue(obj))
+			if funcErr == nil {
+				t.Fatal("Run() should fail")
+			}
+			if !strings.Contains(funcErr.Text, tt.want) {
+				t.Errorf("Run() error = %q, want it to mention %q", funcErr.Text, tt.want)
+			}
+		})
+	}
+}
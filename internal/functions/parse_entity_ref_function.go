@@ -0,0 +1,67 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/resources"
+)
+
+var _ function.Function = &ParseEntityRefFunction{}
+
+// entityRefAttributeTypes is the object returned by parse_entity_ref.
+var entityRefAttributeTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"id":   types.StringType,
+}
+
+// ParseEntityRefFunction splits a type:id entity reference.
+type ParseEntityRefFunction struct{}
+
+// NewParseEntityRefFunction creates a new parse_entity_ref function.
+func NewParseEntityRefFunction() function.Function {
+	return &ParseEntityRefFunction{}
+}
+
+func (f *ParseEntityRefFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_entity_ref"
+}
+
+func (f *ParseEntityRefFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse an entity reference",
+		Description: "Splits a type:id entity reference, such as a shoehorn_entity relation target, into an object with type and id.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ref",
+				Description: "The type:id reference to parse.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: entityRefAttributeTypes},
+	}
+}
+
+func (f *ParseEntityRefFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ref string
+	resp.Error = req.Arguments.Get(ctx, &ref)
+	if resp.Error != nil {
+		return
+	}
+
+	kind, id, err := resources.ParseEntityRef(ref)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	result, diags := types.ObjectValue(entityRefAttributeTypes, map[string]attr.Value{
+		"type": types.StringValue(kind),
+		"id":   types.StringValue(id),
+	})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
@@ -0,0 +1,46 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseEntityRefFunction_Run(t *testing.T) {
+	got, funcErr := runFunction(t, NewParseEntityRefFunction(), types.StringValue("resource:postgres-primary"))
+	if funcErr != nil {
+		t.Fatalf("Run() error: %s", funcErr)
+	}
+	want := types.ObjectValueMust(entityRefAttributeTypes, map[string]attr.Value{
+		"type": types.StringValue("resource"),
+		"id":   types.StringValue("postgres-primary"),
+	})
+	if !got.Equal(want) {
+		t.Errorf("Run() = %s, want %s", got, want)
+	}
+}
+
+func TestParseEntityRefFunction_Run_KeepsColonsInID(t *testing.T) {
+	got, funcErr := runFunction(t, NewParseEntityRefFunction(), types.StringValue("api:payments:v2"))
+	if funcErr != nil {
+		t.Fatalf("Run() error: %s", funcErr)
+	}
+	id := got.(types.Object).Attributes()["id"]
+	if !id.Equal(types.StringValue("payments:v2")) {
+		t.Errorf("id = %s, want payments:v2", id)
+	}
+}
+
+func TestParseEntityRefFunction_Run_Invalid(t *testing.T) {
+	for _, ref := range []string{"payments-api", ":payments-api", "service:", "service: payments-api"} {
+		_, funcErr := runFunction(t, NewParseEntityRefFunction(), types.StringValue(ref))
+		if funcErr == nil {
+			t.Errorf("Run(%q) should fail", ref)
+			continue
+		}
+		if funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 0 {
+			t.Errorf("Run(%q) error should point at the ref argument", ref)
+		}
+	}
+}
@@ -5,18 +5,28 @@ import (
 	"os"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/actions"
 	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
 	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/datasources"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/functions"
 	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/resources"
 )
 
-var _ provider.Provider = &ShoehornProvider{}
+var (
+	_ provider.Provider                  = &ShoehornProvider{}
+	_ provider.ProviderWithListResources = &ShoehornProvider{}
+	_ provider.ProviderWithFunctions     = &ShoehornProvider{}
+	_ provider.ProviderWithActions       = &ShoehornProvider{}
+)
 
 // ShoehornProvider defines the provider implementation.
 type ShoehornProvider struct {
@@ -28,6 +38,8 @@ type ShoehornProviderModel struct {
 	Host    types.String `tfsdk:"host"`
 	APIKey  types.String `tfsdk:"api_key"`
 	Timeout types.Int64  `tfsdk:"timeout"`
+
+	ValidateReferences types.Bool `tfsdk:"validate_references"`
 }
 
 // New returns a function that creates the provider.
@@ -61,6 +73,12 @@ func (p *ShoehornProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Description: "HTTP request timeout in seconds. Defaults to 30.",
 				Optional:    true,
 			},
+			"validate_references": schema.BoolAttribute{
+				Description: "Check during plan that teams, entities, users and roles referenced by resources exist. " +
+					"Only new or changed references are checked. Objects created in the same apply are not yet known " +
+					"and fail validation. Defaults to false.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -120,13 +138,19 @@ func (p *ShoehornProvider) Configure(ctx context.Context, req provider.Configure
 		"api_key_source": apiKeySource,
 		"timeout":        timeout.String(),
 		"version":        p.version,
+		"validate_refs":  config.ValidateReferences.ValueBool(),
 	})
 
 	// Create client
 	c := client.NewClient(host, apiKey, timeout)
+	if config.ValidateReferences.ValueBool() {
+		c.References = client.NewReferences(c)
+	}
 
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.ListResourceData = c
+	resp.ActionData = c
 }
 
 func (p *ShoehornProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -135,16 +159,57 @@ func (p *ShoehornProvider) Resources(_ context.Context) []func() resource.Resour
 		resources.NewEntityResource,
 		resources.NewFeatureFlagResource,
 		resources.NewTenantSettingsResource,
+		resources.NewTenantAppearanceResource,
+		resources.NewTenantAnnouncementResource,
+		resources.NewTenantForgeSettingsResource,
 		resources.NewAPIKeyResource,
 		resources.NewUserRoleResource,
+		resources.NewUserRolesResource,
+		resources.NewRoleResource,
 		resources.NewIntegrationResource,
 		resources.NewK8sAgentResource,
 		resources.NewPlatformPolicyResource,
 		resources.NewGroupRoleMappingResource,
+		resources.NewTeamGroupSyncResource,
+		resources.NewAnnouncementResource,
 		resources.NewForgeMoldResource,
 		resources.NewForgeApprovalPolicyResource,
 		resources.NewMarketplaceInstallationResource,
 		resources.NewGovernanceActionResource,
+		resources.NewGovernanceActionSetResource,
+		resources.NewForgeRunResource,
+		resources.NewGitOpsSyncWaitResource,
+	}
+}
+
+func (p *ShoehornProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		resources.NewTeamListResource,
+		resources.NewEntityListResource,
+		resources.NewFeatureFlagListResource,
+		resources.NewIntegrationListResource,
+		resources.NewForgeMoldListResource,
+		resources.NewGovernanceActionListResource,
+	}
+}
+
+func (p *ShoehornProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		actions.NewRevokeAPIKeyAction,
+		actions.NewRevokeK8sAgentAction,
+		actions.NewPublishForgeMoldAction,
+		actions.NewSyncIntegrationAction,
+		actions.NewEnableMarketplaceItemAction,
+		actions.NewDisableMarketplaceItemAction,
+	}
+}
+
+func (p *ShoehornProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewEntityRefFunction,
+		functions.NewParseEntityRefFunction,
+		functions.NewManifestDecodeFunction,
+		functions.NewManifestEncodeFunction,
 	}
 }
 
@@ -159,9 +224,16 @@ func (p *ShoehornProvider) DataSources(_ context.Context) []func() datasource.Da
 		datasources.NewPlatformPoliciesDataSource,
 		datasources.NewUsersDataSource,
 		datasources.NewGroupsDataSource,
+		datasources.NewRolesDataSource,
+		datasources.NewTeamGroupSyncPreviewDataSource,
+		datasources.NewActiveAnnouncementDataSource,
 		datasources.NewForgeMoldsDataSource,
 		datasources.NewMarketplaceItemsDataSource,
+		datasources.NewMarketplaceItemDataSource,
 		datasources.NewGitOpsResourcesDataSource,
+		datasources.NewGitOpsStatsDataSource,
 		datasources.NewGovernanceActionsDataSource,
+		datasources.NewGovernanceSummaryDataSource,
+		datasources.NewForgeApprovalPolicyDataSource,
 	}
 }
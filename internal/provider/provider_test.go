@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
 )
 
 func TestNew_ReturnsProvider(t *testing.T) {
@@ -38,7 +39,7 @@ func TestProvider_Schema_HasRequiredAttributes(t *testing.T) {
 	p.Schema(context.Background(), provider.SchemaRequest{}, resp)
 
 	attrs := resp.Schema.Attributes
-	requiredAttrs := []string{"host", "api_key", "timeout"}
+	requiredAttrs := []string{"host", "api_key", "timeout", "validate_references"}
 	for _, name := range requiredAttrs {
 		if _, ok := attrs[name]; !ok {
 			t.Errorf("schema missing attribute %q", name)
@@ -61,6 +62,10 @@ func TestProvider_Schema_APIKeyIsSensitive(t *testing.T) {
 }
 
 func newTestConfigValue(host, apiKey *string, timeout *int64) tftypes.Value {
+	return newTestConfigValueWithReferences(host, apiKey, timeout, nil)
+}
+
+func newTestConfigValueWithReferences(host, apiKey *string, timeout *int64, validateReferences *bool) tftypes.Value {
 	hostVal := tftypes.NewValue(tftypes.String, nil)
 	if host != nil {
 		hostVal = tftypes.NewValue(tftypes.String, *host)
@@ -76,16 +81,23 @@ func newTestConfigValue(host, apiKey *string, timeout *int64) tftypes.Value {
 		timeoutVal = tftypes.NewValue(tftypes.Number, timeout)
 	}
 
+	validateReferencesVal := tftypes.NewValue(tftypes.Bool, nil)
+	if validateReferences != nil {
+		validateReferencesVal = tftypes.NewValue(tftypes.Bool, *validateReferences)
+	}
+
 	return tftypes.NewValue(tftypes.Object{
 		AttributeTypes: map[string]tftypes.Type{
-			"host":    tftypes.String,
-			"api_key": tftypes.String,
-			"timeout": tftypes.Number,
+			"host":                tftypes.String,
+			"api_key":             tftypes.String,
+			"timeout":             tftypes.Number,
+			"validate_references": tftypes.Bool,
 		},
 	}, map[string]tftypes.Value{
-		"host":    hostVal,
-		"api_key": apiKeyVal,
-		"timeout": timeoutVal,
+		"host":                hostVal,
+		"api_key":             apiKeyVal,
+		"timeout":             timeoutVal,
+		"validate_references": validateReferencesVal,
 	})
 }
 
@@ -236,3 +248,132 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("SHOEHORN_API_KEY must be set for acceptance tests")
 	}
 }
+
+func TestProvider_Configure_ValidateReferences(t *testing.T) {
+	t.Setenv("SHOEHORN_HOST", "https://test.example.com")
+	t.Setenv("SHOEHORN_API_KEY", "test-key")
+
+	p := &ShoehornProvider{version: "test"}
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+	for _, enabled := range []bool{false, true} {
+		resp := &provider.ConfigureResponse{}
+		p.Configure(context.Background(), provider.ConfigureRequest{
+			Config: tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw:    newTestConfigValueWithReferences(nil, nil, nil, &enabled),
+			},
+		}, resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+		}
+		c, ok := resp.ResourceData.(*client.Client)
+		if !ok {
+			t.Fatalf("ResourceData = %T, want *client.Client", resp.ResourceData)
+		}
+		if (c.References != nil) != enabled {
+			t.Errorf("validate_references = %v: References set = %v", enabled, c.References != nil)
+		}
+	}
+}
+
+func TestProvider_ListResources_HaveIdentitySchemas(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("GetProviderSchema() diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if len(resp.ListResourceSchemas) != 6 {
+		t.Errorf("list resource count = %d, want 6", len(resp.ListResourceSchemas))
+	}
+
+	identities, err := server.GetResourceIdentitySchemas(context.Background(), &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name := range resp.ListResourceSchemas {
+		if _, ok := identities.IdentitySchemas[name]; !ok {
+			t.Errorf("list resource %s has no managed resource identity schema", name)
+		}
+	}
+}
+
+func TestProvider_Functions_CallEntityRef(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"entity_ref", "parse_entity_ref", "manifest_decode", "manifest_encode"} {
+		if _, ok := schemaResp.Functions[name]; !ok {
+			t.Errorf("provider has no %s function", name)
+		}
+	}
+
+	arg := func(s string) *tfprotov6.DynamicValue {
+		v, err := tfprotov6.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &v
+	}
+	resp, err := server.CallFunction(context.Background(), &tfprotov6.CallFunctionRequest{
+		Name:      "entity_ref",
+		Arguments: []*tfprotov6.DynamicValue{arg("service"), arg("payments-api")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != nil {
+		t.Fatalf("CallFunction() error: %s", resp.Error.Text)
+	}
+	got, err := resp.Result.Unmarshal(tftypes.String)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ref string
+	if err := got.As(&ref); err != nil {
+		t.Fatal(err)
+	}
+	if ref != "service:payments-api" {
+		t.Errorf("entity_ref() = %q, want %q", ref, "service:payments-api")
+	}
+}
+
+func TestProvider_Actions_HaveSchemas(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("GetProviderSchema() diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	for _, name := range []string{
+		"shoehorn_revoke_api_key",
+		"shoehorn_revoke_k8s_agent",
+		"shoehorn_publish_forge_mold",
+		"shoehorn_sync_integration",
+		"shoehorn_enable_marketplace_item",
+		"shoehorn_disable_marketplace_item",
+	} {
+		if _, ok := resp.ActionSchemas[name]; !ok {
+			t.Errorf("provider has no %s action", name)
+		}
+	}
+}
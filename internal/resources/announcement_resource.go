@@ -0,0 +1,421 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &AnnouncementResource{}
+	_ resource.ResourceWithImportState    = &AnnouncementResource{}
+	_ resource.ResourceWithValidateConfig = &AnnouncementResource{}
+	_ resource.ResourceWithModifyPlan     = &AnnouncementResource{}
+)
+
+// AnnouncementResource defines the resource implementation.
+type AnnouncementResource struct {
+	client *client.Client
+}
+
+// AnnouncementResourceModel describes the resource data model.
+type AnnouncementResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Message       types.String `tfsdk:"message"`
+	Type          types.String `tfsdk:"type"`
+	LinkURL       types.String `tfsdk:"link_url"`
+	LinkText      types.String `tfsdk:"link_text"`
+	Dismissible   types.Bool   `tfsdk:"dismissible"`
+	StartsAt      types.String `tfsdk:"starts_at"`
+	EndsAt        types.String `tfsdk:"ends_at"`
+	AudienceTeams types.Set    `tfsdk:"audience_teams"`
+	AudienceRoles types.Set    `tfsdk:"audience_roles"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+// NewAnnouncementResource creates a new announcement resource.
+func NewAnnouncementResource() resource.Resource {
+	return &AnnouncementResource{}
+}
+
+func (r *AnnouncementResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_announcement"
+}
+
+func (r *AnnouncementResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a scheduled Shoehorn announcement banner. Shoehorn shows the banner between starts_at and ends_at, so a maintenance window needs a single apply. When several announcements are active, the one that started most recently is shown. Use the shoehorn_active_announcement data source to see which banner is shown.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the announcement.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"message": schema.StringAttribute{
+				Description: "Announcement message text.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Announcement type. Valid values: info, warning, error, success. Defaults to info.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("info"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("info", "warning", "error", "success"),
+				},
+			},
+			"link_url": schema.StringAttribute{
+				Description: "Optional call-to-action link URL (must be http:// or https://).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^https?://`),
+						"must be an HTTP or HTTPS URL",
+					),
+				},
+			},
+			"link_text": schema.StringAttribute{
+				Description: "Call-to-action link text.",
+				Optional:    true,
+			},
+			"dismissible": schema.BoolAttribute{
+				Description: "Whether users can dismiss the banner. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"starts_at": schema.StringAttribute{
+				Description: "When the banner is first shown, as an RFC 3339 timestamp (e.g., 2026-03-01T22:00:00Z). Shown immediately if unset.",
+				Optional:    true,
+			},
+			"ends_at": schema.StringAttribute{
+				Description: "When the banner stops being shown, as an RFC 3339 timestamp. Must be after starts_at. Shown until the resource is destroyed if unset.",
+				Optional:    true,
+			},
+			"audience_teams": schema.SetAttribute{
+				Description: "IDs or slugs of the teams whose members see the banner. If neither audience_teams nor audience_roles is set, everyone sees it.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"audience_roles": schema.SetAttribute{
+				Description: "Roles whose holders see the banner, e.g. tenant:admin.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The creation timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The last update timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *AnnouncementResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ValidateConfig checks that starts_at and ends_at are RFC 3339 timestamps
+// and that the window ends after it starts.
+func (r *AnnouncementResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config AnnouncementResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	start, startOK := parseAnnouncementTime(path.Root("starts_at"), config.StartsAt, &resp.Diagnostics)
+	end, endOK := parseAnnouncementTime(path.Root("ends_at"), config.EndsAt, &resp.Diagnostics)
+	if startOK && endOK && !end.After(start) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ends_at"),
+			"Invalid Announcement Window",
+			fmt.Sprintf("ends_at (%s) must be after starts_at (%s).", config.EndsAt.ValueString(), config.StartsAt.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan validates the audience teams and roles when the provider enables
+// validate_references.
+func (r *AnnouncementResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AnnouncementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, audience := range []struct {
+		attr    string
+		kind    string
+		planned types.Set
+		prior   types.Set
+	}{
+		{"audience_teams", referenceTeam, plan.AudienceTeams, state.AudienceTeams},
+		{"audience_roles", referenceRole, plan.AudienceRoles, state.AudienceRoles},
+	} {
+		planned, diags := stringSetValues(ctx, audience.planned)
+		resp.Diagnostics.Append(diags...)
+		prior, diags := stringSetValues(ctx, audience.prior)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, ref := range setDifference(planned, prior) {
+			resp.Diagnostics.Append(validateReference(ctx, r.client, path.Root(audience.attr), ref, audience.kind)...)
+		}
+	}
+}
+
+func (r *AnnouncementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "creating announcement")
+
+	var plan AnnouncementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	announcementReq, diags := announcementRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	announcement, err := r.client.CreateAnnouncement(ctx, announcementReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Announcement", fmt.Sprintf("Could not create announcement: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(mapAnnouncementToState(ctx, announcement, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AnnouncementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "reading announcement")
+
+	var state AnnouncementResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	announcement, err := r.client.GetAnnouncement(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "announcement not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Announcement", fmt.Sprintf("Could not read announcement %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(mapAnnouncementToState(ctx, announcement, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AnnouncementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "updating announcement")
+
+	var plan AnnouncementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	announcementReq, diags := announcementRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateCtx := withStateVersion(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	announcement, err := r.client.UpdateAnnouncement(updateCtx, plan.ID.ValueString(), announcementReq)
+	if err != nil {
+		addUpdateError(&resp.Diagnostics, "Error Updating Announcement", fmt.Sprintf("Could not update announcement %s", plan.ID.ValueString()), err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapAnnouncementToState(ctx, announcement, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AnnouncementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "deleting announcement")
+
+	var state AnnouncementResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteAnnouncement(ctx, state.ID.ValueString()); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Announcement", fmt.Sprintf("Could not delete announcement %s: %s", state.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *AnnouncementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	announcement, err := r.client.GetAnnouncement(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Announcement", fmt.Sprintf("Could not read announcement %s: %s", req.ID, err))
+		return
+	}
+
+	var state AnnouncementResourceModel
+	resp.Diagnostics.Append(mapAnnouncementToState(ctx, announcement, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// parseAnnouncementTime parses a configured window bound, adding an attribute
+// error if it is not an RFC 3339 timestamp. It reports whether v held a
+// valid time.
+func parseAnnouncementTime(p path.Path, v types.String, diags *diag.Diagnostics) (time.Time, bool) {
+	if v.IsNull() || v.IsUnknown() {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, v.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Invalid Timestamp",
+			fmt.Sprintf("%q is not an RFC 3339 timestamp such as 2026-03-01T22:00:00Z.", v.ValueString()),
+		)
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// announcementRequest builds the announcement create/replace request from the
+// plan.
+func announcementRequest(ctx context.Context, plan AnnouncementResourceModel) (client.AnnouncementRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := client.AnnouncementRequest{
+		Message:     plan.Message.ValueString(),
+		Type:        plan.Type.ValueString(),
+		LinkURL:     plan.LinkURL.ValueString(),
+		LinkText:    plan.LinkText.ValueString(),
+		Dismissible: plan.Dismissible.ValueBool(),
+		StartsAt:    plan.StartsAt.ValueString(),
+		EndsAt:      plan.EndsAt.ValueString(),
+	}
+
+	var d diag.Diagnostics
+	req.Audience.Teams, d = stringSetValues(ctx, plan.AudienceTeams)
+	diags.Append(d...)
+	req.Audience.Roles, d = stringSetValues(ctx, plan.AudienceRoles)
+	diags.Append(d...)
+	return req, diags
+}
+
+func mapAnnouncementToState(ctx context.Context, announcement *client.Announcement, state *AnnouncementResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(announcement.ID)
+	state.Message = types.StringValue(announcement.Message)
+	state.Type = types.StringValue(announcement.Type)
+	state.Dismissible = types.BoolValue(announcement.Dismissible)
+	state.LinkURL = preserveOrNull(announcement.LinkURL, state.LinkURL)
+	state.LinkText = preserveOrNull(announcement.LinkText, state.LinkText)
+	state.StartsAt = preserveTimeOrNull(announcement.StartsAt, state.StartsAt)
+	state.EndsAt = preserveTimeOrNull(announcement.EndsAt, state.EndsAt)
+	state.CreatedAt = stringValueOrNull(announcement.CreatedAt)
+	state.UpdatedAt = stringValueOrNull(announcement.UpdatedAt)
+
+	var d diag.Diagnostics
+	state.AudienceTeams, d = announcementAudienceValue(ctx, announcement.Audience.Teams)
+	diags.Append(d...)
+	state.AudienceRoles, d = announcementAudienceValue(ctx, announcement.Audience.Roles)
+	diags.Append(d...)
+	return diags
+}
+
+// preserveTimeOrNull is preserveOrNull for timestamps: the configured form is
+// kept when the API returns the same instant written differently, e.g. in
+// UTC instead of the configured offset.
+func preserveTimeOrNull(apiValue string, currentState types.String) types.String {
+	if !currentState.IsNull() && !currentState.IsUnknown() && apiValue != "" {
+		configured, err1 := time.Parse(time.RFC3339, currentState.ValueString())
+		returned, err2 := time.Parse(time.RFC3339, apiValue)
+		if err1 == nil && err2 == nil && configured.Equal(returned) {
+			return currentState
+		}
+	}
+	return preserveOrNull(apiValue, currentState)
+}
+
+// announcementAudienceValue returns an audience set, null when empty since
+// an empty audience means everyone.
+func announcementAudienceValue(ctx context.Context, refs []string) (types.Set, diag.Diagnostics) {
+	if len(refs) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+	return types.SetValueFrom(ctx, types.StringType, refs)
+}
@@ -0,0 +1,226 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+func announcementSchema() resource.SchemaResponse {
+	resp := resource.SchemaResponse{}
+	NewAnnouncementResource().Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	return resp
+}
+
+// announcementPlan encodes m as a plan for the announcement resource.
+func announcementPlan(t *testing.T, m AnnouncementResourceModel) tfsdk.Plan {
+	t.Helper()
+	state := tfsdk.State{Schema: announcementSchema().Schema}
+	if diags := state.Set(context.Background(), &m); diags.HasError() {
+		t.Fatalf("encoding model: %v", diags)
+	}
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+// announcementModel returns a maintenance banner for the given window.
+func announcementModel(startsAt, endsAt types.String) AnnouncementResourceModel {
+	return AnnouncementResourceModel{
+		ID: types.StringUnknown(), Message: types.StringValue("Maintenance tonight"), Type: types.StringValue("warning"),
+		LinkURL: types.StringNull(), LinkText: types.StringNull(), Dismissible: types.BoolValue(false),
+		StartsAt: startsAt, EndsAt: endsAt,
+		AudienceTeams: types.SetNull(types.StringType), AudienceRoles: types.SetNull(types.StringType),
+		CreatedAt: types.StringUnknown(), UpdatedAt: types.StringUnknown(),
+	}
+}
+
+func TestAnnouncementResource_Metadata(t *testing.T) {
+	r := NewAnnouncementResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_announcement" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_announcement")
+	}
+}
+
+func TestAnnouncementResource_Schema_HasRequiredAttributes(t *testing.T) {
+	attrs := announcementSchema().Schema.Attributes
+	for _, name := range []string{"id", "message", "type", "link_url", "link_text", "dismissible", "starts_at", "ends_at", "audience_teams", "audience_roles", "created_at", "updated_at"} {
+		if _, ok := attrs[name]; !ok {
+			t.Errorf("schema missing attribute %q", name)
+		}
+	}
+	if !attrs["message"].IsRequired() {
+		t.Error("message should be required")
+	}
+}
+
+func TestAnnouncementResource_Configure_WithValidClient(t *testing.T) {
+	r := &AnnouncementResource{}
+	c := client.NewClient("https://test.example.com", "key", 30*time.Second)
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: c,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if r.client != c {
+		t.Error("client not set correctly")
+	}
+}
+
+func TestAnnouncementResource_Configure_WrongType(t *testing.T) {
+	r := &AnnouncementResource{}
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: "not a client",
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected error for wrong provider data type")
+	}
+}
+
+func TestAnnouncementResource_ValidateConfig_Window(t *testing.T) {
+	tests := []struct {
+		name     string
+		startsAt types.String
+		endsAt   types.String
+		wantErr  bool
+	}{
+		{"open window", types.StringNull(), types.StringNull(), false},
+		{"valid window", types.StringValue("2026-03-01T22:00:00Z"), types.StringValue("2026-03-02T02:00:00Z"), false},
+		{"offset window", types.StringValue("2026-03-01T23:00:00+01:00"), types.StringValue("2026-03-01T23:30:00Z"), false},
+		{"inverted window", types.StringValue("2026-03-02T02:00:00Z"), types.StringValue("2026-03-01T22:00:00Z"), true},
+		{"empty window", types.StringValue("2026-03-01T22:00:00Z"), types.StringValue("2026-03-01T22:00:00Z"), true},
+		{"not a timestamp", types.StringValue("tonight"), types.StringNull(), true},
+		{"unknown bound", types.StringUnknown(), types.StringValue("2026-03-01T22:00:00Z"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tfsdk.State(announcementPlan(t, announcementModel(tt.startsAt, tt.endsAt)))
+			resp := &resource.ValidateConfigResponse{}
+			(&AnnouncementResource{}).ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+			}, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("HasError = %v, want %v (%v)", resp.Diagnostics.HasError(), tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestPreserveTimeOrNull(t *testing.T) {
+	configured := types.StringValue("2026-03-01T23:00:00+01:00")
+	if got := preserveTimeOrNull("2026-03-01T22:00:00Z", configured); !got.Equal(configured) {
+		t.Errorf("same instant = %v, want the configured form kept", got)
+	}
+	if got := preserveTimeOrNull("2026-03-01T23:00:00Z", configured); got.ValueString() != "2026-03-01T23:00:00Z" {
+		t.Errorf("different instant = %v, want the API value", got)
+	}
+	if got := preserveTimeOrNull("", types.StringNull()); !got.IsNull() {
+		t.Errorf("unset = %v, want null", got)
+	}
+}
+
+func TestAnnouncementResource_Lifecycle(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	r := &AnnouncementResource{client: api.Client()}
+	ctx := context.Background()
+	schemaResp := announcementSchema()
+
+	plan := announcementPlan(t, announcementModel(types.StringValue("2026-03-01T22:00:00Z"), types.StringValue("2026-03-02T02:00:00Z")))
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() error = %v", createResp.Diagnostics)
+	}
+	var created AnnouncementResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.ID.IsNull() || created.Dismissible.ValueBool() || !created.AudienceTeams.IsNull() {
+		t.Errorf("created = %+v", created)
+	}
+
+	teams, _ := types.SetValueFrom(ctx, types.StringType, []string{"platform"})
+	created.AudienceTeams = teams
+	created.EndsAt = types.StringNull()
+	updateResp := &resource.UpdateResponse{State: createResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: announcementPlan(t, created), State: createResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update() error = %v", updateResp.Diagnostics)
+	}
+
+	announcement, err := api.Client().GetAnnouncement(ctx, created.ID.ValueString())
+	if err != nil {
+		t.Fatalf("GetAnnouncement() error = %v", err)
+	}
+	if announcement.EndsAt != "" || len(announcement.Audience.Teams) != 1 || announcement.Audience.Teams[0] != "platform" {
+		t.Errorf("announcement = %+v", announcement)
+	}
+
+	deleteResp := &resource.DeleteResponse{State: updateResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Delete() error = %v", deleteResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, readResp)
+	if readResp.Diagnostics.HasError() || !readResp.State.Raw.IsNull() {
+		t.Errorf("Read() after delete should remove the resource: %v", readResp.Diagnostics)
+	}
+}
+
+func TestAnnouncementResource_UpdateRejectsChangeSinceRefresh(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	r := &AnnouncementResource{client: api.Client()}
+	ctx := context.Background()
+	schemaResp := announcementSchema()
+
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: announcementPlan(t, announcementModel(types.StringNull(), types.StringNull()))}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() error = %v", createResp.Diagnostics)
+	}
+	var created AnnouncementResourceModel
+	createResp.State.Get(ctx, &created)
+
+	// State last refreshed before someone else edited the announcement.
+	stale := created
+	stale.UpdatedAt = types.StringValue("2020-01-01T00:00:00Z")
+	staleState := tfsdk.State{Schema: schemaResp.Schema}
+	staleState.Set(ctx, &stale)
+
+	created.Message = types.StringValue("Edited by Terraform")
+	updateResp := &resource.UpdateResponse{State: staleState}
+	r.Update(ctx, resource.UpdateRequest{Plan: announcementPlan(t, created), State: staleState}, updateResp)
+	if !updateResp.Diagnostics.HasError() {
+		t.Fatal("Update() with stale state succeeded, want a conflict error")
+	}
+	if detail := updateResp.Diagnostics[0].Detail(); !strings.Contains(detail, "changed outside Terraform") {
+		t.Errorf("error detail = %q, want it to ask for a re-plan", detail)
+	}
+
+	announcement, err := api.Client().GetAnnouncement(ctx, created.ID.ValueString())
+	if err != nil {
+		t.Fatalf("GetAnnouncement() error = %v", err)
+	}
+	if announcement.Message == "Edited by Terraform" {
+		t.Error("stale update was applied")
+	}
+}
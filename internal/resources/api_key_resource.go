@@ -15,7 +15,10 @@ import (
 	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
 )
 
-var _ resource.Resource = &APIKeyResource{}
+var (
+	_ resource.Resource                = &APIKeyResource{}
+	_ resource.ResourceWithImportState = &APIKeyResource{}
+)
 
 // APIKeyResource defines the resource implementation.
 type APIKeyResource struct {
@@ -223,3 +226,36 @@ func (r *APIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 }
+
+// ImportState imports a key by ID. The raw key is only returned on creation,
+// so raw_key stays null for imported keys.
+func (r *APIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	apiKey, err := r.client.GetAPIKey(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing API Key", fmt.Sprintf("Could not read API key %s: %s", req.ID, err))
+		return
+	}
+	if apiKey.RevokedAt != "" {
+		resp.Diagnostics.AddError("Error Importing API Key", fmt.Sprintf("API key %s was revoked at %s.", req.ID, apiKey.RevokedAt))
+		return
+	}
+
+	scopes, diags := types.ListValueFrom(ctx, types.StringType, apiKey.Scopes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := APIKeyResourceModel{
+		ID:            types.StringValue(apiKey.ID),
+		Name:          types.StringValue(apiKey.Name),
+		Description:   stringValueOrNull(apiKey.Description),
+		Scopes:        scopes,
+		ExpiresInDays: expiresInDays(apiKey.CreatedAt, apiKey.ExpiresAt),
+		KeyPrefix:     types.StringValue(apiKey.KeyPrefix),
+		RawKey:        types.StringNull(),
+		ExpiresAt:     stringValueOrNull(apiKey.ExpiresAt),
+		CreatedAt:     stringValueOrNull(apiKey.CreatedAt),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,111 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ list.ListResourceWithConfigure = &EntityListResource{}
+
+// EntityListResource lists catalog entities for terraform query.
+type EntityListResource struct {
+	client *client.Client
+}
+
+// EntityListConfigModel describes the list block configuration.
+type EntityListConfigModel struct {
+	Type      types.String `tfsdk:"type"`
+	Owner     types.String `tfsdk:"owner"`
+	Lifecycle types.String `tfsdk:"entity_lifecycle"`
+	Tag       types.String `tfsdk:"tag"`
+}
+
+// NewEntityListResource creates a new entity list resource.
+func NewEntityListResource() list.ListResource {
+	return &EntityListResource{}
+}
+
+func (r *EntityListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity"
+}
+
+func (r *EntityListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists Shoehorn catalog entities.",
+		Attributes: map[string]listschema.Attribute{
+			"type": listschema.StringAttribute{
+				Description: "Only list entities of this type, e.g. service.",
+				Optional:    true,
+			},
+			"owner": listschema.StringAttribute{
+				Description: "Only list entities owned by this team.",
+				Optional:    true,
+			},
+			"entity_lifecycle": listschema.StringAttribute{
+				Description: "Only list entities in this lifecycle stage, e.g. production.",
+				Optional:    true,
+			},
+			"tag": listschema.StringAttribute{
+				Description: "Only list entities with this tag.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *EntityListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListClient(req, resp)
+}
+
+func (r *EntityListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config EntityListConfigModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	entities, err := r.client.ListEntities(ctx)
+	if err != nil {
+		listError(stream, "Error Listing Entities", fmt.Sprintf("Could not list entities: %s", err))
+		return
+	}
+	var matched []client.EntityListItem
+	for _, e := range entities {
+		var owner string
+		if len(e.Owner) > 0 {
+			owner = e.Owner[0].ID
+		}
+		if !matchesFilter(config.Type, e.Service.Type) || !matchesFilter(config.Owner, owner) || !matchesFilter(config.Lifecycle, e.Lifecycle) {
+			continue
+		}
+		if !config.Tag.IsNull() && !slices.Contains(e.Tags, config.Tag.ValueString()) {
+			continue
+		}
+		matched = append(matched, e)
+	}
+
+	stream.Results = listResults(ctx, req, matched, func(e client.EntityListItem, result *list.ListResult) {
+		result.DisplayName = e.Service.Name
+		result.Diagnostics.Append(setStringIdentity(ctx, result.Identity, "id", types.StringValue(e.Service.ID))...)
+		if !req.IncludeResource {
+			return
+		}
+
+		// The list omits links, relations and interfaces.
+		entity, err := r.client.GetEntity(ctx, e.Service.ID)
+		if err != nil {
+			result.Diagnostics.AddError("Error Reading Entity", fmt.Sprintf("Could not read entity %s: %s", e.Service.ID, err))
+			return
+		}
+		var state EntityResourceModel
+		mapEntityToState(ctx, entity, &state)
+		result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+	})
+}
@@ -0,0 +1,240 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"gopkg.in/yaml.v3"
+)
+
+// manifestDocument is the part of the catalog manifest format the entity
+// resource writes. It is used to decode manifests back into the model.
+type manifestDocument struct {
+	Service struct {
+		ID   string `yaml:"id"`
+		Name string `yaml:"name"`
+		Type string `yaml:"type"`
+		Tier string `yaml:"tier"`
+	} `yaml:"service"`
+	Description string `yaml:"description"`
+	Lifecycle   string `yaml:"lifecycle"`
+	Owner       []struct {
+		Type string `yaml:"type"`
+		ID   string `yaml:"id"`
+	} `yaml:"owner"`
+	Tags  []string `yaml:"tags"`
+	Links []struct {
+		Name string `yaml:"name"`
+		URL  string `yaml:"url"`
+		Icon string `yaml:"icon"`
+	} `yaml:"links"`
+	Relations []struct {
+		Type   string `yaml:"type"`
+		Target string `yaml:"target"`
+		Via    string `yaml:"via"`
+	} `yaml:"relations"`
+	Integrations *struct {
+		Changelog *struct {
+			Path string `yaml:"path"`
+		} `yaml:"changelog"`
+		Licenses []struct {
+			Title     string `yaml:"title"`
+			Vendor    string `yaml:"vendor"`
+			Purchased string `yaml:"purchased"`
+			Expires   string `yaml:"expires"`
+			Seats     int    `yaml:"seats"`
+			Cost      string `yaml:"cost"`
+			Contract  string `yaml:"contract"`
+			Notes     string `yaml:"notes"`
+		} `yaml:"licenses"`
+	} `yaml:"integrations"`
+	Interfaces map[string]any `yaml:"interfaces"`
+}
+
+// EncodeEntityManifest validates model and renders it as the manifest the
+// entity resource uploads.
+func EncodeEntityManifest(model *EntityResourceModel) (string, diag.Diagnostics) {
+	diags := validateEntityModel(model)
+	if diags.HasError() {
+		return "", diags
+	}
+	return buildManifestYAML(model), diags
+}
+
+// DecodeEntityManifest parses a catalog manifest into the entity resource
+// model, in the form Read stores in state. The computed attributes are left
+// null. The result is validated like entity configuration.
+func DecodeEntityManifest(ctx context.Context, content string) (*EntityResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var doc manifestDocument
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		diags.AddError("Invalid Manifest", fmt.Sprintf("Could not parse the manifest: %s", err))
+		return nil, diags
+	}
+
+	entity := &client.Entity{
+		Service: client.EntityService{
+			ID:   doc.Service.ID,
+			Name: doc.Service.Name,
+			Type: doc.Service.Type,
+			Tier: doc.Service.Tier,
+		},
+		Description: doc.Description,
+		Lifecycle:   doc.Lifecycle,
+		Tags:        doc.Tags,
+		Interfaces:  doc.Interfaces,
+	}
+	// The resource writes name as both the service ID and name; the ID wins.
+	if entity.Service.ID != "" {
+		entity.Service.Name = entity.Service.ID
+	}
+	for _, o := range doc.Owner {
+		entity.Owner = append(entity.Owner, client.OwnerInfo{Type: o.Type, ID: o.ID})
+	}
+	for _, l := range doc.Links {
+		entity.Links = append(entity.Links, client.LinkInfo{Name: l.Name, URL: l.URL, Icon: l.Icon})
+	}
+	for _, rel := range doc.Relations {
+		targetType, targetID, _ := strings.Cut(rel.Target, ":")
+		entity.Relations = append(entity.Relations, client.RelationInfo{
+			Type:       rel.Type,
+			TargetType: targetType,
+			TargetID:   targetID,
+			Via:        rel.Via,
+		})
+	}
+	if doc.Integrations != nil {
+		entity.Integrations = &client.Integrations{}
+		if doc.Integrations.Changelog != nil {
+			entity.Integrations.Changelog = &client.ChangelogIntegration{Path: doc.Integrations.Changelog.Path}
+		}
+		for _, lic := range doc.Integrations.Licenses {
+			entity.Integrations.Licenses = append(entity.Integrations.Licenses, client.LicenseInfo(lic))
+		}
+	}
+
+	var model EntityResourceModel
+	mapEntityToState(ctx, entity, &model)
+	model.ID = types.StringNull()
+	model.RepositoryPath = types.StringNull()
+
+	diags.Append(validateEntityModel(&model)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &model, diags
+}
+
+// FormatEntityRef returns the type:id reference used for relation targets.
+func FormatEntityRef(kind, id string) (string, error) {
+	if strings.Contains(kind, ":") {
+		return "", fmt.Errorf("entity type %q must not contain a colon", kind)
+	}
+	ref := kind + ":" + id
+	if _, _, err := ParseEntityRef(ref); err != nil {
+		return "", err
+	}
+	return ref, nil
+}
+
+// ParseEntityRef splits a type:id entity reference. The type may not contain
+// a colon; neither part may be empty or contain whitespace.
+func ParseEntityRef(ref string) (kind, id string, err error) {
+	kind, id, ok := strings.Cut(ref, ":")
+	if !ok {
+		return "", "", fmt.Errorf("%q is not an entity reference; expected type:id", ref)
+	}
+	if kind == "" || id == "" {
+		return "", "", fmt.Errorf("%q is not an entity reference; both type and id must be set", ref)
+	}
+	if strings.ContainsAny(ref, " \t\r\n") {
+		return "", "", fmt.Errorf("%q is not an entity reference; it must not contain whitespace", ref)
+	}
+	return kind, id, nil
+}
+
+// validateEntityModel checks the entity attributes the API only rejects once
+// the manifest is uploaded: the required service fields and the shape of the
+// JSON-encoded attributes. Unknown values are skipped.
+func validateEntityModel(model *EntityResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, attr := range []struct {
+		name  string
+		value types.String
+	}{{"name", model.Name}, {"type", model.Type}} {
+		if !attr.value.IsUnknown() && strings.TrimSpace(attr.value.ValueString()) == "" {
+			diags.AddAttributeError(path.Root(attr.name), "Missing Entity Attribute", fmt.Sprintf("The entity %s must not be empty.", attr.name))
+		}
+	}
+
+	validateEntityJSON(&diags, "links", model.Links, func(data []byte) error {
+		var links []client.LinkInfo
+		if err := json.Unmarshal(data, &links); err != nil {
+			return err
+		}
+		for i, l := range links {
+			if l.Name == "" || l.URL == "" {
+				return fmt.Errorf("link %d must have a name and url", i)
+			}
+		}
+		return nil
+	})
+
+	validateEntityJSON(&diags, "relations", model.Relations, func(data []byte) error {
+		var relations []struct {
+			Type   string `json:"type"`
+			Target string `json:"target"`
+		}
+		if err := json.Unmarshal(data, &relations); err != nil {
+			return err
+		}
+		for i, rel := range relations {
+			if rel.Type == "" {
+				return fmt.Errorf("relation %d must have a type", i)
+			}
+			if _, _, err := ParseEntityRef(rel.Target); err != nil {
+				return fmt.Errorf("relation %d target: %w", i, err)
+			}
+		}
+		return nil
+	})
+
+	validateEntityJSON(&diags, "licenses", model.Licenses, func(data []byte) error {
+		var licenses []client.LicenseInfo
+		if err := json.Unmarshal(data, &licenses); err != nil {
+			return err
+		}
+		for i, lic := range licenses {
+			if lic.Title == "" {
+				return fmt.Errorf("license %d must have a title", i)
+			}
+		}
+		return nil
+	})
+
+	validateEntityJSON(&diags, "interfaces", model.Interfaces, func(data []byte) error {
+		var ifaces map[string]any
+		return json.Unmarshal(data, &ifaces)
+	})
+
+	return diags
+}
+
+// validateEntityJSON runs check on a known, non-null JSON attribute and adds
+// an attribute error if it fails.
+func validateEntityJSON(diags *diag.Diagnostics, attr string, value types.String, check func([]byte) error) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	if err := check([]byte(value.ValueString())); err != nil {
+		diags.AddAttributeError(path.Root(attr), "Invalid Entity Attribute", fmt.Sprintf("%s is not valid: %s", attr, err))
+	}
+}
@@ -0,0 +1,126 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// entityConfigModel returns a minimal entity configuration with the
+// computed attributes unknown and everything else null.
+func entityConfigModel() EntityResourceModel {
+	return EntityResourceModel{
+		ID: types.StringUnknown(), Name: types.StringValue("payments-api"), Type: types.StringValue("service"),
+		Description: types.StringNull(), Lifecycle: types.StringNull(), Tier: types.StringNull(), Owner: types.StringNull(),
+		Tags: types.SetNull(types.StringType), Links: types.StringNull(), Relations: types.StringNull(),
+		Licenses: types.StringNull(), ChangelogPath: types.StringNull(), Interfaces: types.StringNull(),
+		RepositoryPath: types.StringUnknown(), CreatedAt: types.StringUnknown(), UpdatedAt: types.StringUnknown(),
+	}
+}
+
+func TestEntityResource_ValidateConfig(t *testing.T) {
+	r := &EntityResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		name    string
+		modify  func(m *EntityResourceModel)
+		wantErr bool
+	}{
+		{"minimal", func(m *EntityResourceModel) {}, false},
+		{"valid relations", func(m *EntityResourceModel) {
+			m.Relations = types.StringValue(`[{"type":"depends_on","target":"resource:postgres-primary"}]`)
+		}, false},
+		{"unknown relations", func(m *EntityResourceModel) { m.Relations = types.StringUnknown() }, false},
+		{"relation target without type", func(m *EntityResourceModel) {
+			m.Relations = types.StringValue(`[{"type":"calls","target":"notification-service"}]`)
+		}, true},
+		{"relations not an array", func(m *EntityResourceModel) { m.Relations = types.StringValue(`{"type":"calls"}`) }, true},
+		{"link without url", func(m *EntityResourceModel) { m.Links = types.StringValue(`[{"name":"Docs"}]`) }, true},
+		{"license without title", func(m *EntityResourceModel) { m.Licenses = types.StringValue(`[{"vendor":"Acme"}]`) }, true},
+		{"interfaces not an object", func(m *EntityResourceModel) { m.Interfaces = types.StringValue(`["http"]`) }, true},
+		{"empty type", func(m *EntityResourceModel) { m.Type = types.StringValue(" ") }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := entityConfigModel()
+			tt.modify(&m)
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := state.Set(context.Background(), &m); diags.HasError() {
+				t.Fatalf("encoding model: %v", diags)
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+			}, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("HasError = %v, want %v (%v)", resp.Diagnostics.HasError(), tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestParseEntityRef(t *testing.T) {
+	kind, id, err := ParseEntityRef("service:payments-api")
+	if err != nil || kind != "service" || id != "payments-api" {
+		t.Errorf("ParseEntityRef() = %q, %q, %v", kind, id, err)
+	}
+
+	for _, ref := range []string{"", "payments-api", ":payments-api", "service:", "service:payments api"} {
+		if _, _, err := ParseEntityRef(ref); err == nil {
+			t.Errorf("ParseEntityRef(%q) should fail", ref)
+		}
+	}
+}
+
+func TestFormatEntityRef(t *testing.T) {
+	if ref, err := FormatEntityRef("resource", "postgres-primary"); err != nil || ref != "resource:postgres-primary" {
+		t.Errorf("FormatEntityRef() = %q, %v", ref, err)
+	}
+	if _, err := FormatEntityRef("service:v2", "payments-api"); err == nil {
+		t.Error("FormatEntityRef() should reject a colon in the type")
+	}
+}
+
+func TestDecodeEntityManifest_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	m := entityConfigModel()
+	m.Tier = types.StringValue("tier1")
+	m.Owner = types.StringValue("payments")
+	m.Description = types.StringValue("Handles: card payments")
+	m.Links = types.StringValue(`[{"name":"Runbook","url":"https://runbooks.example.com/payments"}]`)
+	m.ChangelogPath = types.StringValue("CHANGELOG.md")
+
+	manifest, diags := EncodeEntityManifest(&m)
+	if diags.HasError() {
+		t.Fatalf("EncodeEntityManifest() error: %v", diags)
+	}
+	got, diags := DecodeEntityManifest(ctx, manifest)
+	if diags.HasError() {
+		t.Fatalf("DecodeEntityManifest() error: %v", diags)
+	}
+
+	for name, pair := range map[string][2]types.String{
+		"name":           {got.Name, m.Name},
+		"type":           {got.Type, m.Type},
+		"tier":           {got.Tier, m.Tier},
+		"owner":          {got.Owner, m.Owner},
+		"description":    {got.Description, m.Description},
+		"links":          {got.Links, m.Links},
+		"changelog_path": {got.ChangelogPath, m.ChangelogPath},
+	} {
+		if !pair[0].Equal(pair[1]) {
+			t.Errorf("%s = %s, want %s", name, pair[0], pair[1])
+		}
+	}
+	if !got.ID.IsNull() || !got.Relations.IsNull() {
+		t.Errorf("id and relations should be null, got %s and %s", got.ID, got.Relations)
+	}
+}
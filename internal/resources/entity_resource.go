@@ -18,8 +18,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &EntityResource{}
-	_ resource.ResourceWithImportState = &EntityResource{}
+	_ resource.Resource                   = &EntityResource{}
+	_ resource.ResourceWithImportState    = &EntityResource{}
+	_ resource.ResourceWithIdentity       = &EntityResource{}
+	_ resource.ResourceWithModifyPlan     = &EntityResource{}
+	_ resource.ResourceWithValidateConfig = &EntityResource{}
 )
 
 // EntityResource defines the resource implementation.
@@ -142,6 +145,10 @@ func (r *EntityResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+func (r *EntityResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("id", "The entity (service) ID.")
+}
+
 func (r *EntityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -159,6 +166,38 @@ func (r *EntityResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = c
 }
 
+// ValidateConfig checks the service fields and the JSON-encoded attributes
+// before the manifest is built. The manifest functions apply the same rules.
+func (r *EntityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config EntityResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateEntityModel(&config)...)
+}
+
+// ModifyPlan validates the owner team and relation targets when the provider
+// enables validate_references.
+func (r *EntityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state EntityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateChangedReference(ctx, r.client, path.Root("owner"), plan.Owner, state.Owner, referenceTeam)...)
+	resp.Diagnostics.Append(validateRelationTargets(ctx, r.client, plan.Relations, state.Relations)...)
+}
+
 func (r *EntityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Debug(ctx, "creating entity")
 
@@ -195,6 +234,7 @@ func (r *EntityResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", plan.ID)...)
 }
 
 func (r *EntityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -254,6 +294,7 @@ func (r *EntityResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", state.ID)...)
 }
 
 func (r *EntityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -273,12 +314,17 @@ func (r *EntityResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	manifest := buildManifestYAML(&plan)
 
-	updateResp, err := r.client.UpdateEntity(ctx, state.ID.ValueString(), client.CreateEntityRequest{
+	updateCtx := withStateVersion(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateResp, err := r.client.UpdateEntity(updateCtx, state.ID.ValueString(), client.CreateEntityRequest{
 		Content: manifest,
 		Source:  "terraform",
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error Updating Entity", fmt.Sprintf("Could not update entity %s: %s", state.ID.ValueString(), err))
+		addUpdateError(&resp.Diagnostics, "Error Updating Entity", fmt.Sprintf("Could not update entity %s", state.ID.ValueString()), err)
 		return
 	}
 
@@ -296,6 +342,7 @@ func (r *EntityResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", plan.ID)...)
 }
 
 func (r *EntityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -313,8 +360,26 @@ func (r *EntityResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// ImportState imports an entity by service ID. The JSON attributes are
+// written in the same form Read produces, so generated configuration plans
+// without changes.
 func (r *EntityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importID, diags := importIDFromIdentity(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity, err := r.client.GetEntity(ctx, importID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Entity", fmt.Sprintf("Could not read entity %s: %s", importID, err))
+		return
+	}
+
+	var state EntityResourceModel
+	mapEntityToState(ctx, entity, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", state.ID)...)
 }
 
 // buildManifestYAML generates the YAML manifest content from the resource model.
@@ -0,0 +1,81 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ list.ListResourceWithConfigure = &FeatureFlagListResource{}
+
+// FeatureFlagListResource lists feature flags for terraform query.
+type FeatureFlagListResource struct {
+	client *client.Client
+}
+
+// FeatureFlagListConfigModel describes the list block configuration.
+type FeatureFlagListConfigModel struct {
+	DefaultEnabled types.Bool `tfsdk:"default_enabled"`
+}
+
+// NewFeatureFlagListResource creates a new feature flag list resource.
+func NewFeatureFlagListResource() list.ListResource {
+	return &FeatureFlagListResource{}
+}
+
+func (r *FeatureFlagListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_flag"
+}
+
+func (r *FeatureFlagListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists Shoehorn feature flags.",
+		Attributes: map[string]listschema.Attribute{
+			"default_enabled": listschema.BoolAttribute{
+				Description: "Only list flags that are on (true) or off (false) by default.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *FeatureFlagListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListClient(req, resp)
+}
+
+func (r *FeatureFlagListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config FeatureFlagListConfigModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	flags, err := r.client.ListFeatureFlags(ctx)
+	if err != nil {
+		listError(stream, "Error Listing Feature Flags", fmt.Sprintf("Could not list feature flags: %s", err))
+		return
+	}
+	var matched []client.FeatureFlag
+	for _, f := range flags {
+		if config.DefaultEnabled.IsNull() || config.DefaultEnabled.ValueBool() == f.DefaultEnabled {
+			matched = append(matched, f)
+		}
+	}
+
+	stream.Results = listResults(ctx, req, matched, func(f client.FeatureFlag, result *list.ListResult) {
+		result.DisplayName = f.Name
+		result.Diagnostics.Append(setStringIdentity(ctx, result.Identity, "key", types.StringValue(f.Key))...)
+		if !req.IncludeResource {
+			return
+		}
+
+		var state FeatureFlagResourceModel
+		mapFeatureFlagToState(&f, &state)
+		result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+	})
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +17,7 @@ import (
 var (
 	_ resource.Resource                = &FeatureFlagResource{}
 	_ resource.ResourceWithImportState = &FeatureFlagResource{}
+	_ resource.ResourceWithIdentity    = &FeatureFlagResource{}
 )
 
 // FeatureFlagResource defines the resource implementation.
@@ -89,6 +89,10 @@ func (r *FeatureFlagResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *FeatureFlagResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("key", "The feature flag key.")
+}
+
 func (r *FeatureFlagResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -128,6 +132,7 @@ func (r *FeatureFlagResource) Create(ctx context.Context, req resource.CreateReq
 
 	mapFeatureFlagToState(flag, &plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "key", plan.Key)...)
 }
 
 func (r *FeatureFlagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -152,6 +157,7 @@ func (r *FeatureFlagResource) Read(ctx context.Context, req resource.ReadRequest
 
 	mapFeatureFlagToState(flag, &state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "key", state.Key)...)
 }
 
 func (r *FeatureFlagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -164,18 +170,24 @@ func (r *FeatureFlagResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	enabled := plan.DefaultEnabled.ValueBool()
-	flag, err := r.client.UpdateFeatureFlag(ctx, plan.Key.ValueString(), client.UpdateFeatureFlagRequest{
+	updateCtx := withStateVersion(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flag, err := r.client.UpdateFeatureFlag(updateCtx, plan.Key.ValueString(), client.UpdateFeatureFlagRequest{
 		Name:           plan.Name.ValueString(),
 		Description:    plan.Description.ValueString(),
 		DefaultEnabled: &enabled,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error Updating Feature Flag", fmt.Sprintf("Could not update feature flag %s: %s", plan.Key.ValueString(), err))
+		addUpdateError(&resp.Diagnostics, "Error Updating Feature Flag", fmt.Sprintf("Could not update feature flag %s", plan.Key.ValueString()), err)
 		return
 	}
 
 	mapFeatureFlagToState(flag, &plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "key", plan.Key)...)
 }
 
 func (r *FeatureFlagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -194,7 +206,22 @@ func (r *FeatureFlagResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *FeatureFlagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+	importID, diags := importIDFromIdentity(ctx, req, "key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flag, err := r.client.GetFeatureFlag(ctx, importID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Feature Flag", fmt.Sprintf("Could not read feature flag %s: %s", importID, err))
+		return
+	}
+
+	var state FeatureFlagResourceModel
+	mapFeatureFlagToState(flag, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "key", state.Key)...)
 }
 
 func mapFeatureFlagToState(flag *client.FeatureFlag, state *FeatureFlagResourceModel) {
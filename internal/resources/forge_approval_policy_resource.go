@@ -3,16 +3,23 @@ package resources
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
 )
@@ -29,13 +36,24 @@ type ForgeApprovalPolicyResource struct {
 
 // ForgeApprovalPolicyResourceModel describes the resource data model.
 type ForgeApprovalPolicyResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Enabled     types.Bool   `tfsdk:"enabled"`
-	ApprovalChain       types.List   `tfsdk:"steps"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Priority      types.Int64  `tfsdk:"priority"`
+	Match         types.Object `tfsdk:"match"`
+	ApprovalChain types.List   `tfsdk:"steps"`
+	ApproverIDs   types.Map    `tfsdk:"approver_user_ids"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+// ApprovalPolicyMatchModel describes the criteria that scope an approval policy.
+type ApprovalPolicyMatchModel struct {
+	MoldSlugs  types.List `tfsdk:"mold_slugs"`
+	Categories types.List `tfsdk:"categories"`
+	Visibility types.List `tfsdk:"visibility"`
+	Teams      types.List `tfsdk:"teams"`
 }
 
 // ApprovalStepModel describes a single step in an approval policy.
@@ -56,6 +74,21 @@ func approvalStepModelAttrTypes() map[string]attr.Type {
 	}
 }
 
+// approvalPolicyMatchAttrTypes returns the attribute types for the match nested object.
+func approvalPolicyMatchAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"mold_slugs": types.ListType{ElemType: types.StringType},
+		"categories": types.ListType{ElemType: types.StringType},
+		"visibility": types.ListType{ElemType: types.StringType},
+		"teams":      types.ListType{ElemType: types.StringType},
+	}
+}
+
+// approverRefPattern accepts prefixed references (team:<slug>, user:<id>,
+// user_email:<email>, role:<name>) and bare identifiers, which are passed
+// through unchecked.
+var approverRefPattern = regexp.MustCompile(`^((team|user|user_email|role):[^:\s]+|[^:\s]+)$`)
+
 // NewForgeApprovalPolicyResource creates a new forge approval policy resource.
 func NewForgeApprovalPolicyResource() resource.Resource {
 	return &ForgeApprovalPolicyResource{}
@@ -90,6 +123,43 @@ func (r *ForgeApprovalPolicyResource) Schema(_ context.Context, _ resource.Schem
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"priority": schema.Int64Attribute{
+				Description: "The priority of the policy. When several enabled policies match a forge run, the one with the highest priority governs it.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"match": schema.SingleNestedAttribute{
+				Description: "Criteria that scope which forge runs the policy applies to. Omitted or empty criteria match everything; all non-empty criteria must match.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"mold_slugs": schema.ListAttribute{
+						Description: "Mold slugs the policy applies to.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"categories": schema.ListAttribute{
+						Description: "Mold categories the policy applies to.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"visibility": schema.ListAttribute{
+						Description: "Mold visibilities the policy applies to. Valid values: public, tenant, private.",
+						Optional:    true,
+						ElementType: types.StringType,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.OneOf("public", "tenant", "private")),
+						},
+					},
+					"teams": schema.ListAttribute{
+						Description: "Slugs of the requesting teams the policy applies to.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
 			"steps": schema.ListNestedAttribute{
 				Description: "The approval chain steps in the policy workflow.",
 				Required:    true,
@@ -104,9 +174,15 @@ func (r *ForgeApprovalPolicyResource) Schema(_ context.Context, _ resource.Schem
 							Optional:    true,
 						},
 						"approvers": schema.ListAttribute{
-							Description: "The list of approver identifiers for this step.",
+							Description: "The list of approvers for this step. References of the form team:<slug>, user:<id> and role:<name> are validated against the directory at apply time. user_email:<email> references are resolved to user:<id>.",
 							Required:    true,
 							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(stringvalidator.RegexMatches(
+									approverRefPattern,
+									"must be team:<slug>, user:<id>, user_email:<email>, role:<name> or a bare approver identifier",
+								)),
+							},
 						},
 						"required_count": schema.Int64Attribute{
 							Description: "Number of approvers required. 0 means all must approve.",
@@ -116,6 +192,11 @@ func (r *ForgeApprovalPolicyResource) Schema(_ context.Context, _ resource.Schem
 					},
 				},
 			},
+			"approver_user_ids": schema.MapAttribute{
+				Description: "Directory user IDs resolved for user_email:<email> approvers, keyed by email. If an email later belongs to a different user, the approver is reported as drift.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"created_at": schema.StringAttribute{
 				Description: "The creation timestamp.",
 				Computed:    true,
@@ -163,14 +244,37 @@ func (r *ForgeApprovalPolicyResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	configured := steps
+	approverIDs, diags := resolveUserEmails(ctx, r.client, path.Root("steps"), approverEmails(steps))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	steps = withApproverIDs(steps, approverIDs)
+
+	resp.Diagnostics.Append(validateApproverRefs(ctx, r.client, steps)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	match, diags := expandApprovalPolicyMatch(ctx, plan.Match)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createReq := client.CreateApprovalPolicyRequest{
-		Name:    plan.Name.ValueString(),
-		Enabled: plan.Enabled.ValueBool(),
-		ApprovalChain:   steps,
+		Name:          plan.Name.ValueString(),
+		Enabled:       plan.Enabled.ValueBool(),
+		ApprovalChain: steps,
+		Match:         match,
 	}
 	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
 		createReq.Description = plan.Description.ValueString()
 	}
+	if !plan.Priority.IsNull() && !plan.Priority.IsUnknown() {
+		createReq.Priority = int(plan.Priority.ValueInt64())
+	}
 
 	policy, err := r.client.CreateApprovalPolicy(ctx, createReq)
 	if err != nil {
@@ -178,6 +282,8 @@ func (r *ForgeApprovalPolicyResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	plan.ApproverIDs, diags = userIDsMapValue(ctx, restoreApproverEmails(policy.ApprovalChain, configured, approverIDs))
+	resp.Diagnostics.Append(diags...)
 	diags = mapApprovalPolicyToState(ctx, policy, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -207,7 +313,23 @@ func (r *ForgeApprovalPolicyResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	diags := mapApprovalPolicyToState(ctx, policy, &state)
+	// Keep user_email approvers from state while their email still resolves
+	// to the approver the API reports; otherwise the user:<id> is shown as drift.
+	configured, diags := expandApprovalApprovalChain(ctx, state.ApprovalChain)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	approverIDs, err := currentUserIDs(ctx, r.client, approverEmails(configured))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Forge Approval Policy", fmt.Sprintf("Could not resolve approver emails: %s", err))
+		return
+	}
+	approverIDs = restoreApproverEmails(policy.ApprovalChain, configured, approverIDs)
+	state.ApproverIDs, diags = userIDsMapValue(ctx, approverIDs)
+	resp.Diagnostics.Append(diags...)
+
+	diags = mapApprovalPolicyToState(ctx, policy, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -231,22 +353,57 @@ func (r *ForgeApprovalPolicyResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	configured := steps
+	approverIDs, diags := resolveUserEmails(ctx, r.client, path.Root("steps"), approverEmails(steps))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	steps = withApproverIDs(steps, approverIDs)
+
+	resp.Diagnostics.Append(validateApproverRefs(ctx, r.client, steps)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	match, diags := expandApprovalPolicyMatch(ctx, plan.Match)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Send an empty match to clear criteria removed from the configuration.
+	if match == nil {
+		match = &client.ApprovalPolicyMatch{}
+	}
+
 	enabled := plan.Enabled.ValueBool()
 	updateReq := client.UpdateApprovalPolicyRequest{
-		Name:    plan.Name.ValueString(),
-		Enabled: &enabled,
-		ApprovalChain:   steps,
+		Name:          plan.Name.ValueString(),
+		Enabled:       &enabled,
+		ApprovalChain: steps,
+		Match:         match,
 	}
 	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
 		updateReq.Description = plan.Description.ValueString()
 	}
+	if !plan.Priority.IsNull() && !plan.Priority.IsUnknown() {
+		priority := int(plan.Priority.ValueInt64())
+		updateReq.Priority = &priority
+	}
+
+	updateCtx := withStateVersion(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	policy, err := r.client.UpdateApprovalPolicy(ctx, plan.ID.ValueString(), updateReq)
+	policy, err := r.client.UpdateApprovalPolicy(updateCtx, plan.ID.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error Updating Forge Approval Policy", fmt.Sprintf("Could not update approval policy: %s", err))
+		addUpdateError(&resp.Diagnostics, "Error Updating Forge Approval Policy", "Could not update approval policy", err)
 		return
 	}
 
+	plan.ApproverIDs, diags = userIDsMapValue(ctx, restoreApproverEmails(policy.ApprovalChain, configured, approverIDs))
+	resp.Diagnostics.Append(diags...)
 	diags = mapApprovalPolicyToState(ctx, policy, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -275,8 +432,21 @@ func (r *ForgeApprovalPolicyResource) Delete(ctx context.Context, req resource.D
 	}
 }
 
+// ImportState imports a policy by ID. Approvers are imported in the user:<id>
+// form the API reports.
 func (r *ForgeApprovalPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	policy, err := r.client.GetApprovalPolicy(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Forge Approval Policy", fmt.Sprintf("Could not read approval policy %s: %s", req.ID, err))
+		return
+	}
+
+	state := ForgeApprovalPolicyResourceModel{ApproverIDs: types.MapNull(types.StringType)}
+	resp.Diagnostics.Append(mapApprovalPolicyToState(ctx, policy, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 // expandApprovalApprovalChain converts the Terraform list of steps into client ApprovalStep structs.
@@ -320,6 +490,7 @@ func mapApprovalPolicyToState(ctx context.Context, policy *client.ForgeApprovalP
 	state.Name = types.StringValue(policy.Name)
 	state.Description = stringValueOrNull(policy.Description)
 	state.Enabled = types.BoolValue(policy.Enabled)
+	state.Priority = types.Int64Value(int64(policy.Priority))
 	state.CreatedAt = stringValueOrNull(policy.CreatedAt)
 	state.UpdatedAt = stringValueOrNull(policy.UpdatedAt)
 
@@ -354,5 +525,204 @@ func mapApprovalPolicyToState(ctx context.Context, policy *client.ForgeApprovalP
 	diags.Append(d...)
 	state.ApprovalChain = stepsList
 
+	matchObj, d := flattenApprovalPolicyMatch(ctx, policy.Match, state.Match)
+	diags.Append(d...)
+	state.Match = matchObj
+
+	return diags
+}
+
+// expandApprovalPolicyMatch converts the Terraform match object into the client
+// representation. A null match yields nil so the policy applies to every run.
+func expandApprovalPolicyMatch(ctx context.Context, obj types.Object) (*client.ApprovalPolicyMatch, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, diags
+	}
+
+	var m ApprovalPolicyMatchModel
+	diags.Append(obj.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	match := &client.ApprovalPolicyMatch{}
+	for _, f := range []struct {
+		list types.List
+		dst  *[]string
+	}{
+		{m.MoldSlugs, &match.MoldSlugs},
+		{m.Categories, &match.Categories},
+		{m.Visibility, &match.Visibility},
+		{m.Teams, &match.Teams},
+	} {
+		if f.list.IsNull() || f.list.IsUnknown() {
+			continue
+		}
+		diags.Append(f.list.ElementsAs(ctx, f.dst, false)...)
+	}
+
+	return match, diags
+}
+
+// flattenApprovalPolicyMatch converts the client match criteria into a Terraform
+// object. A policy without criteria maps to a null object unless prior holds
+// an empty match, and criteria the API returns empty keep an empty list from
+// prior, since omitted and empty criteria both match everything.
+func flattenApprovalPolicyMatch(ctx context.Context, match *client.ApprovalPolicyMatch, prior types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if match == nil {
+		match = &client.ApprovalPolicyMatch{}
+	}
+
+	var priorMatch ApprovalPolicyMatchModel
+	hasPrior := !prior.IsNull() && !prior.IsUnknown()
+	if hasPrior {
+		diags.Append(prior.As(ctx, &priorMatch, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return types.ObjectNull(approvalPolicyMatchAttrTypes()), diags
+		}
+	}
+
+	if !hasPrior && len(match.MoldSlugs) == 0 && len(match.Categories) == 0 &&
+		len(match.Visibility) == 0 && len(match.Teams) == 0 {
+		return types.ObjectNull(approvalPolicyMatchAttrTypes()), diags
+	}
+
+	toList := func(values []string, prior types.List) types.List {
+		if len(values) == 0 {
+			if !prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+				return prior
+			}
+			return types.ListNull(types.StringType)
+		}
+		l, d := types.ListValueFrom(ctx, types.StringType, values)
+		diags.Append(d...)
+		return l
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, approvalPolicyMatchAttrTypes(), ApprovalPolicyMatchModel{
+		MoldSlugs:  toList(match.MoldSlugs, priorMatch.MoldSlugs),
+		Categories: toList(match.Categories, priorMatch.Categories),
+		Visibility: toList(match.Visibility, priorMatch.Visibility),
+		Teams:      toList(match.Teams, priorMatch.Teams),
+	})
+	diags.Append(d...)
+	return obj, diags
+}
+
+// approverEmails returns the emails of the user_email:<email> approvers in steps.
+func approverEmails(steps []client.ApprovalStep) []string {
+	var emails []string
+	for _, step := range steps {
+		for _, approver := range step.Approvers {
+			if kind, value := parseApproverRef(approver); kind == "user_email" {
+				emails = append(emails, value)
+			}
+		}
+	}
+	return emails
+}
+
+// withApproverIDs returns a copy of steps in which each user_email:<email>
+// approver with a resolved ID is replaced by user:<id>.
+func withApproverIDs(steps []client.ApprovalStep, ids map[string]string) []client.ApprovalStep {
+	if len(ids) == 0 {
+		return steps
+	}
+	resolved := make([]client.ApprovalStep, len(steps))
+	for i, step := range steps {
+		resolved[i] = step
+		resolved[i].Approvers = make([]string, len(step.Approvers))
+		for j, approver := range step.Approvers {
+			resolved[i].Approvers[j] = approver
+			if kind, value := parseApproverRef(approver); kind == "user_email" && ids[value] != "" {
+				resolved[i].Approvers[j] = "user:" + ids[value]
+			}
+		}
+	}
+	return resolved
+}
+
+// restoreApproverEmails rewrites user:<id> approvers in chain back to the
+// user_email:<email> reference configured at the same position, provided the
+// email resolves to that ID. It returns the email to ID mappings it restored.
+func restoreApproverEmails(chain, configured []client.ApprovalStep, ids map[string]string) map[string]string {
+	restored := make(map[string]string)
+	for i := range chain {
+		if i >= len(configured) {
+			break
+		}
+		for j, approver := range chain[i].Approvers {
+			if j >= len(configured[i].Approvers) {
+				break
+			}
+			kind, email := parseApproverRef(configured[i].Approvers[j])
+			if kind == "user_email" && ids[email] != "" && approver == "user:"+ids[email] {
+				chain[i].Approvers[j] = configured[i].Approvers[j]
+				restored[email] = ids[email]
+			}
+		}
+	}
+	return restored
+}
+
+// parseApproverRef splits an approver reference into its kind and value.
+// Bare identifiers without a known prefix return an empty kind.
+func parseApproverRef(ref string) (kind, value string) {
+	prefix, rest, ok := strings.Cut(ref, ":")
+	if !ok {
+		return "", ref
+	}
+	switch prefix {
+	case "team", "user", "user_email", "role":
+		return prefix, rest
+	}
+	return "", ref
+}
+
+// validateApproverRefs checks that every prefixed approver reference in the
+// chain resolves to an existing team, directory user or role, using the same
+// definitions as plan-time reference validation. Lookups are only performed
+// for the kinds of references actually present.
+func validateApproverRefs(ctx context.Context, c *client.Client, steps []client.ApprovalStep) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	refs := c.References
+	if refs == nil {
+		refs = client.NewReferences(c)
+	}
+
+	for i, step := range steps {
+		for j, approver := range step.Approvers {
+			kind, value := parseApproverRef(approver)
+
+			var found bool
+			var err error
+			switch kind {
+			case "team":
+				found, err = refs.HasTeam(ctx, value)
+			case "user":
+				found, err = refs.HasUser(ctx, value)
+			case "role":
+				found, err = refs.HasRole(ctx, value)
+			default:
+				continue
+			}
+			if err != nil {
+				diags.AddError("Error Validating Approvers", fmt.Sprintf("Could not look up %s approvers: %s", kind, err))
+				return diags
+			}
+
+			if !found {
+				diags.AddAttributeError(
+					path.Root("steps").AtListIndex(i).AtName("approvers").AtListIndex(j),
+					"Unknown Approver",
+					fmt.Sprintf("Approver %q in step %q does not match any existing %s.", approver, step.Name, kind),
+				)
+			}
+		}
+	}
+
 	return diags
 }
@@ -2,11 +2,18 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
 )
 
 func TestForgeApprovalPolicyResource_Metadata(t *testing.T) {
@@ -26,7 +33,7 @@ func TestForgeApprovalPolicyResource_Schema_HasRequiredAttributes(t *testing.T)
 
 	attrs := resp.Schema.Attributes
 	expectedAttrs := []string{
-		"id", "name", "description", "enabled", "steps", "created_at", "updated_at",
+		"id", "name", "description", "enabled", "priority", "match", "steps", "approver_user_ids", "created_at", "updated_at",
 	}
 	for _, name := range expectedAttrs {
 		if _, ok := attrs[name]; !ok {
@@ -186,3 +193,258 @@ func TestMapApprovalPolicyToState_EmptyOptionalFields(t *testing.T) {
 		t.Errorf("Enabled = %v, want %v", state.Enabled.ValueBool(), false)
 	}
 }
+
+func TestMapApprovalPolicyToState_WithMatch(t *testing.T) {
+	policy := &client.ForgeApprovalPolicy{
+		ID:       "pol-789",
+		Name:     "Backend Services",
+		Enabled:  true,
+		Priority: 10,
+		Match: &client.ApprovalPolicyMatch{
+			MoldSlugs:  []string{"go-service"},
+			Categories: []string{"backend"},
+		},
+		ApprovalChain: []client.ApprovalStep{
+			{Name: "Lead", Approvers: []string{"team:platform"}},
+		},
+	}
+
+	state := &ForgeApprovalPolicyResourceModel{}
+	diags := mapApprovalPolicyToState(context.Background(), policy, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if state.Priority.ValueInt64() != 10 {
+		t.Errorf("Priority = %d, want 10", state.Priority.ValueInt64())
+	}
+	if state.Match.IsNull() {
+		t.Fatal("Match should not be null")
+	}
+
+	match, diags := expandApprovalPolicyMatch(context.Background(), state.Match)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(match.MoldSlugs) != 1 || match.MoldSlugs[0] != "go-service" {
+		t.Errorf("MoldSlugs = %v, want [go-service]", match.MoldSlugs)
+	}
+	if len(match.Categories) != 1 || match.Categories[0] != "backend" {
+		t.Errorf("Categories = %v, want [backend]", match.Categories)
+	}
+	if match.Teams != nil {
+		t.Errorf("Teams = %v, want nil", match.Teams)
+	}
+}
+
+func TestMapApprovalPolicyToState_NoMatchIsNull(t *testing.T) {
+	policy := &client.ForgeApprovalPolicy{
+		ID:    "pol-1",
+		Name:  "Everything",
+		Match: &client.ApprovalPolicyMatch{},
+	}
+
+	state := &ForgeApprovalPolicyResourceModel{}
+	diags := mapApprovalPolicyToState(context.Background(), policy, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !state.Match.IsNull() {
+		t.Error("Match should be null when the policy has no criteria")
+	}
+}
+
+func TestParseApproverRef(t *testing.T) {
+	tests := []struct {
+		ref       string
+		wantKind  string
+		wantValue string
+	}{
+		{ref: "team:platform", wantKind: "team", wantValue: "platform"},
+		{ref: "user:u-123", wantKind: "user", wantValue: "u-123"},
+		{ref: "role:admin", wantKind: "role", wantValue: "admin"},
+		{ref: "user_email:ada@example.com", wantKind: "user_email", wantValue: "ada@example.com"},
+		{ref: "sec-team", wantKind: "", wantValue: "sec-team"},
+		{ref: "group:eng", wantKind: "", wantValue: "group:eng"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			kind, value := parseApproverRef(tt.ref)
+			if kind != tt.wantKind || value != tt.wantValue {
+				t.Errorf("parseApproverRef(%q) = (%q, %q), want (%q, %q)", tt.ref, kind, value, tt.wantKind, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestApproverRefPattern(t *testing.T) {
+	valid := []string{"team:platform", "user:u-1", "user_email:ada@example.com", "role:admin", "sec-team"}
+	for _, v := range valid {
+		if !approverRefPattern.MatchString(v) {
+			t.Errorf("approverRefPattern should accept %q", v)
+		}
+	}
+
+	invalid := []string{"group:eng", "team:", "team:a:b", "has space"}
+	for _, v := range invalid {
+		if approverRefPattern.MatchString(v) {
+			t.Errorf("approverRefPattern should reject %q", v)
+		}
+	}
+}
+
+func TestValidateApproverRefs(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/api/v1/admin/teams":
+			json.NewEncoder(w).Encode(map[string]any{"teams": []map[string]any{{"id": "t-1", "name": "Platform", "slug": "platform"}}})
+		case "/api/v1/users":
+			json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{{"id": "u-1", "email": "alice@example.com"}}})
+		case "/api/v1/roles":
+			json.NewEncoder(w).Encode(map[string]any{"roles": []map[string]any{{"user_id": "u-1", "role": "admin"}}})
+		case "/api/v1/groups":
+			json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{}})
+		case "/api/v1/admin/bundles":
+			json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{}})
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "key", 30*time.Second)
+
+	steps := []client.ApprovalStep{
+		{Name: "Lead", Approvers: []string{"team:platform", "user:u-1", "legacy-id"}},
+		{Name: "Security", Approvers: []string{"role:admin", "team:missing", "user:u-404"}},
+	}
+
+	diags := validateApproverRefs(context.Background(), c, steps)
+	if got := diags.ErrorsCount(); got != 2 {
+		t.Fatalf("error count = %d, want 2: %v", got, diags)
+	}
+	if len(calls) != 5 {
+		t.Errorf("API calls = %v, want each directory listed once", calls)
+	}
+}
+
+func TestValidateApproverRefs_BundleRole(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/api/v1/roles":
+			json.NewEncoder(w).Encode(map[string]any{"roles": []map[string]any{}})
+		case "/api/v1/groups":
+			json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{}})
+		case "/api/v1/admin/bundles":
+			json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{{"name": "release-manager"}}})
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "key", 30*time.Second)
+	steps := []client.ApprovalStep{{Name: "Release", Approvers: []string{"role:release-manager"}}}
+
+	if diags := validateApproverRefs(context.Background(), c, steps); diags.HasError() {
+		t.Errorf("a role defined only as a bundle should be a known approver: %v", diags)
+	}
+}
+
+func TestValidateApproverRefs_BareIdentifiersSkipLookups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "key", 30*time.Second)
+	steps := []client.ApprovalStep{{Name: "Approval", Approvers: []string{"admin", "sec-team"}}}
+
+	if diags := validateApproverRefs(context.Background(), c, steps); diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestApproverEmails_ResolveAndRestore(t *testing.T) {
+	configured := []client.ApprovalStep{
+		{Name: "Lead", Approvers: []string{"team:platform", "user_email:ada@example.com"}},
+		{Name: "Security", Approvers: []string{"user_email:grace@example.com"}},
+	}
+	if got := approverEmails(configured); len(got) != 2 || got[0] != "ada@example.com" || got[1] != "grace@example.com" {
+		t.Fatalf("approverEmails() = %v", got)
+	}
+
+	ids := map[string]string{"ada@example.com": "u-1", "grace@example.com": "u-2"}
+	resolved := withApproverIDs(configured, ids)
+	if resolved[0].Approvers[1] != "user:u-1" || resolved[1].Approvers[0] != "user:u-2" {
+		t.Errorf("withApproverIDs() = %+v", resolved)
+	}
+	if configured[0].Approvers[1] != "user_email:ada@example.com" {
+		t.Error("withApproverIDs() must not modify its input")
+	}
+
+	// grace@example.com now resolves to a different user than the API reports.
+	chain := withApproverIDs(configured, ids)
+	restored := restoreApproverEmails(chain, configured, map[string]string{"ada@example.com": "u-1", "grace@example.com": "u-3"})
+	if chain[0].Approvers[1] != "user_email:ada@example.com" {
+		t.Errorf("approver = %q, want the configured email reference", chain[0].Approvers[1])
+	}
+	if chain[1].Approvers[0] != "user:u-2" {
+		t.Errorf("approver = %q, want the remapped email reported as user:u-2", chain[1].Approvers[0])
+	}
+	if len(restored) != 1 || restored["ada@example.com"] != "u-1" {
+		t.Errorf("restored = %v, want only ada@example.com", restored)
+	}
+}
+
+func TestForgeApprovalPolicyResource_Create_EmptyMatch(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewForgeApprovalPolicyResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	approvers, _ := types.ListValueFrom(ctx, types.StringType, []string{"sec-team"})
+	step, _ := types.ObjectValue(approvalStepModelAttrTypes(), map[string]attr.Value{
+		"name":           types.StringValue("Security"),
+		"description":    types.StringNull(),
+		"approvers":      approvers,
+		"required_count": types.Int64Value(1),
+	})
+	steps, _ := types.ListValue(types.ObjectType{AttrTypes: approvalStepModelAttrTypes()}, []attr.Value{step})
+	emptyList, _ := types.ListValue(types.StringType, []attr.Value{})
+	nullList := types.ListNull(types.StringType)
+
+	for name, match := range map[string]map[string]attr.Value{
+		"empty object":     {"mold_slugs": nullList, "categories": nullList, "visibility": nullList, "teams": nullList},
+		"empty mold_slugs": {"mold_slugs": emptyList, "categories": nullList, "visibility": nullList, "teams": nullList},
+	} {
+		t.Run(name, func(t *testing.T) {
+			api := fakeapi.NewServer()
+			t.Cleanup(api.Close)
+			r := &ForgeApprovalPolicyResource{client: api.Client()}
+
+			matchObj, _ := types.ObjectValue(approvalPolicyMatchAttrTypes(), match)
+			model := ForgeApprovalPolicyResourceModel{
+				ID: types.StringUnknown(), Name: types.StringValue("Everything"), Description: types.StringNull(),
+				Enabled: types.BoolValue(true), Priority: types.Int64Value(0), Match: matchObj, ApprovalChain: steps,
+				ApproverIDs: types.MapUnknown(types.StringType), CreatedAt: types.StringUnknown(), UpdatedAt: types.StringUnknown(),
+			}
+			plan := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := plan.Set(ctx, &model); diags.HasError() {
+				t.Fatalf("encoding plan: %v", diags)
+			}
+
+			createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, createResp)
+			if createResp.Diagnostics.HasError() {
+				t.Fatalf("Create() error = %v", createResp.Diagnostics)
+			}
+
+			var created ForgeApprovalPolicyResourceModel
+			createResp.State.Get(ctx, &created)
+			if !created.Match.Equal(matchObj) {
+				t.Errorf("Match = %v, want planned %v", created.Match, matchObj)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ list.ListResourceWithConfigure = &ForgeMoldListResource{}
+
+// ForgeMoldListResource lists forge molds for terraform query.
+type ForgeMoldListResource struct {
+	client *client.Client
+}
+
+// ForgeMoldListConfigModel describes the list block configuration.
+type ForgeMoldListConfigModel struct {
+	Category   types.String `tfsdk:"category"`
+	Visibility types.String `tfsdk:"visibility"`
+}
+
+// NewForgeMoldListResource creates a new forge mold list resource.
+func NewForgeMoldListResource() list.ListResource {
+	return &ForgeMoldListResource{}
+}
+
+func (r *ForgeMoldListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_mold"
+}
+
+func (r *ForgeMoldListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists Shoehorn forge molds.",
+		Attributes: map[string]listschema.Attribute{
+			"category": listschema.StringAttribute{
+				Description: "Only list molds in this category.",
+				Optional:    true,
+			},
+			"visibility": listschema.StringAttribute{
+				Description: "Only list molds with this visibility, e.g. public.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *ForgeMoldListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListClient(req, resp)
+}
+
+func (r *ForgeMoldListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ForgeMoldListConfigModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	molds, err := r.client.ListForgeMolds(ctx)
+	if err != nil {
+		listError(stream, "Error Listing Forge Molds", fmt.Sprintf("Could not list forge molds: %s", err))
+		return
+	}
+	var matched []client.ForgeMold
+	for _, m := range molds {
+		if matchesFilter(config.Category, m.Category) && matchesFilter(config.Visibility, m.Visibility) {
+			matched = append(matched, m)
+		}
+	}
+
+	stream.Results = listResults(ctx, req, matched, func(m client.ForgeMold, result *list.ListResult) {
+		result.DisplayName = m.Name
+		result.Diagnostics.Append(setStringIdentity(ctx, result.Identity, "slug", types.StringValue(m.Slug))...)
+		if !req.IncludeResource {
+			return
+		}
+
+		// The list omits the schema and defaults.
+		mold, err := r.client.GetForgeMold(ctx, m.Slug)
+		if err != nil {
+			result.Diagnostics.AddError("Error Reading Forge Mold", fmt.Sprintf("Could not read forge mold %s: %s", m.Slug, err))
+			return
+		}
+		var state ForgeMoldResourceModel
+		mapForgeMoldToState(mold, &state)
+		result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+	})
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -22,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &ForgeMoldResource{}
 	_ resource.ResourceWithImportState = &ForgeMoldResource{}
+	_ resource.ResourceWithIdentity    = &ForgeMoldResource{}
 )
 
 // ForgeMoldResource defines the resource implementation.
@@ -180,6 +180,10 @@ func (r *ForgeMoldResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *ForgeMoldResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("slug", "The forge mold slug.")
+}
+
 func (r *ForgeMoldResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -274,6 +278,7 @@ func (r *ForgeMoldResource) Create(ctx context.Context, req resource.CreateReque
 
 	mapForgeMoldToState(mold, &plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "slug", plan.Slug)...)
 }
 
 func (r *ForgeMoldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -298,6 +303,7 @@ func (r *ForgeMoldResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	mapForgeMoldToState(mold, &state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "slug", state.Slug)...)
 }
 
 func (r *ForgeMoldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -349,14 +355,20 @@ func (r *ForgeMoldResource) Update(ctx context.Context, req resource.UpdateReque
 		updateReq.Defaults = defaultsMap
 	}
 
-	mold, err := r.client.UpdateForgeMold(ctx, plan.Slug.ValueString(), updateReq)
+	updateCtx := withStateVersion(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mold, err := r.client.UpdateForgeMold(updateCtx, plan.Slug.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error Updating Forge Mold", fmt.Sprintf("Could not update forge mold %s: %s", plan.Slug.ValueString(), err))
+		addUpdateError(&resp.Diagnostics, "Error Updating Forge Mold", fmt.Sprintf("Could not update forge mold %s", plan.Slug.ValueString()), err)
 		return
 	}
 
 	mapForgeMoldToState(mold, &plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "slug", plan.Slug)...)
 }
 
 func (r *ForgeMoldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -378,7 +390,22 @@ func (r *ForgeMoldResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *ForgeMoldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("slug"), req, resp)
+	importID, diags := importIDFromIdentity(ctx, req, "slug")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mold, err := r.client.GetForgeMold(ctx, importID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Forge Mold", fmt.Sprintf("Could not read forge mold %s: %s", importID, err))
+		return
+	}
+
+	var state ForgeMoldResourceModel
+	mapForgeMoldToState(mold, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "slug", state.Slug)...)
 }
 
 // mapForgeMoldToState maps a client ForgeMold to the Terraform resource model.
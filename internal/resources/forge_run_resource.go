@@ -0,0 +1,431 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+// defaultForgeRunCreateTimeout bounds how long Create waits for approvals and
+// for the run to complete when no timeouts block is configured.
+const defaultForgeRunCreateTimeout = 30 * time.Minute
+
+var (
+	_ resource.Resource                = &ForgeRunResource{}
+	_ resource.ResourceWithImportState = &ForgeRunResource{}
+)
+
+// ForgeRunResource defines the resource implementation.
+type ForgeRunResource struct {
+	client *client.Client
+}
+
+// ForgeRunResourceModel describes the resource data model.
+type ForgeRunResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	Mold             types.String   `tfsdk:"mold"`
+	Version          types.String   `tfsdk:"version"`
+	Team             types.String   `tfsdk:"team"`
+	Inputs           types.Map      `tfsdk:"inputs"`
+	Status           types.String   `tfsdk:"status"`
+	ApprovalPolicyID types.String   `tfsdk:"approval_policy_id"`
+	Outputs          types.Map      `tfsdk:"outputs"`
+	RepoURL          types.String   `tfsdk:"repo_url"`
+	EntityID         types.String   `tfsdk:"entity_id"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	CompletedAt      types.String   `tfsdk:"completed_at"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewForgeRunResource creates a new forge run resource.
+func NewForgeRunResource() resource.Resource {
+	return &ForgeRunResource{}
+}
+
+func (r *ForgeRunResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forge_run"
+}
+
+func (r *ForgeRunResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Triggers a run of a Shoehorn Forge mold and waits for it to complete. " +
+			"Changing any argument triggers a new run. Destroying the resource cancels an unfinished run " +
+			"but does not remove anything the run provisioned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the run.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mold": schema.StringAttribute{
+				Description: "The slug of the mold to run.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "The mold version to run. Defaults to the mold's current version.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team": schema.StringAttribute{
+				Description: "The slug of the team requesting the run. Used to select the governing approval policy.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"inputs": schema.MapAttribute{
+				Description: "Input values for the mold. Values are converted to the types declared in the mold schema; " +
+					"array and object inputs must be JSON-encoded. Inputs are validated against the schema before the run is submitted.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the run.",
+				Computed:    true,
+			},
+			"approval_policy_id": schema.StringAttribute{
+				Description: "The ID of the approval policy that governed the run, if any.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"outputs": schema.MapAttribute{
+				Description: "Outputs reported by the run. Non-string values are JSON-encoded.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"repo_url": schema.StringAttribute{
+				Description: "The URL of the repository created by the run, if any.",
+				Computed:    true,
+			},
+			"entity_id": schema.StringAttribute{
+				Description: "The ID of the catalog entity registered by the run, if any.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The creation timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"completed_at": schema.StringAttribute{
+				Description: "The completion timestamp.",
+				Computed:    true,
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *ForgeRunResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *ForgeRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "creating forge run")
+
+	var plan ForgeRunResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultForgeRunCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slug := plan.Mold.ValueString()
+	mold, err := r.client.GetForgeMold(ctx, slug)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Forge Mold", fmt.Sprintf("Could not read forge mold %s: %s", slug, err))
+		return
+	}
+
+	version := mold.Version
+	if !plan.Version.IsNull() && !plan.Version.IsUnknown() {
+		version = plan.Version.ValueString()
+		if version != mold.Version {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("version"),
+				"Mold Version Not Available",
+				fmt.Sprintf("Forge mold %s is at version %s, not %s.", slug, mold.Version, version),
+			)
+			return
+		}
+	}
+
+	rawInputs := map[string]string{}
+	if !plan.Inputs.IsNull() && !plan.Inputs.IsUnknown() {
+		resp.Diagnostics.Append(plan.Inputs.ElementsAs(ctx, &rawInputs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	inputs, problems := coerceToSchema(mold.Schema, rawInputs)
+	if len(problems) == 0 {
+		// Defaults fill in inputs the mold declares but the configuration omits.
+		withDefaults := make(map[string]interface{}, len(mold.Defaults)+len(inputs))
+		for k, v := range mold.Defaults {
+			withDefaults[k] = v
+		}
+		for k, v := range inputs {
+			withDefaults[k] = v
+		}
+		problems = validateAgainstSchema(mold.Schema, withDefaults, "inputs")
+	}
+	if len(problems) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("inputs"),
+			"Invalid Forge Run Inputs",
+			fmt.Sprintf("Inputs do not match the schema of forge mold %s:\n  - %s", slug, strings.Join(problems, "\n  - ")),
+		)
+		return
+	}
+
+	team := plan.Team.ValueString()
+	policies, err := r.client.ListApprovalPolicies(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Forge Approval Policies", fmt.Sprintf("Could not list approval policies: %s", err))
+		return
+	}
+	if policy := client.ResolveApprovalPolicy(policies, mold, team); policy != nil {
+		tflog.Info(ctx, "forge run requires approval", map[string]any{"mold": slug, "policy": policy.Name})
+	}
+
+	run, err := r.client.CreateForgeRun(ctx, client.CreateForgeRunRequest{
+		MoldSlug: slug,
+		Version:  version,
+		Team:     team,
+		Inputs:   inputs,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Forge Run", fmt.Sprintf("Could not trigger forge mold %s: %s", slug, err))
+		return
+	}
+
+	// Save partial state so Terraform tracks the run even if waiting fails
+	resp.Diagnostics.Append(mapForgeRunToState(ctx, run, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	err = waitFor(waitCtx, func(ctx context.Context) (bool, error) {
+		current, err := r.client.GetForgeRun(ctx, run.ID)
+		if err != nil {
+			return false, err
+		}
+		if current.Status != run.Status {
+			tflog.Debug(ctx, "forge run status changed", map[string]any{"id": run.ID, "status": current.Status})
+		}
+		run = current
+		return run.IsTerminal(), nil
+	})
+
+	resp.Diagnostics.Append(mapForgeRunToState(ctx, run, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		resp.Diagnostics.AddError(
+			"Timeout Waiting For Forge Run",
+			fmt.Sprintf("Forge run %s did not complete within %s; last observed status: %s.", run.ID, createTimeout, run.Status),
+		)
+	case err != nil:
+		resp.Diagnostics.AddError("Error Waiting For Forge Run", fmt.Sprintf("Could not poll forge run %s: %s", run.ID, err))
+	case run.Status != client.ForgeRunStatusSucceeded:
+		detail := fmt.Sprintf("Forge run %s finished with status %s.", run.ID, run.Status)
+		if run.Error != "" {
+			detail += " " + run.Error
+		}
+		resp.Diagnostics.AddError("Forge Run Did Not Succeed", detail)
+	}
+}
+
+func (r *ForgeRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "reading forge run")
+
+	var state ForgeRunResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	run, err := r.client.GetForgeRun(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "forge run not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Forge Run", fmt.Sprintf("Could not read forge run %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(mapForgeRunToState(ctx, run, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only persists changes to timeouts; every other argument forces a new run.
+func (r *ForgeRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ForgeRunResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ForgeRunResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ForgeRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "deleting forge run")
+
+	var state ForgeRunResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	run, err := r.client.GetForgeRun(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Forge Run", fmt.Sprintf("Could not read forge run %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	// Finished runs are historical records; only unfinished runs are cancelled.
+	if run.IsTerminal() {
+		return
+	}
+
+	if err := r.client.CancelForgeRun(ctx, run.ID); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error Cancelling Forge Run", fmt.Sprintf("Could not cancel forge run %s: %s", run.ID, err))
+	}
+}
+
+func (r *ForgeRunResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	run, err := r.client.GetForgeRun(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Forge Run", fmt.Sprintf("Could not read forge run %s: %s", req.ID, err))
+		return
+	}
+
+	state := ForgeRunResourceModel{Timeouts: nullTimeouts("create")}
+	resp.Diagnostics.Append(mapForgeRunToState(ctx, run, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// mapForgeRunToState maps a client ForgeRun to the Terraform resource model.
+// Inputs are preserved from the plan/state because the API returns them in
+// their coerced form; they are only populated from the API on import.
+func mapForgeRunToState(ctx context.Context, run *client.ForgeRun, state *ForgeRunResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(run.ID)
+	state.Mold = types.StringValue(run.MoldSlug)
+	state.Version = preserveOrNull(run.MoldVersion, state.Version)
+	state.Team = preserveOrNull(run.Team, state.Team)
+	state.Status = types.StringValue(run.Status)
+	state.ApprovalPolicyID = stringValueOrNull(run.ApprovalPolicyID)
+	state.CreatedAt = stringValueOrNull(run.CreatedAt)
+	state.CompletedAt = stringValueOrNull(run.CompletedAt)
+
+	if state.Inputs.IsNull() || state.Inputs.IsUnknown() {
+		if len(run.Inputs) > 0 {
+			inputs, d := types.MapValueFrom(ctx, types.StringType, stringifyJSONValues(run.Inputs))
+			diags.Append(d...)
+			state.Inputs = inputs
+		} else {
+			state.Inputs = types.MapNull(types.StringType)
+		}
+	}
+
+	outputs := stringifyJSONValues(run.Outputs)
+	outputsMap, d := types.MapValueFrom(ctx, types.StringType, outputs)
+	diags.Append(d...)
+	state.Outputs = outputsMap
+	state.RepoURL = stringValueOrNull(outputs["repo_url"])
+	state.EntityID = stringValueOrNull(outputs["entity_id"])
+
+	return diags
+}
+
+// stringifyJSONValues converts decoded JSON values to strings, leaving strings
+// as-is and JSON-encoding everything else.
+func stringifyJSONValues(values map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(values))
+	for k, v := range values {
+		if s, ok := v.(string); ok {
+			result[k] = s
+			continue
+		}
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		result[k] = string(encoded)
+	}
+	return result
+}
@@ -0,0 +1,296 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+func TestForgeRunResource_Metadata(t *testing.T) {
+	r := NewForgeRunResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_forge_run" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_forge_run")
+	}
+}
+
+func TestForgeRunResource_Schema_HasRequiredAttributes(t *testing.T) {
+	r := NewForgeRunResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	expectedAttrs := []string{
+		"id", "mold", "version", "team", "inputs", "status", "approval_policy_id",
+		"outputs", "repo_url", "entity_id", "created_at", "completed_at", "timeouts",
+	}
+	for _, name := range expectedAttrs {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("schema missing attribute %q", name)
+		}
+	}
+	if !resp.Schema.Attributes["mold"].IsRequired() {
+		t.Error("mold should be required")
+	}
+}
+
+func TestForgeRunResource_Configure_WithValidClient(t *testing.T) {
+	r := &ForgeRunResource{}
+	c := client.NewClient("https://test.example.com", "key", 30*time.Second)
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: c,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if r.client != c {
+		t.Error("client not set correctly")
+	}
+}
+
+func TestForgeRunResource_Configure_WrongType(t *testing.T) {
+	r := &ForgeRunResource{}
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: "not a client",
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected error for wrong provider data type")
+	}
+}
+
+func TestMapForgeRunToState(t *testing.T) {
+	run := &client.ForgeRun{
+		ID:          "run-1",
+		MoldSlug:    "go-service",
+		MoldVersion: "1.2.0",
+		Status:      client.ForgeRunStatusSucceeded,
+		Inputs:      map[string]interface{}{"name": "payments-api", "replicas": float64(3)},
+		Outputs: map[string]interface{}{
+			"repo_url":  "https://github.com/acme/payments-api",
+			"entity_id": "service:payments-api",
+			"ports":     []interface{}{float64(8080)},
+		},
+		ApprovalPolicyID: "ap-1",
+		CompletedAt:      "2025-06-10T10:05:00Z",
+	}
+
+	state := &ForgeRunResourceModel{
+		Inputs: types.MapNull(types.StringType),
+	}
+	diags := mapForgeRunToState(context.Background(), run, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if state.RepoURL.ValueString() != "https://github.com/acme/payments-api" {
+		t.Errorf("RepoURL = %q", state.RepoURL.ValueString())
+	}
+	if state.EntityID.ValueString() != "service:payments-api" {
+		t.Errorf("EntityID = %q", state.EntityID.ValueString())
+	}
+	if state.Version.ValueString() != "1.2.0" {
+		t.Errorf("Version = %q, want %q", state.Version.ValueString(), "1.2.0")
+	}
+
+	outputs := map[string]string{}
+	state.Outputs.ElementsAs(context.Background(), &outputs, false)
+	if outputs["ports"] != "[8080]" {
+		t.Errorf("outputs[ports] = %q, want JSON-encoded array", outputs["ports"])
+	}
+
+	inputs := map[string]string{}
+	state.Inputs.ElementsAs(context.Background(), &inputs, false)
+	if inputs["replicas"] != "3" {
+		t.Errorf("inputs[replicas] = %q, want %q on import", inputs["replicas"], "3")
+	}
+}
+
+// newForgeRunTestAPI returns a fake API with a published "service" mold at
+// 1.0.0 in the backend category, optionally behind an approval policy.
+func newForgeRunTestAPI(t *testing.T, withApproval bool) (*fakeapi.Server, *ForgeRunResource) {
+	t.Helper()
+	previous := pollInterval
+	pollInterval = time.Millisecond
+	t.Cleanup(func() { pollInterval = previous })
+
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	ctx := context.Background()
+
+	if _, err := c.CreateForgeMold(ctx, client.CreateForgeMoldRequest{
+		Slug: "service", Name: "Service", Version: "1.0.0", Visibility: "public", Category: "backend",
+		Schema:   testMoldSchema(),
+		Defaults: map[string]interface{}{"replicas": float64(2)},
+	}); err != nil {
+		t.Fatalf("CreateForgeMold() error = %v", err)
+	}
+	if _, err := c.PublishForgeMold(ctx, "service", "1.0.0"); err != nil {
+		t.Fatalf("PublishForgeMold() error = %v", err)
+	}
+	if withApproval {
+		if _, err := c.CreateApprovalPolicy(ctx, client.CreateApprovalPolicyRequest{
+			Name:          "backend",
+			Enabled:       true,
+			ApprovalChain: []client.ApprovalStep{{Name: "lead", Approvers: []string{"team:platform"}}},
+			Match:         &client.ApprovalPolicyMatch{Categories: []string{"backend"}},
+		}); err != nil {
+			t.Fatalf("CreateApprovalPolicy() error = %v", err)
+		}
+	}
+	return api, &ForgeRunResource{client: c}
+}
+
+// createForgeRun plans a run of the "service" mold and calls Create.
+func createForgeRun(t *testing.T, r *ForgeRunResource, version string, inputs map[string]string, createTimeout string) (*resource.CreateResponse, ForgeRunResourceModel) {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	model := ForgeRunResourceModel{
+		ID: types.StringUnknown(), Mold: types.StringValue("service"), Version: types.StringUnknown(),
+		Team: types.StringNull(), Inputs: types.MapNull(types.StringType), Status: types.StringUnknown(),
+		ApprovalPolicyID: types.StringUnknown(), Outputs: types.MapUnknown(types.StringType),
+		RepoURL: types.StringUnknown(), EntityID: types.StringUnknown(),
+		CreatedAt: types.StringUnknown(), CompletedAt: types.StringUnknown(),
+		Timeouts: nullTimeouts("create"),
+	}
+	if version != "" {
+		model.Version = types.StringValue(version)
+	}
+	if inputs != nil {
+		model.Inputs, _ = types.MapValueFrom(ctx, types.StringType, inputs)
+	}
+	if createTimeout != "" {
+		model.Timeouts = timeouts.Value{Object: types.ObjectValueMust(
+			map[string]attr.Type{"create": types.StringType},
+			map[string]attr.Value{"create": types.StringValue(createTimeout)},
+		)}
+	}
+	plan := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("encoding plan: %v", diags)
+	}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, resp)
+
+	var created ForgeRunResourceModel
+	if !resp.State.Raw.IsNull() {
+		resp.State.Get(ctx, &created)
+	}
+	return resp, created
+}
+
+func TestForgeRunResource_Create_VersionMismatch(t *testing.T) {
+	_, r := newForgeRunTestAPI(t, false)
+
+	resp, created := createForgeRun(t, r, "2.0.0", map[string]string{"name": "payments-api"}, "")
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a version the mold is not at")
+	}
+	if len(resp.Diagnostics.Errors()) != 1 || resp.Diagnostics.Errors()[0].Summary() != "Mold Version Not Available" {
+		t.Errorf("diagnostics = %v, want Mold Version Not Available", resp.Diagnostics)
+	}
+	if d, ok := resp.Diagnostics.Errors()[0].(interface{ Path() path.Path }); !ok || !d.Path().Equal(path.Root("version")) {
+		t.Errorf("error is not on the version attribute: %v", resp.Diagnostics)
+	}
+	if !created.ID.IsNull() {
+		t.Errorf("ID = %v, want no run to be created", created.ID)
+	}
+}
+
+func TestForgeRunResource_Create_InvalidInputs(t *testing.T) {
+	_, r := newForgeRunTestAPI(t, false)
+
+	resp, created := createForgeRun(t, r, "", map[string]string{"name": "Payments API", "replicas": "three"}, "")
+	if len(resp.Diagnostics.Errors()) != 1 || resp.Diagnostics.Errors()[0].Summary() != "Invalid Forge Run Inputs" {
+		t.Fatalf("diagnostics = %v, want Invalid Forge Run Inputs", resp.Diagnostics)
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "replicas") {
+		t.Errorf("detail = %q, want it to name replicas", detail)
+	}
+	if !created.ID.IsNull() {
+		t.Errorf("ID = %v, want no run to be created", created.ID)
+	}
+}
+
+func TestForgeRunResource_Create_WaitsForApproval(t *testing.T) {
+	api, r := newForgeRunTestAPI(t, true)
+
+	// Approve the run once Create has triggered it and started polling.
+	approved := make(chan struct{})
+	go func() {
+		defer close(approved)
+		for {
+			if ids := api.PendingForgeRuns(); len(ids) > 0 {
+				api.SetForgeRunStatus(ids[0], client.ForgeRunStatusSucceeded, map[string]interface{}{"repo_url": "https://github.com/acme/payments-api"})
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}()
+
+	resp, created := createForgeRun(t, r, "1.0.0", map[string]string{"name": "payments-api"}, "")
+	<-approved
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() error = %v", resp.Diagnostics)
+	}
+	if created.Status.ValueString() != client.ForgeRunStatusSucceeded {
+		t.Errorf("Status = %q, want %q", created.Status.ValueString(), client.ForgeRunStatusSucceeded)
+	}
+	if created.ApprovalPolicyID.IsNull() {
+		t.Error("ApprovalPolicyID is null, want the matching policy")
+	}
+	if created.RepoURL.ValueString() != "https://github.com/acme/payments-api" {
+		t.Errorf("RepoURL = %q, want the run output", created.RepoURL.ValueString())
+	}
+}
+
+func TestForgeRunResource_Create_TimeoutKeepsState(t *testing.T) {
+	api, r := newForgeRunTestAPI(t, true)
+
+	resp, created := createForgeRun(t, r, "", map[string]string{"name": "payments-api"}, "50ms")
+	if len(resp.Diagnostics.Errors()) != 1 || resp.Diagnostics.Errors()[0].Summary() != "Timeout Waiting For Forge Run" {
+		t.Fatalf("diagnostics = %v, want Timeout Waiting For Forge Run", resp.Diagnostics)
+	}
+	pending := api.PendingForgeRuns()
+	if len(pending) != 1 || created.ID.ValueString() != pending[0] {
+		t.Errorf("ID = %v, want the pending run %v in state", created.ID, pending)
+	}
+	if created.Status.ValueString() != client.ForgeRunStatusPendingApproval {
+		t.Errorf("Status = %q, want %q", created.Status.ValueString(), client.ForgeRunStatusPendingApproval)
+	}
+}
+
+func TestWaitFor_Timeout(t *testing.T) {
+	previous := pollInterval
+	pollInterval = time.Millisecond
+	t.Cleanup(func() { pollInterval = previous })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := waitFor(ctx, func(context.Context) (bool, error) { return false, nil })
+	if err != context.DeadlineExceeded {
+		t.Errorf("waitFor() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,439 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+// defaultGitOpsSyncWaitTimeout bounds how long create and update wait for a
+// rollout when no timeouts block is configured.
+const defaultGitOpsSyncWaitTimeout = 10 * time.Minute
+
+var (
+	_ resource.Resource                = &GitOpsSyncWaitResource{}
+	_ resource.ResourceWithImportState = &GitOpsSyncWaitResource{}
+)
+
+// GitOpsSyncWaitResource defines the resource implementation.
+type GitOpsSyncWaitResource struct {
+	client *client.Client
+}
+
+// GitOpsSyncWaitResourceModel describes the resource data model.
+type GitOpsSyncWaitResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	ClusterID      types.String   `tfsdk:"cluster_id"`
+	Name           types.String   `tfsdk:"name"`
+	Namespace      types.String   `tfsdk:"namespace"`
+	Kind           types.String   `tfsdk:"kind"`
+	Tool           types.String   `tfsdk:"tool"`
+	Revision       types.String   `tfsdk:"revision"`
+	SyncStatus     types.String   `tfsdk:"sync_status"`
+	HealthStatus   types.String   `tfsdk:"health_status"`
+	SyncedRevision types.String   `tfsdk:"synced_revision"`
+	LastSyncedAt   types.String   `tfsdk:"last_synced_at"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewGitOpsSyncWaitResource creates a new GitOps sync wait resource.
+func NewGitOpsSyncWaitResource() resource.Resource {
+	return &GitOpsSyncWaitResource{}
+}
+
+func (r *GitOpsSyncWaitResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gitops_sync_wait"
+}
+
+func (r *GitOpsSyncWaitResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Waits for an Argo CD Application, Flux Kustomization or HelmRelease reported by a K8s agent " +
+			"to become Synced and Healthy, optionally at an expected revision. Create and update fail with the " +
+			"last observed status if the rollout does not complete in time. Destroying the resource has no effect " +
+			"on the GitOps resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the GitOps resource being watched.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "The cluster the GitOps resource runs in.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the Application, Kustomization or HelmRelease.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Description: "The namespace of the GitOps resource. Required when the name is not unique in the cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Description: "The kind of the GitOps resource (Application, Kustomization, HelmRelease).",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"tool": schema.StringAttribute{
+				Description: "The GitOps tool managing the resource (argocd, flux).",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"revision": schema.StringAttribute{
+				Description: "The revision the resource must be synced to, such as a commit SHA (abbreviated SHAs of at " +
+					"least 7 characters are accepted) or a Helm chart version. Changing it waits for the new revision.",
+				Optional: true,
+			},
+			"sync_status": schema.StringAttribute{
+				Description: "The last observed sync status.",
+				Computed:    true,
+			},
+			"health_status": schema.StringAttribute{
+				Description: "The last observed health status.",
+				Computed:    true,
+			},
+			"synced_revision": schema.StringAttribute{
+				Description: "The last observed revision.",
+				Computed:    true,
+			},
+			"last_synced_at": schema.StringAttribute{
+				Description: "When the resource last synced.",
+				Computed:    true,
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *GitOpsSyncWaitResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *GitOpsSyncWaitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "creating gitops sync wait")
+
+	var plan GitOpsSyncWaitResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultGitOpsSyncWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, diags := waitForGitOpsSync(ctx, r.client, &plan, createTimeout)
+	resp.Diagnostics.Append(diags...)
+	if res == nil {
+		return
+	}
+
+	// Record the watched resource even when the wait fails so it is tainted and retried.
+	mapGitOpsResourceToState(res, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *GitOpsSyncWaitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "reading gitops sync wait")
+
+	var state GitOpsSyncWaitResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetGitOpsResource(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "gitops resource not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading GitOps Resource", fmt.Sprintf("Could not read gitops resource %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	mapGitOpsResourceToState(res, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *GitOpsSyncWaitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "updating gitops sync wait")
+
+	var plan GitOpsSyncWaitResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultGitOpsSyncWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// State is left untouched on failure so the next apply waits again.
+	res, diags := waitForGitOpsSync(ctx, r.client, &plan, updateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapGitOpsResourceToState(res, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the wait from state; the GitOps resource is managed by its agent.
+func (r *GitOpsSyncWaitResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "deleting gitops sync wait")
+}
+
+// ImportState imports a wait by GitOps resource ID. revision is left unset,
+// so the imported wait accepts any revision that is Synced and Healthy.
+func (r *GitOpsSyncWaitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	res, err := r.client.GetGitOpsResource(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing GitOps Resource", fmt.Sprintf("Could not read gitops resource %s: %s", req.ID, err))
+		return
+	}
+
+	state := GitOpsSyncWaitResourceModel{Timeouts: nullTimeouts("create", "update")}
+	mapGitOpsResourceToState(res, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// waitForGitOpsSync polls the GitOps resource described by m until it is
+// Synced and Healthy at the expected revision. Resources an agent has not
+// reported yet are waited for as well. It returns the last observed resource,
+// or nil if none was found, so callers can still record state when the wait fails.
+func waitForGitOpsSync(ctx context.Context, c *client.Client, m *GitOpsSyncWaitResourceModel, timeout time.Duration) (*client.GitOpsResource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var res *client.GitOpsResource
+	expected := m.Revision.ValueString()
+	target := describeGitOpsTarget(m)
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := waitFor(waitCtx, func(ctx context.Context) (bool, error) {
+		var current *client.GitOpsResource
+		var err error
+		if res != nil {
+			current, err = c.GetGitOpsResource(ctx, res.ID)
+			if client.IsNotFound(err) {
+				current, err = nil, nil
+			}
+		} else {
+			current, err = findGitOpsResource(ctx, c, m)
+		}
+		if err != nil || current == nil {
+			return false, err
+		}
+		if res == nil || current.SyncStatus != res.SyncStatus || current.HealthStatus != res.HealthStatus || current.Revision != res.Revision {
+			tflog.Debug(ctx, "gitops resource status changed", map[string]any{
+				"id": current.ID, "sync_status": current.SyncStatus, "health_status": current.HealthStatus, "revision": current.Revision,
+			})
+		}
+		res = current
+		if res.Suspended {
+			return false, fmt.Errorf("%s is suspended and will not sync", target)
+		}
+		return gitOpsRolloutComplete(res, expected), nil
+	})
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) && res == nil:
+		diags.AddError(
+			"Timeout Waiting For GitOps Sync",
+			fmt.Sprintf("%s was not reported by any agent within %s.", target, timeout),
+		)
+	case errors.Is(err, context.DeadlineExceeded):
+		want := "Synced and Healthy"
+		if expected != "" {
+			want += " at revision " + expected
+		}
+		diags.AddError(
+			"Timeout Waiting For GitOps Sync",
+			fmt.Sprintf("%s did not become %s within %s; last observed sync status: %s, health status: %s, revision: %s.",
+				target, want, timeout, res.SyncStatus, res.HealthStatus, res.Revision),
+		)
+	case err != nil:
+		diags.AddError("Error Waiting For GitOps Sync", fmt.Sprintf("Could not wait for %s: %s", target, err))
+	}
+
+	return res, diags
+}
+
+// findGitOpsResource looks up the GitOps resource matching the identifying
+// attributes of m. It returns nil without error if no agent has reported it.
+func findGitOpsResource(ctx context.Context, c *client.Client, m *GitOpsSyncWaitResourceModel) (*client.GitOpsResource, error) {
+	resources, _, err := c.ListGitOpsResources(ctx, client.ListGitOpsResourcesParams{
+		ClusterID: m.ClusterID.ValueString(),
+		Tool:      knownString(m.Tool),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []client.GitOpsResource
+	for _, res := range resources {
+		if res.Name != m.Name.ValueString() {
+			continue
+		}
+		if ns := knownString(m.Namespace); ns != "" && res.Namespace != ns {
+			continue
+		}
+		if kind := knownString(m.Kind); kind != "" && !strings.EqualFold(res.Kind, kind) {
+			continue
+		}
+		matches = append(matches, res)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matches[0], nil
+	default:
+		found := make([]string, len(matches))
+		for i, res := range matches {
+			found[i] = fmt.Sprintf("%s %s/%s", res.Kind, res.Namespace, res.Name)
+		}
+		return nil, fmt.Errorf("%d gitops resources named %q found on cluster %s (%s); set namespace or kind to select one",
+			len(matches), m.Name.ValueString(), m.ClusterID.ValueString(), strings.Join(found, ", "))
+	}
+}
+
+// gitOpsRolloutComplete reports whether res is Synced and Healthy at the expected revision.
+func gitOpsRolloutComplete(res *client.GitOpsResource, expected string) bool {
+	if !res.IsSynced() || !res.IsHealthy() {
+		return false
+	}
+	return expected == "" || gitOpsRevisionMatches(res.Revision, expected) || gitOpsRevisionMatches(res.ChartVersion, expected)
+}
+
+// gitOpsRevisionMatches compares an observed revision with the expected one.
+// Flux reports revisions as "<branch>@sha1:<sha>" or "<branch>/<sha>", and
+// commit SHAs may be abbreviated to 7 or more characters.
+func gitOpsRevisionMatches(observed, expected string) bool {
+	if observed == "" {
+		return false
+	}
+	if observed == expected {
+		return true
+	}
+	sha := observed
+	if i := strings.LastIndexAny(sha, ":/"); i >= 0 {
+		sha = sha[i+1:]
+	}
+	if sha == expected {
+		return true
+	}
+	return len(expected) >= 7 && isHex(expected) && strings.HasPrefix(strings.ToLower(sha), strings.ToLower(expected))
+}
+
+// isHex reports whether s consists only of hexadecimal digits.
+func isHex(s string) bool {
+	for _, r := range s {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+			return false
+		}
+	}
+	return true
+}
+
+// knownString returns the value of s, or "" if it is null or unknown.
+func knownString(s types.String) string {
+	if s.IsNull() || s.IsUnknown() {
+		return ""
+	}
+	return s.ValueString()
+}
+
+// describeGitOpsTarget names the watched resource for diagnostics.
+func describeGitOpsTarget(m *GitOpsSyncWaitResourceModel) string {
+	name := m.Name.ValueString()
+	if ns := knownString(m.Namespace); ns != "" {
+		name = ns + "/" + name
+	}
+	kind := knownString(m.Kind)
+	if kind == "" {
+		kind = "GitOps resource"
+	}
+	return fmt.Sprintf("%s %s on cluster %s", kind, name, m.ClusterID.ValueString())
+}
+
+// mapGitOpsResourceToState maps a client GitOpsResource to the Terraform resource
+// model. Identifying attributes keep their configured values, since kind and
+// tool are matched case-insensitively; they are only filled in when unset.
+func mapGitOpsResourceToState(res *client.GitOpsResource, state *GitOpsSyncWaitResourceModel) {
+	state.ID = types.StringValue(res.ID)
+	state.ClusterID = configuredOr(state.ClusterID, res.ClusterID)
+	state.Name = configuredOr(state.Name, res.Name)
+	state.Namespace = configuredOr(state.Namespace, res.Namespace)
+	state.Kind = configuredOr(state.Kind, res.Kind)
+	state.Tool = configuredOr(state.Tool, res.Tool)
+	state.SyncStatus = stringValueOrNull(res.SyncStatus)
+	state.HealthStatus = stringValueOrNull(res.HealthStatus)
+	state.SyncedRevision = stringValueOrNull(res.Revision)
+	state.LastSyncedAt = stringValueOrNull(res.LastSyncedAt)
+}
+
+// configuredOr returns current if it holds a value, otherwise the API value.
+func configuredOr(current types.String, apiValue string) types.String {
+	if knownString(current) != "" {
+		return current
+	}
+	return types.StringValue(apiValue)
+}
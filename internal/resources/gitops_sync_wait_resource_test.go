@@ -0,0 +1,215 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func TestGitOpsSyncWaitResource_Metadata(t *testing.T) {
+	r := NewGitOpsSyncWaitResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_gitops_sync_wait" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_gitops_sync_wait")
+	}
+}
+
+func TestGitOpsSyncWaitResource_Schema_HasRequiredAttributes(t *testing.T) {
+	r := NewGitOpsSyncWaitResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	for _, name := range []string{"cluster_id", "name"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Fatalf("schema missing %q attribute", name)
+		}
+		if !attr.IsRequired() {
+			t.Errorf("%s should be required", name)
+		}
+	}
+	for _, name := range []string{"id", "namespace", "kind", "tool", "revision", "sync_status", "health_status", "synced_revision", "last_synced_at", "timeouts"} {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("schema missing %q attribute", name)
+		}
+	}
+}
+
+func TestGitOpsRevisionMatches(t *testing.T) {
+	tests := []struct {
+		observed, expected string
+		want               bool
+	}{
+		{"4f2c9e1a7b3d5e6f7a8b9c0d1e2f3a4b5c6d7e8f", "4f2c9e1a7b3d5e6f7a8b9c0d1e2f3a4b5c6d7e8f", true},
+		{"4f2c9e1a7b3d5e6f7a8b9c0d1e2f3a4b5c6d7e8f", "4f2c9e1", true},
+		{"4f2c9e1a7b3d5e6f7a8b9c0d1e2f3a4b5c6d7e8f", "4F2C9E1", true},
+		{"4f2c9e1a7b3d5e6f7a8b9c0d1e2f3a4b5c6d7e8f", "4f2c9e", false},
+		{"main@sha1:4f2c9e1a7b3d5e6f7a8b9c0d1e2f3a4b5c6d7e8f", "4f2c9e1a", true},
+		{"main/4f2c9e1a7b3d5e6f7a8b9c0d1e2f3a4b5c6d7e8f", "4f2c9e1a", true},
+		{"main@sha1:4f2c9e1a7b3d5e6f7a8b9c0d1e2f3a4b5c6d7e8f", "main@sha1:4f2c9e1a7b3d5e6f7a8b9c0d1e2f3a4b5c6d7e8f", true},
+		{"4f2c9e1a7b3d5e6f7a8b9c0d1e2f3a4b5c6d7e8f", "0a1b2c3d", false},
+		{"1.2.3", "1.2.3", true},
+		{"1.2.3", "1.2", false},
+		{"", "4f2c9e1", false},
+	}
+	for _, tt := range tests {
+		if got := gitOpsRevisionMatches(tt.observed, tt.expected); got != tt.want {
+			t.Errorf("gitOpsRevisionMatches(%q, %q) = %v, want %v", tt.observed, tt.expected, got, tt.want)
+		}
+	}
+}
+
+func TestGitOpsRolloutComplete(t *testing.T) {
+	tests := []struct {
+		name     string
+		res      client.GitOpsResource
+		expected string
+		want     bool
+	}{
+		{"synced and healthy", client.GitOpsResource{SyncStatus: "Synced", HealthStatus: "Healthy", Revision: "abc1234"}, "", true},
+		{"at expected revision", client.GitOpsResource{SyncStatus: "Synced", HealthStatus: "Healthy", Revision: "abc1234"}, "abc1234", true},
+		{"at old revision", client.GitOpsResource{SyncStatus: "Synced", HealthStatus: "Healthy", Revision: "abc1234"}, "def5678", false},
+		{"chart version", client.GitOpsResource{Kind: "HelmRelease", SyncStatus: "synced", HealthStatus: "healthy", ChartVersion: "2.1.0"}, "2.1.0", true},
+		{"progressing", client.GitOpsResource{SyncStatus: "Synced", HealthStatus: "Progressing", Revision: "abc1234"}, "abc1234", false},
+		{"out of sync", client.GitOpsResource{SyncStatus: "OutOfSync", HealthStatus: "Healthy"}, "", false},
+	}
+	for _, tt := range tests {
+		if got := gitOpsRolloutComplete(&tt.res, tt.expected); got != tt.want {
+			t.Errorf("%s: gitOpsRolloutComplete() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// gitOpsTestServer serves a single Argo CD application whose status is
+// produced by status for each list or get request.
+func gitOpsTestServer(t *testing.T, status func(poll int) client.GitOpsResource) *httptest.Server {
+	t.Helper()
+	previous := pollInterval
+	pollInterval = time.Millisecond
+	t.Cleanup(func() { pollInterval = previous })
+
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		res := status(polls)
+		res.ID, res.ClusterID, res.Tool, res.Namespace, res.Name, res.Kind = "gitops-1", "prod", "argocd", "argocd", "payments", "Application"
+		if r.URL.Path == "/api/v1/operations/gitops" {
+			json.NewEncoder(w).Encode(map[string]interface{}{"resources": []client.GitOpsResource{res}, "total": 1})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"resource": res})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func gitOpsSyncWaitModel(revision string) *GitOpsSyncWaitResourceModel {
+	m := &GitOpsSyncWaitResourceModel{
+		ClusterID: types.StringValue("prod"),
+		Name:      types.StringValue("payments"),
+		Namespace: types.StringUnknown(),
+		Kind:      types.StringUnknown(),
+		Tool:      types.StringUnknown(),
+		Revision:  types.StringNull(),
+	}
+	if revision != "" {
+		m.Revision = types.StringValue(revision)
+	}
+	return m
+}
+
+func TestWaitForGitOpsSync_WaitsForRevision(t *testing.T) {
+	server := gitOpsTestServer(t, func(poll int) client.GitOpsResource {
+		switch {
+		case poll < 2:
+			return client.GitOpsResource{SyncStatus: "Synced", HealthStatus: "Healthy", Revision: "0000000aaaa"}
+		case poll < 4:
+			return client.GitOpsResource{SyncStatus: "Synced", HealthStatus: "Progressing", Revision: "4f2c9e1abcd"}
+		default:
+			return client.GitOpsResource{SyncStatus: "Synced", HealthStatus: "Healthy", Revision: "4f2c9e1abcd"}
+		}
+	})
+	c := client.NewClient(server.URL, "key", 30*time.Second)
+	m := gitOpsSyncWaitModel("4f2c9e1")
+
+	res, diags := waitForGitOpsSync(context.Background(), c, m, time.Second)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if res.HealthStatus != "Healthy" || res.Revision != "4f2c9e1abcd" {
+		t.Errorf("got %s/%s, want Healthy at 4f2c9e1abcd", res.HealthStatus, res.Revision)
+	}
+
+	mapGitOpsResourceToState(res, m)
+	if m.ID.ValueString() != "gitops-1" || m.Kind.ValueString() != "Application" || m.Namespace.ValueString() != "argocd" {
+		t.Errorf("state = %s %s/%s", m.ID, m.Namespace, m.Kind)
+	}
+	if m.SyncedRevision.ValueString() != "4f2c9e1abcd" {
+		t.Errorf("SyncedRevision = %q", m.SyncedRevision.ValueString())
+	}
+}
+
+func TestWaitForGitOpsSync_TimeoutReportsLastStatus(t *testing.T) {
+	server := gitOpsTestServer(t, func(int) client.GitOpsResource {
+		return client.GitOpsResource{SyncStatus: "OutOfSync", HealthStatus: "Degraded", Revision: "0000000aaaa"}
+	})
+	c := client.NewClient(server.URL, "key", 30*time.Second)
+
+	res, diags := waitForGitOpsSync(context.Background(), c, gitOpsSyncWaitModel("4f2c9e1"), 20*time.Millisecond)
+	if !diags.HasError() {
+		t.Fatal("expected timeout error")
+	}
+	if res == nil {
+		t.Fatal("expected last observed resource to be returned")
+	}
+	detail := diags[0].Detail()
+	for _, want := range []string{"OutOfSync", "Degraded", "0000000aaaa", "4f2c9e1"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail %q should mention %q", detail, want)
+		}
+	}
+}
+
+func TestWaitForGitOpsSync_SuspendedFails(t *testing.T) {
+	server := gitOpsTestServer(t, func(int) client.GitOpsResource {
+		return client.GitOpsResource{SyncStatus: "OutOfSync", HealthStatus: "Healthy", Suspended: true}
+	})
+	c := client.NewClient(server.URL, "key", 30*time.Second)
+
+	_, diags := waitForGitOpsSync(context.Background(), c, gitOpsSyncWaitModel(""), time.Second)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "suspended") {
+		t.Errorf("expected suspended error, got %v", diags)
+	}
+}
+
+func TestFindGitOpsResource_Ambiguous(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"resources": []client.GitOpsResource{
+			{ID: "a", ClusterID: "prod", Namespace: "argocd", Name: "payments", Kind: "Application"},
+			{ID: "b", ClusterID: "prod", Namespace: "flux-system", Name: "payments", Kind: "Kustomization"},
+		}})
+	}))
+	defer server.Close()
+	c := client.NewClient(server.URL, "key", 30*time.Second)
+
+	m := gitOpsSyncWaitModel("")
+	if _, err := findGitOpsResource(context.Background(), c, m); err == nil || !strings.Contains(err.Error(), "set namespace or kind") {
+		t.Errorf("expected ambiguity error, got %v", err)
+	}
+
+	m.Kind = types.StringValue("kustomization")
+	res, err := findGitOpsResource(context.Background(), c, m)
+	if err != nil || res == nil || res.ID != "b" {
+		t.Errorf("findGitOpsResource() = %v, %v; want resource b", res, err)
+	}
+}
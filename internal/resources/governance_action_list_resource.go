@@ -0,0 +1,106 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ list.ListResourceWithConfigure = &GovernanceActionListResource{}
+
+// GovernanceActionListResource lists governance actions for terraform query.
+type GovernanceActionListResource struct {
+	client *client.Client
+}
+
+// GovernanceActionListConfigModel describes the list block configuration.
+type GovernanceActionListConfigModel struct {
+	Status     types.String `tfsdk:"status"`
+	Priority   types.String `tfsdk:"priority"`
+	EntityID   types.String `tfsdk:"entity_id"`
+	SourceType types.String `tfsdk:"source_type"`
+	Overdue    types.Bool   `tfsdk:"overdue"`
+}
+
+// NewGovernanceActionListResource creates a new governance action list resource.
+func NewGovernanceActionListResource() list.ListResource {
+	return &GovernanceActionListResource{}
+}
+
+func (r *GovernanceActionListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_governance_action"
+}
+
+func (r *GovernanceActionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists Shoehorn governance actions. The filters are applied by the API.",
+		Attributes: map[string]listschema.Attribute{
+			"status": listschema.StringAttribute{
+				Description: "Only list actions with this status, e.g. open.",
+				Optional:    true,
+			},
+			"priority": listschema.StringAttribute{
+				Description: "Only list actions with this priority, e.g. critical.",
+				Optional:    true,
+			},
+			"entity_id": listschema.StringAttribute{
+				Description: "Only list actions for this entity.",
+				Optional:    true,
+			},
+			"source_type": listschema.StringAttribute{
+				Description: "Only list actions from this source type, e.g. scorecard.",
+				Optional:    true,
+			},
+			"overdue": listschema.BoolAttribute{
+				Description: "Only list actions that are (true) or are not (false) past their SLA.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *GovernanceActionListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListClient(req, resp)
+}
+
+func (r *GovernanceActionListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config GovernanceActionListConfigModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filters := &client.GovernanceActionFilters{
+		Status:     config.Status.ValueString(),
+		Priority:   config.Priority.ValueString(),
+		EntityID:   config.EntityID.ValueString(),
+		SourceType: config.SourceType.ValueString(),
+	}
+	if !config.Overdue.IsNull() {
+		overdue := config.Overdue.ValueBool()
+		filters.Overdue = &overdue
+	}
+
+	actions, _, err := r.client.ListGovernanceActions(ctx, filters)
+	if err != nil {
+		listError(stream, "Error Listing Governance Actions", fmt.Sprintf("Could not list governance actions: %s", err))
+		return
+	}
+
+	stream.Results = listResults(ctx, req, actions, func(a client.GovernanceAction, result *list.ListResult) {
+		result.DisplayName = a.Title
+		result.Diagnostics.Append(setStringIdentity(ctx, result.Identity, "id", types.StringValue(a.ID))...)
+		if !req.IncludeResource {
+			return
+		}
+
+		var state GovernanceActionResourceModel
+		mapGovernanceActionToState(&a, &state)
+		result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+	})
+}
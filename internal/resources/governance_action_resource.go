@@ -3,8 +3,10 @@ package resources
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,8 +20,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &GovernanceActionResource{}
-	_ resource.ResourceWithImportState = &GovernanceActionResource{}
+	_ resource.Resource                   = &GovernanceActionResource{}
+	_ resource.ResourceWithImportState    = &GovernanceActionResource{}
+	_ resource.ResourceWithValidateConfig = &GovernanceActionResource{}
+	_ resource.ResourceWithModifyPlan     = &GovernanceActionResource{}
+	_ resource.ResourceWithIdentity       = &GovernanceActionResource{}
 )
 
 // GovernanceActionResource defines the resource implementation.
@@ -44,6 +49,16 @@ type GovernanceActionResourceModel struct {
 	ResolutionNote types.String `tfsdk:"resolution_note"`
 	CreatedAt      types.String `tfsdk:"created_at"`
 	UpdatedAt      types.String `tfsdk:"updated_at"`
+	History        types.List   `tfsdk:"history"`
+}
+
+// governanceStatusChangeAttrTypes returns the attribute types of a history entry.
+func governanceStatusChangeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"status":     types.StringType,
+		"actor":      types.StringType,
+		"changed_at": types.StringType,
+	}
 }
 
 // NewGovernanceActionResource creates a new governance action resource.
@@ -103,9 +118,11 @@ func (r *GovernanceActionResource) Schema(_ context.Context, _ resource.SchemaRe
 				},
 			},
 			"status": schema.StringAttribute{
-				Description: "The action status (open, in_progress, resolved, dismissed, wont_fix). Defaults to open.",
-				Optional:    true,
-				Computed:    true,
+				Description: "The action status (open, in_progress, resolved, dismissed, wont_fix). Defaults to open. " +
+					"Actions must be in_progress before they are resolved; open and in_progress actions may be dismissed or marked wont_fix, " +
+					"and closed actions may only be reopened. Moving to a closed status requires resolution_note.",
+				Optional: true,
+				Computed: true,
 				Validators: []validator.String{
 					stringvalidator.OneOf("open", "in_progress", "resolved", "dismissed", "wont_fix"),
 				},
@@ -148,7 +165,7 @@ func (r *GovernanceActionResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:    true,
 			},
 			"resolution_note": schema.StringAttribute{
-				Description: "A note explaining how the action was resolved.",
+				Description: "A note explaining how the action was resolved. Required when status is resolved, dismissed or wont_fix.",
 				Optional:    true,
 			},
 			"created_at": schema.StringAttribute{
@@ -162,10 +179,34 @@ func (r *GovernanceActionResource) Schema(_ context.Context, _ resource.SchemaRe
 				Description: "The last update timestamp.",
 				Computed:    true,
 			},
+			"history": schema.ListNestedAttribute{
+				Description: "The status transitions of the action, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"status": schema.StringAttribute{
+							Description: "The status the action moved to.",
+							Computed:    true,
+						},
+						"actor": schema.StringAttribute{
+							Description: "The user or API key that made the change.",
+							Computed:    true,
+						},
+						"changed_at": schema.StringAttribute{
+							Description: "When the change was made.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func (r *GovernanceActionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("id", "The governance action ID.")
+}
+
 func (r *GovernanceActionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -183,6 +224,74 @@ func (r *GovernanceActionResource) Configure(_ context.Context, req resource.Con
 	r.client = c
 }
 
+func (r *GovernanceActionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config GovernanceActionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Status.IsNull() || config.Status.IsUnknown() || config.ResolutionNote.IsUnknown() {
+		return
+	}
+
+	status := config.Status.ValueString()
+	if client.IsClosedGovernanceStatus(status) && strings.TrimSpace(config.ResolutionNote.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("resolution_note"),
+			"Missing Resolution Note",
+			fmt.Sprintf("A resolution_note is required when status is %q, so the reason the action was closed is recorded.", status),
+		)
+	}
+}
+
+// ModifyPlan rejects status changes the governance workflow does not allow.
+// New actions start open, so a status set at creation must be reachable from
+// open in one step. The transition history is carried over from state unless
+// the status changes.
+func (r *GovernanceActionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan GovernanceActionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state GovernanceActionResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(validateChangedReference(ctx, r.client, path.Root("entity_id"), plan.EntityID, state.EntityID, referenceEntity)...)
+	resp.Diagnostics.Append(validateChangedReference(ctx, r.client, path.Root("assigned_to"), plan.AssignedTo, state.AssignedTo, referenceUser, referenceTeam)...)
+
+	if plan.Status.IsNull() || plan.Status.IsUnknown() {
+		return
+	}
+
+	from := client.GovernanceStatusOpen
+	if !req.State.Raw.IsNull() {
+		from = state.Status.ValueString()
+	}
+
+	to := plan.Status.ValueString()
+	if !client.ValidGovernanceTransition(from, to) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("status"),
+			"Invalid Governance Status Transition",
+			fmt.Sprintf("A governance action cannot move from %q to %q. Allowed next statuses from %q: %s.",
+				from, to, from, strings.Join(client.GovernanceStatusTransitions(from), ", ")),
+		)
+		return
+	}
+
+	if !req.State.Raw.IsNull() && from == to && plan.History.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("history"), state.History)...)
+	}
+}
+
 func (r *GovernanceActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Debug(ctx, "creating governance action")
 
@@ -222,8 +331,33 @@ func (r *GovernanceActionResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	// Actions are always created open; move to the configured status afterwards.
+	if !plan.Status.IsNull() && !plan.Status.IsUnknown() && plan.Status.ValueString() != action.Status {
+		// Save the created action so a failed transition does not orphan it.
+		mapGovernanceActionToState(action, &plan)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+		status := plan.Status.ValueString()
+		updateReq := client.UpdateGovernanceActionRequest{Status: &status}
+		if !plan.ResolutionNote.IsNull() && !plan.ResolutionNote.IsUnknown() {
+			v := plan.ResolutionNote.ValueString()
+			updateReq.ResolutionNote = &v
+		}
+		if err := r.client.UpdateGovernanceAction(ctx, action.ID, updateReq); err != nil {
+			resp.Diagnostics.AddError("Error Setting Governance Action Status", fmt.Sprintf("Created governance action %s but could not move it to %q: %s", action.ID, status, err))
+			return
+		}
+
+		action, err = r.client.GetGovernanceAction(ctx, action.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Governance Action After Create", fmt.Sprintf("Could not read governance action %s: %s", plan.ID.ValueString(), err))
+			return
+		}
+	}
+
 	mapGovernanceActionToState(action, &plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", plan.ID)...)
 }
 
 func (r *GovernanceActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -248,20 +382,23 @@ func (r *GovernanceActionResource) Read(ctx context.Context, req resource.ReadRe
 
 	mapGovernanceActionToState(action, &state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", state.ID)...)
 }
 
 func (r *GovernanceActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	tflog.Debug(ctx, "updating governance action")
 
-	var plan GovernanceActionResourceModel
+	var plan, state GovernanceActionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	updateReq := client.UpdateGovernanceActionRequest{}
 
-	if !plan.Status.IsNull() && !plan.Status.IsUnknown() {
+	// Only send status when it changes, so the API records a single transition.
+	if !plan.Status.IsNull() && !plan.Status.IsUnknown() && !plan.Status.Equal(state.Status) {
 		v := plan.Status.ValueString()
 		updateReq.Status = &v
 	}
@@ -278,8 +415,13 @@ func (r *GovernanceActionResource) Update(ctx context.Context, req resource.Upda
 		updateReq.ResolutionNote = &v
 	}
 
-	if err := r.client.UpdateGovernanceAction(ctx, plan.ID.ValueString(), updateReq); err != nil {
-		resp.Diagnostics.AddError("Error Updating Governance Action", fmt.Sprintf("Could not update governance action: %s", err))
+	updateCtx := withStateVersion(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.UpdateGovernanceAction(updateCtx, plan.ID.ValueString(), updateReq); err != nil {
+		addUpdateError(&resp.Diagnostics, "Error Updating Governance Action", "Could not update governance action", err)
 		return
 	}
 
@@ -292,6 +434,7 @@ func (r *GovernanceActionResource) Update(ctx context.Context, req resource.Upda
 
 	mapGovernanceActionToState(action, &plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", plan.ID)...)
 }
 
 func (r *GovernanceActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -313,7 +456,22 @@ func (r *GovernanceActionResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *GovernanceActionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importID, diags := importIDFromIdentity(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	action, err := r.client.GetGovernanceAction(ctx, importID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Governance Action", fmt.Sprintf("Could not read governance action %s: %s", importID, err))
+		return
+	}
+
+	var state GovernanceActionResourceModel
+	mapGovernanceActionToState(action, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", state.ID)...)
 }
 
 func mapGovernanceActionToState(action *client.GovernanceAction, state *GovernanceActionResourceModel) {
@@ -356,5 +514,20 @@ func mapGovernanceActionToState(action *client.GovernanceAction, state *Governan
 	} else {
 		state.SLADays = types.Int64Null()
 	}
+
+	state.History = governanceHistoryValue(action.History)
 }
 
+// governanceHistoryValue converts an action's status history to its list attribute value.
+func governanceHistoryValue(history []client.GovernanceStatusChange) types.List {
+	elemType := types.ObjectType{AttrTypes: governanceStatusChangeAttrTypes()}
+	elems := make([]attr.Value, 0, len(history))
+	for _, change := range history {
+		elems = append(elems, types.ObjectValueMust(governanceStatusChangeAttrTypes(), map[string]attr.Value{
+			"status":     types.StringValue(change.Status),
+			"actor":      stringValueOrNull(change.Actor),
+			"changed_at": stringValueOrNull(change.ChangedAt),
+		}))
+	}
+	return types.ListValueMust(elemType, elems)
+}
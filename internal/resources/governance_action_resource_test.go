@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
 )
 
@@ -259,3 +261,137 @@ func TestMapGovernanceActionToState_PreservesUserSetValues(t *testing.T) {
 		t.Errorf("SLADays should be null when API returns nil, got %d", state.SLADays.ValueInt64())
 	}
 }
+
+// governanceActionModel returns a model with the required attributes set and
+// every other attribute null, ready to be encoded as config, plan or state.
+func governanceActionModel(status, note string) GovernanceActionResourceModel {
+	return GovernanceActionResourceModel{
+		ID:             types.StringValue("ga-1"),
+		EntityID:       types.StringValue("payments"),
+		EntityName:     types.StringNull(),
+		Title:          types.StringValue("Patch CVE"),
+		Description:    types.StringNull(),
+		Priority:       types.StringValue("high"),
+		Status:         stringValueOrNull(status),
+		SourceType:     types.StringValue("security"),
+		SourceID:       types.StringNull(),
+		AssignedTo:     types.StringNull(),
+		SLADays:        types.Int64Null(),
+		DueDate:        types.StringNull(),
+		ResolutionNote: stringValueOrNull(note),
+		CreatedAt:      types.StringNull(),
+		UpdatedAt:      types.StringNull(),
+		History:        governanceHistoryValue(nil),
+	}
+}
+
+// governanceActionState encodes a model against the resource schema.
+func governanceActionState(t *testing.T, m GovernanceActionResourceModel) tfsdk.State {
+	t.Helper()
+	r := NewGovernanceActionResource()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(context.Background(), &m); diags.HasError() {
+		t.Fatalf("encoding model: %v", diags)
+	}
+	return state
+}
+
+func TestGovernanceActionResource_ValidateConfig_RequiresNoteWhenClosing(t *testing.T) {
+	tests := []struct {
+		status, note string
+		wantErr      bool
+	}{
+		{"resolved", "", true},
+		{"dismissed", "  ", true},
+		{"wont_fix", "accepted risk", false},
+		{"in_progress", "", false},
+	}
+
+	for _, tt := range tests {
+		state := governanceActionState(t, governanceActionModel(tt.status, tt.note))
+		resp := &resource.ValidateConfigResponse{}
+		r := &GovernanceActionResource{}
+		r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+		}, resp)
+
+		if resp.Diagnostics.HasError() != tt.wantErr {
+			t.Errorf("status %q note %q: HasError = %v, want %v (%v)", tt.status, tt.note, resp.Diagnostics.HasError(), tt.wantErr, resp.Diagnostics)
+		}
+	}
+}
+
+func TestGovernanceActionResource_ModifyPlan_EnforcesTransitions(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		create   bool
+		wantErr  bool
+	}{
+		{name: "start work", from: "open", to: "in_progress"},
+		{name: "resolve started work", from: "in_progress", to: "resolved"},
+		{name: "reopen", from: "resolved", to: "open"},
+		{name: "skip in_progress", from: "open", to: "resolved", wantErr: true},
+		{name: "closed to closed", from: "dismissed", to: "wont_fix", wantErr: true},
+		{name: "create in_progress", to: "in_progress", create: true},
+		{name: "create resolved", to: "resolved", create: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := governanceActionState(t, governanceActionModel(tt.to, "done"))
+			req := resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+				State: tfsdk.State{Schema: plan.Schema, Raw: nullStateLike(plan)},
+			}
+			if !tt.create {
+				req.State = governanceActionState(t, governanceActionModel(tt.from, ""))
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r := &GovernanceActionResource{}
+			r.ModifyPlan(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("HasError = %v, want %v (%v)", resp.Diagnostics.HasError(), tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// nullStateLike returns a null value of the same type as the given state, as for a resource being created.
+func nullStateLike(s tfsdk.State) tftypes.Value {
+	return tftypes.NewValue(s.Raw.Type(), nil)
+}
+
+func TestMapGovernanceActionToState_History(t *testing.T) {
+	action := &client.GovernanceAction{
+		ID: "ga-1", EntityID: "payments", Title: "Patch CVE", Priority: "high", SourceType: "security", Status: "resolved",
+		History: []client.GovernanceStatusChange{
+			{Status: "open", Actor: "scanner", ChangedAt: "2026-01-01T00:00:00Z"},
+			{Status: "in_progress", Actor: "alice", ChangedAt: "2026-01-02T00:00:00Z"},
+			{Status: "resolved", Actor: "alice", ChangedAt: "2026-01-03T00:00:00Z"},
+		},
+	}
+
+	state := &GovernanceActionResourceModel{}
+	mapGovernanceActionToState(action, state)
+
+	var history []struct {
+		Status    types.String `tfsdk:"status"`
+		Actor     types.String `tfsdk:"actor"`
+		ChangedAt types.String `tfsdk:"changed_at"`
+	}
+	if diags := state.History.ElementsAs(context.Background(), &history, false); diags.HasError() {
+		t.Fatalf("ElementsAs() error = %v", diags)
+	}
+	if len(history) != 3 {
+		t.Fatalf("history length = %d, want 3", len(history))
+	}
+	if history[2].Status.ValueString() != "resolved" || history[2].Actor.ValueString() != "alice" {
+		t.Errorf("last history entry = %+v, want resolved by alice", history[2])
+	}
+}
@@ -0,0 +1,610 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &GovernanceActionSetResource{}
+	_ resource.ResourceWithValidateConfig = &GovernanceActionSetResource{}
+)
+
+// defaultFindingResolutionNote is recorded on actions whose finding is no longer reported.
+const defaultFindingResolutionNote = "Resolved automatically: the finding is no longer reported."
+
+// GovernanceActionSetResource defines the resource implementation.
+type GovernanceActionSetResource struct {
+	client *client.Client
+}
+
+// GovernanceActionSetResourceModel describes the resource data model.
+type GovernanceActionSetResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	ResolutionNote types.String `tfsdk:"resolution_note"`
+	Findings       types.List   `tfsdk:"findings"`
+	ActionIDs      types.Map    `tfsdk:"action_ids"`
+}
+
+// GovernanceFindingModel describes a single finding in a governance action set.
+type GovernanceFindingModel struct {
+	SourceType  types.String `tfsdk:"source_type"`
+	SourceID    types.String `tfsdk:"source_id"`
+	EntityID    types.String `tfsdk:"entity_id"`
+	EntityName  types.String `tfsdk:"entity_name"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Priority    types.String `tfsdk:"priority"`
+	SLADays     types.Int64  `tfsdk:"sla_days"`
+	AssignedTo  types.String `tfsdk:"assigned_to"`
+}
+
+// governanceFindingAttrTypes returns the attribute types for the finding nested object.
+func governanceFindingAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"source_type": types.StringType,
+		"source_id":   types.StringType,
+		"entity_id":   types.StringType,
+		"entity_name": types.StringType,
+		"title":       types.StringType,
+		"description": types.StringType,
+		"priority":    types.StringType,
+		"sla_days":    types.Int64Type,
+		"assigned_to": types.StringType,
+	}
+}
+
+// key identifies the finding, and the action tracking it, within the set.
+func (f GovernanceFindingModel) key() string {
+	return governanceFindingKey(f.SourceType.ValueString(), f.SourceID.ValueString())
+}
+
+// governanceFindingKey builds the source_type/source_id key used in action_ids.
+func governanceFindingKey(sourceType, sourceID string) string {
+	return sourceType + "/" + sourceID
+}
+
+// NewGovernanceActionSetResource creates a new governance action set resource.
+func NewGovernanceActionSetResource() resource.Resource {
+	return &GovernanceActionSetResource{}
+}
+
+func (r *GovernanceActionSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_governance_action_set"
+}
+
+func (r *GovernanceActionSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reconciles a list of findings, such as scanner or scorecard results, against Shoehorn governance actions. " +
+			"Each finding is keyed by source_type/source_id: new findings open an action (adopting an existing open action with the same key), " +
+			"changed priorities, SLAs and assignees are updated in place, and actions whose finding disappears are resolved with resolution_note rather than deleted. " +
+			"Actions dismissed or marked won't fix outside Terraform are left alone; a finding whose action was resolved elsewhere but is still reported gets a new action.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the set (same as name).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "A unique name for this set of findings, e.g. the scanner that produced them.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resolution_note": schema.StringAttribute{
+				Description: "The note recorded on actions resolved because their finding disappeared, or because the set was destroyed.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultFindingResolutionNote),
+			},
+			"findings": schema.ListNestedAttribute{
+				Description: "The currently reported findings. Each source_type/source_id pair must be unique.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source_type": schema.StringAttribute{
+							Description: "The source type of the finding (scorecard, security, policy).",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("scorecard", "security", "policy"),
+							},
+						},
+						"source_id": schema.StringAttribute{
+							Description: "The identifier of the finding within its source.",
+							Required:    true,
+						},
+						"entity_id": schema.StringAttribute{
+							Description: "The entity ID the finding applies to.",
+							Required:    true,
+						},
+						"entity_name": schema.StringAttribute{
+							Description: "The display name of the entity.",
+							Optional:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the governance action.",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "A description of the governance action.",
+							Optional:    true,
+						},
+						"priority": schema.StringAttribute{
+							Description: "The priority level (critical, high, medium, low).",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("critical", "high", "medium", "low"),
+							},
+						},
+						"sla_days": schema.Int64Attribute{
+							Description: "The SLA in days for resolving the action.",
+							Optional:    true,
+						},
+						"assigned_to": schema.StringAttribute{
+							Description: "The user or team the action is assigned to.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"action_ids": schema.MapAttribute{
+				Description: "The governance action ID tracking each finding, keyed by source_type/source_id.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *GovernanceActionSetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *GovernanceActionSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config GovernanceActionSetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Findings.IsNull() || config.Findings.IsUnknown() {
+		return
+	}
+
+	var findings []GovernanceFindingModel
+	resp.Diagnostics.Append(config.Findings.ElementsAs(ctx, &findings, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]int, len(findings))
+	for i, f := range findings {
+		if f.SourceType.IsUnknown() || f.SourceID.IsUnknown() {
+			continue
+		}
+		key := f.key()
+		if first, dup := seen[key]; dup {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("findings").AtListIndex(i),
+				"Duplicate Finding",
+				fmt.Sprintf("Finding %q is already listed at findings[%d]. Each source_type/source_id pair must be unique.", key, first),
+			)
+			continue
+		}
+		seen[key] = i
+	}
+}
+
+func (r *GovernanceActionSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "creating governance action set")
+
+	var plan GovernanceActionSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var desired []GovernanceFindingModel
+	resp.Diagnostics.Append(plan.Findings.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tracked, diags := reconcileGovernanceFindings(ctx, r.client, desired, nil, nil, plan.ResolutionNote.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	plan.ID = plan.Name
+	resp.Diagnostics.Append(setGovernanceFindingsState(ctx, &plan, desired, nil, tracked)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *GovernanceActionSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "reading governance action set")
+
+	var state GovernanceActionSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var findings []GovernanceFindingModel
+	resp.Diagnostics.Append(state.Findings.ElementsAs(ctx, &findings, false)...)
+	actionIDs := map[string]string{}
+	resp.Diagnostics.Append(state.ActionIDs.ElementsAs(ctx, &actionIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tracked := make(map[string]string, len(actionIDs))
+	refreshed := make([]GovernanceFindingModel, 0, len(findings))
+	for _, f := range findings {
+		key := f.key()
+		id, ok := actionIDs[key]
+		if !ok {
+			continue
+		}
+
+		action, err := r.client.GetGovernanceAction(ctx, id)
+		if err != nil {
+			if client.IsNotFound(err) {
+				tflog.Warn(ctx, "governance action for finding not found, dropping finding from state", map[string]any{"finding": key, "id": id})
+				continue
+			}
+			resp.Diagnostics.AddError("Error Reading Governance Action", fmt.Sprintf("Could not read governance action %s for finding %s: %s", id, key, err))
+			return
+		}
+
+		// A finding resolved outside Terraform but still reported needs a new action.
+		if action.Status == client.GovernanceStatusResolved {
+			tflog.Info(ctx, "governance action for finding was resolved outside Terraform", map[string]any{"finding": key, "id": id})
+			continue
+		}
+
+		mapGovernanceActionToFinding(action, &f)
+		refreshed = append(refreshed, f)
+		tracked[key] = id
+	}
+
+	resp.Diagnostics.Append(setGovernanceFindingsState(ctx, &state, refreshed, nil, tracked)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *GovernanceActionSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "updating governance action set")
+
+	var plan, state GovernanceActionSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var desired, previous []GovernanceFindingModel
+	resp.Diagnostics.Append(plan.Findings.ElementsAs(ctx, &desired, false)...)
+	resp.Diagnostics.Append(state.Findings.ElementsAs(ctx, &previous, false)...)
+	actionIDs := map[string]string{}
+	resp.Diagnostics.Append(state.ActionIDs.ElementsAs(ctx, &actionIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tracked, diags := reconcileGovernanceFindings(ctx, r.client, desired, previous, actionIDs, plan.ResolutionNote.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(setGovernanceFindingsState(ctx, &plan, desired, previous, tracked)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *GovernanceActionSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "deleting governance action set")
+
+	var state GovernanceActionSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actionIDs := map[string]string{}
+	resp.Diagnostics.Append(state.ActionIDs.ElementsAs(ctx, &actionIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Actions are resolved rather than deleted so their history is kept.
+	for _, key := range sortedKeys(actionIDs) {
+		if err := resolveGovernanceAction(ctx, r.client, actionIDs[key], state.ResolutionNote.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error Resolving Governance Action", fmt.Sprintf("Could not resolve governance action %s for finding %s: %s", actionIDs[key], key, err))
+		}
+	}
+}
+
+// reconcileGovernanceFindings brings governance actions in line with the
+// desired findings. previous and actionIDs describe what the set tracked
+// before; both are nil on create. It returns the action ID tracked for each
+// finding key afterwards, including keys whose action could not be resolved,
+// so a failed run leaves state that the next apply can retry from.
+func reconcileGovernanceFindings(ctx context.Context, c *client.Client, desired, previous []GovernanceFindingModel, actionIDs map[string]string, note string) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	tracked := make(map[string]string, len(desired))
+
+	previousByKey := make(map[string]GovernanceFindingModel, len(previous))
+	for _, f := range previous {
+		previousByKey[f.key()] = f
+	}
+	desiredKeys := make(map[string]bool, len(desired))
+	for _, f := range desired {
+		desiredKeys[f.key()] = true
+	}
+
+	// Resolve actions whose finding disappeared.
+	for _, key := range sortedKeys(actionIDs) {
+		if desiredKeys[key] {
+			continue
+		}
+		id := actionIDs[key]
+		tflog.Debug(ctx, "resolving governance action for disappeared finding", map[string]any{"finding": key, "id": id})
+		if err := resolveGovernanceAction(ctx, c, id, note); err != nil {
+			diags.AddError("Error Resolving Governance Action", fmt.Sprintf("Could not resolve governance action %s for finding %s: %s", id, key, err))
+			tracked[key] = id
+		}
+	}
+
+	// Open actions are only listed if some finding is not tracked yet.
+	var open map[string]*client.GovernanceAction
+
+	for _, f := range desired {
+		key := f.key()
+
+		if id, ok := actionIDs[key]; ok {
+			prev := previousByKey[key]
+			if governanceFindingReplaced(prev, f) {
+				tflog.Debug(ctx, "finding changed immutable fields, replacing its governance action", map[string]any{"finding": key, "id": id})
+				if err := resolveGovernanceAction(ctx, c, id, note); err != nil {
+					diags.AddError("Error Resolving Governance Action", fmt.Sprintf("Could not resolve governance action %s for finding %s: %s", id, key, err))
+					tracked[key] = id
+					continue
+				}
+			} else {
+				if update := governanceFindingUpdate(prev, f); !update.IsEmpty() {
+					if err := c.UpdateGovernanceAction(ctx, id, update); err != nil {
+						diags.AddError("Error Updating Governance Action", fmt.Sprintf("Could not update governance action %s for finding %s: %s", id, key, err))
+					}
+				}
+				tracked[key] = id
+				continue
+			}
+		}
+
+		if open == nil {
+			var err error
+			open, err = listOpenGovernanceActions(ctx, c)
+			if err != nil {
+				diags.AddError("Error Reading Governance Actions", fmt.Sprintf("Could not list governance actions: %s", err))
+				return tracked, diags
+			}
+		}
+
+		if existing, ok := open[key]; ok {
+			tflog.Debug(ctx, "adopting existing governance action for finding", map[string]any{"finding": key, "id": existing.ID})
+			if update := governanceFindingUpdate(governanceFindingFromAction(existing), f); !update.IsEmpty() {
+				if err := c.UpdateGovernanceAction(ctx, existing.ID, update); err != nil {
+					diags.AddError("Error Updating Governance Action", fmt.Sprintf("Could not update governance action %s for finding %s: %s", existing.ID, key, err))
+				}
+			}
+			tracked[key] = existing.ID
+			continue
+		}
+
+		action, err := c.CreateGovernanceAction(ctx, governanceFindingCreate(f))
+		if err != nil {
+			diags.AddError("Error Creating Governance Action", fmt.Sprintf("Could not create governance action for finding %s: %s", key, err))
+			continue
+		}
+		tracked[key] = action.ID
+	}
+
+	return tracked, diags
+}
+
+// listOpenGovernanceActions indexes open and in-progress actions by finding key.
+func listOpenGovernanceActions(ctx context.Context, c *client.Client) (map[string]*client.GovernanceAction, error) {
+	actions, _, err := c.ListGovernanceActions(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	open := make(map[string]*client.GovernanceAction, len(actions))
+	for i := range actions {
+		a := &actions[i]
+		if a.IsClosed() || a.SourceID == "" {
+			continue
+		}
+		open[governanceFindingKey(a.SourceType, a.SourceID)] = a
+	}
+	return open, nil
+}
+
+// resolveGovernanceAction marks an action resolved with the given note.
+// Actions that are already closed or no longer exist are left alone. Open
+// actions are moved to in_progress first, as the workflow only allows
+// resolving work that has been started.
+func resolveGovernanceAction(ctx context.Context, c *client.Client, id, note string) error {
+	action, err := c.GetGovernanceAction(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if action.IsClosed() {
+		return nil
+	}
+
+	if !client.ValidGovernanceTransition(action.Status, client.GovernanceStatusResolved) {
+		inProgress := client.GovernanceStatusInProgress
+		if err := c.UpdateGovernanceAction(ctx, id, client.UpdateGovernanceActionRequest{Status: &inProgress}); err != nil {
+			return err
+		}
+	}
+
+	status := client.GovernanceStatusResolved
+	return c.UpdateGovernanceAction(ctx, id, client.UpdateGovernanceActionRequest{
+		Status:         &status,
+		ResolutionNote: &note,
+	})
+}
+
+// governanceFindingReplaced reports whether a finding changed fields the API
+// cannot update in place, so its action has to be resolved and reopened.
+func governanceFindingReplaced(prev, next GovernanceFindingModel) bool {
+	return !prev.EntityID.Equal(next.EntityID) ||
+		!prev.Title.Equal(next.Title) ||
+		!prev.Description.Equal(next.Description)
+}
+
+// governanceFindingUpdate builds a PATCH carrying the fields that differ between two versions of a finding.
+func governanceFindingUpdate(prev, next GovernanceFindingModel) client.UpdateGovernanceActionRequest {
+	req := client.UpdateGovernanceActionRequest{}
+	if !prev.Priority.Equal(next.Priority) {
+		v := next.Priority.ValueString()
+		req.Priority = &v
+	}
+	if !next.SLADays.IsNull() && !next.SLADays.Equal(prev.SLADays) {
+		v := int(next.SLADays.ValueInt64())
+		req.SLADays = &v
+	}
+	if !next.AssignedTo.IsNull() && !next.AssignedTo.Equal(prev.AssignedTo) {
+		v := next.AssignedTo.ValueString()
+		req.AssignedTo = &v
+	}
+	return req
+}
+
+// governanceFindingCreate builds the request that opens an action for a finding.
+func governanceFindingCreate(f GovernanceFindingModel) client.CreateGovernanceActionRequest {
+	req := client.CreateGovernanceActionRequest{
+		EntityID:    f.EntityID.ValueString(),
+		EntityName:  f.EntityName.ValueString(),
+		Title:       f.Title.ValueString(),
+		Description: f.Description.ValueString(),
+		Priority:    f.Priority.ValueString(),
+		SourceType:  f.SourceType.ValueString(),
+		SourceID:    f.SourceID.ValueString(),
+	}
+	if !f.AssignedTo.IsNull() && !f.AssignedTo.IsUnknown() {
+		v := f.AssignedTo.ValueString()
+		req.AssignedTo = &v
+	}
+	if !f.SLADays.IsNull() && !f.SLADays.IsUnknown() {
+		v := int(f.SLADays.ValueInt64())
+		req.SLADays = &v
+	}
+	return req
+}
+
+// governanceFindingFromAction describes an existing action as a finding, so it
+// can be diffed against the desired finding when adopted.
+func governanceFindingFromAction(action *client.GovernanceAction) GovernanceFindingModel {
+	f := GovernanceFindingModel{
+		EntityName:  types.StringNull(),
+		Description: types.StringNull(),
+		SLADays:     types.Int64Null(),
+		AssignedTo:  types.StringNull(),
+	}
+	mapGovernanceActionToFinding(action, &f)
+	return f
+}
+
+// mapGovernanceActionToFinding refreshes a finding from its action. Optional
+// fields the API returns empty keep their current value to avoid "" -> null drift.
+func mapGovernanceActionToFinding(action *client.GovernanceAction, f *GovernanceFindingModel) {
+	f.SourceType = types.StringValue(action.SourceType)
+	f.SourceID = types.StringValue(action.SourceID)
+	f.EntityID = types.StringValue(action.EntityID)
+	f.Title = types.StringValue(action.Title)
+	f.Priority = types.StringValue(action.Priority)
+
+	if action.EntityName != "" {
+		f.EntityName = types.StringValue(action.EntityName)
+	}
+	if action.Description != "" {
+		f.Description = types.StringValue(action.Description)
+	}
+	if action.AssignedTo != "" {
+		f.AssignedTo = types.StringValue(action.AssignedTo)
+	}
+	if action.SLADays != nil {
+		f.SLADays = types.Int64Value(int64(*action.SLADays))
+	}
+}
+
+// setGovernanceFindingsState records the tracked findings and their action IDs.
+// Desired findings without an action (because creating it failed) are left out
+// so the next plan retries them; previous findings whose action could not be
+// resolved are kept so the next apply retries the resolution.
+func setGovernanceFindingsState(ctx context.Context, model *GovernanceActionSetResourceModel, desired, previous []GovernanceFindingModel, tracked map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	findings := make([]GovernanceFindingModel, 0, len(tracked))
+	included := make(map[string]bool, len(tracked))
+	for _, f := range desired {
+		if _, ok := tracked[f.key()]; ok {
+			findings = append(findings, f)
+			included[f.key()] = true
+		}
+	}
+	for _, f := range previous {
+		if _, ok := tracked[f.key()]; ok && !included[f.key()] {
+			findings = append(findings, f)
+			included[f.key()] = true
+		}
+	}
+
+	list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: governanceFindingAttrTypes()}, findings)
+	diags.Append(d...)
+	ids, d := types.MapValueFrom(ctx, types.StringType, tracked)
+	diags.Append(d...)
+
+	model.Findings = list
+	model.ActionIDs = ids
+	return diags
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
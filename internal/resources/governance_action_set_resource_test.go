@@ -0,0 +1,297 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func TestGovernanceActionSetResource_Metadata(t *testing.T) {
+	r := NewGovernanceActionSetResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_governance_action_set" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_governance_action_set")
+	}
+}
+
+func TestGovernanceActionSetResource_Schema_HasRequiredAttributes(t *testing.T) {
+	r := NewGovernanceActionSetResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	for _, name := range []string{"id", "name", "resolution_note", "findings", "action_ids"} {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("schema missing attribute %q", name)
+		}
+	}
+	if !resp.Schema.Attributes["findings"].IsRequired() {
+		t.Error("findings should be required")
+	}
+	if !resp.Schema.Attributes["action_ids"].IsComputed() {
+		t.Error("action_ids should be computed")
+	}
+}
+
+func TestGovernanceActionSetResource_Configure_WithValidClient(t *testing.T) {
+	r := &GovernanceActionSetResource{}
+	c := client.NewClient("https://test.example.com", "key", 30*time.Second)
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: c,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if r.client != c {
+		t.Error("client not set correctly")
+	}
+}
+
+func TestGovernanceActionSetResource_Configure_WrongType(t *testing.T) {
+	r := &GovernanceActionSetResource{}
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: "not a client",
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected error for wrong provider data type")
+	}
+}
+
+// governanceStore is a minimal in-memory governance actions API.
+type governanceStore struct {
+	mu      sync.Mutex
+	actions map[string]*client.GovernanceAction
+	nextID  int
+	creates int
+	patches map[string][]map[string]interface{}
+}
+
+func newGovernanceStore(t *testing.T, existing ...client.GovernanceAction) (*governanceStore, *client.Client) {
+	t.Helper()
+	s := &governanceStore{actions: map[string]*client.GovernanceAction{}, patches: map[string][]map[string]interface{}{}}
+	for i := range existing {
+		a := existing[i]
+		s.actions[a.ID] = &a
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/governance/actions/")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/governance/actions":
+			list := []client.GovernanceAction{}
+			for _, a := range s.actions {
+				list = append(list, *a)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"actions": list, "total": len(list)})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/governance/actions":
+			var req client.CreateGovernanceActionRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			s.nextID++
+			s.creates++
+			a := &client.GovernanceAction{
+				ID: fmt.Sprintf("act-%d", s.nextID), EntityID: req.EntityID, Title: req.Title, Priority: req.Priority,
+				Status: client.GovernanceStatusOpen, SourceType: req.SourceType, SourceID: req.SourceID, SLADays: req.SLADays,
+			}
+			s.actions[a.ID] = a
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": a.ID})
+		case r.Method == http.MethodGet:
+			a, ok := s.actions[id]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(a)
+		case r.Method == http.MethodPatch:
+			a, ok := s.actions[id]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			var patch map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&patch)
+			s.patches[id] = append(s.patches[id], patch)
+			if v, ok := patch["status"].(string); ok {
+				a.Status = v
+			}
+			if v, ok := patch["priority"].(string); ok {
+				a.Priority = v
+			}
+			if v, ok := patch["resolution_note"].(string); ok {
+				a.ResolutionNote = v
+			}
+			if v, ok := patch["sla_days"].(float64); ok {
+				days := int(v)
+				a.SLADays = &days
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"message": "ok"})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return s, client.NewClient(server.URL, "key", 30*time.Second)
+}
+
+func testFinding(sourceID, priority string) GovernanceFindingModel {
+	return GovernanceFindingModel{
+		SourceType:  types.StringValue("security"),
+		SourceID:    types.StringValue(sourceID),
+		EntityID:    types.StringValue("payments"),
+		EntityName:  types.StringNull(),
+		Title:       types.StringValue("Fix " + sourceID),
+		Description: types.StringNull(),
+		Priority:    types.StringValue(priority),
+		SLADays:     types.Int64Null(),
+		AssignedTo:  types.StringNull(),
+	}
+}
+
+func TestReconcileGovernanceFindings_CreatesAndAdopts(t *testing.T) {
+	store, c := newGovernanceStore(t, client.GovernanceAction{
+		ID: "existing", EntityID: "payments", Title: "Fix CVE-2", Priority: "low",
+		Status: client.GovernanceStatusOpen, SourceType: "security", SourceID: "CVE-2",
+	})
+
+	desired := []GovernanceFindingModel{testFinding("CVE-1", "high"), testFinding("CVE-2", "critical")}
+	tracked, diags := reconcileGovernanceFindings(context.Background(), c, desired, nil, nil, "gone")
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	if tracked["security/CVE-2"] != "existing" {
+		t.Errorf("CVE-2 should adopt the existing open action, got %q", tracked["security/CVE-2"])
+	}
+	if tracked["security/CVE-1"] == "" {
+		t.Error("CVE-1 should have a new action")
+	}
+	if store.creates != 1 {
+		t.Errorf("creates = %d, want 1", store.creates)
+	}
+	if store.actions["existing"].Priority != "critical" {
+		t.Errorf("adopted action priority = %q, want %q", store.actions["existing"].Priority, "critical")
+	}
+}
+
+func TestReconcileGovernanceFindings_UpdatesAndResolves(t *testing.T) {
+	store, c := newGovernanceStore(t,
+		client.GovernanceAction{ID: "act-a", EntityID: "payments", Title: "Fix CVE-1", Priority: "high", Status: client.GovernanceStatusOpen, SourceType: "security", SourceID: "CVE-1"},
+		client.GovernanceAction{ID: "act-b", EntityID: "payments", Title: "Fix CVE-2", Priority: "high", Status: client.GovernanceStatusOpen, SourceType: "security", SourceID: "CVE-2"},
+	)
+
+	previous := []GovernanceFindingModel{testFinding("CVE-1", "high"), testFinding("CVE-2", "high")}
+	actionIDs := map[string]string{"security/CVE-1": "act-a", "security/CVE-2": "act-b"}
+
+	updated := testFinding("CVE-1", "low")
+	updated.SLADays = types.Int64Value(30)
+
+	tracked, diags := reconcileGovernanceFindings(context.Background(), c, []GovernanceFindingModel{updated}, previous, actionIDs, "Scanner no longer reports it")
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	if len(tracked) != 1 || tracked["security/CVE-1"] != "act-a" {
+		t.Errorf("tracked = %v, want only CVE-1 -> act-a", tracked)
+	}
+	if a := store.actions["act-a"]; a.Priority != "low" || a.SLADays == nil || *a.SLADays != 30 {
+		t.Errorf("act-a = priority %q sla %v, want low/30", a.Priority, a.SLADays)
+	}
+	if a := store.actions["act-b"]; a.Status != client.GovernanceStatusResolved || a.ResolutionNote != "Scanner no longer reports it" {
+		t.Errorf("act-b = status %q note %q, want resolved with note", a.Status, a.ResolutionNote)
+	}
+	if store.creates != 0 {
+		t.Errorf("creates = %d, want 0", store.creates)
+	}
+}
+
+func TestReconcileGovernanceFindings_UnchangedSendsNothing(t *testing.T) {
+	store, c := newGovernanceStore(t,
+		client.GovernanceAction{ID: "act-a", EntityID: "payments", Title: "Fix CVE-1", Priority: "high", Status: client.GovernanceStatusOpen, SourceType: "security", SourceID: "CVE-1"},
+	)
+
+	findings := []GovernanceFindingModel{testFinding("CVE-1", "high")}
+	_, diags := reconcileGovernanceFindings(context.Background(), c, findings, findings, map[string]string{"security/CVE-1": "act-a"}, "gone")
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if len(store.patches["act-a"]) != 0 {
+		t.Errorf("unchanged finding should not be patched, got %v", store.patches["act-a"])
+	}
+}
+
+func TestReconcileGovernanceFindings_ReplacesOnTitleChange(t *testing.T) {
+	store, c := newGovernanceStore(t,
+		client.GovernanceAction{ID: "act-a", EntityID: "payments", Title: "Fix CVE-1", Priority: "high", Status: client.GovernanceStatusOpen, SourceType: "security", SourceID: "CVE-1"},
+	)
+
+	previous := []GovernanceFindingModel{testFinding("CVE-1", "high")}
+	renamed := testFinding("CVE-1", "high")
+	renamed.Title = types.StringValue("Upgrade openssl")
+
+	tracked, diags := reconcileGovernanceFindings(context.Background(), c, []GovernanceFindingModel{renamed}, previous, map[string]string{"security/CVE-1": "act-a"}, "gone")
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if store.actions["act-a"].Status != client.GovernanceStatusResolved {
+		t.Errorf("old action status = %q, want resolved", store.actions["act-a"].Status)
+	}
+	if id := tracked["security/CVE-1"]; id == "act-a" || store.actions[id] == nil || store.actions[id].Title != "Upgrade openssl" {
+		t.Errorf("finding should be tracked by a new action titled %q, got %q", "Upgrade openssl", id)
+	}
+}
+
+func TestResolveGovernanceAction_SkipsClosedAndMissing(t *testing.T) {
+	store, c := newGovernanceStore(t,
+		client.GovernanceAction{ID: "act-d", Status: client.GovernanceStatusDismissed},
+	)
+
+	if err := resolveGovernanceAction(context.Background(), c, "act-d", "gone"); err != nil {
+		t.Fatalf("resolveGovernanceAction() error = %v", err)
+	}
+	if err := resolveGovernanceAction(context.Background(), c, "missing", "gone"); err != nil {
+		t.Fatalf("resolveGovernanceAction() on missing action error = %v", err)
+	}
+	if store.actions["act-d"].Status != client.GovernanceStatusDismissed {
+		t.Errorf("dismissed action should not be reopened or resolved, got %q", store.actions["act-d"].Status)
+	}
+}
+
+func TestSetGovernanceFindingsState_KeepsOnlyTracked(t *testing.T) {
+	model := &GovernanceActionSetResourceModel{}
+	desired := []GovernanceFindingModel{testFinding("CVE-1", "high"), testFinding("CVE-2", "high")}
+	previous := []GovernanceFindingModel{testFinding("CVE-3", "low")}
+	tracked := map[string]string{"security/CVE-1": "act-1", "security/CVE-3": "act-3"}
+
+	if diags := setGovernanceFindingsState(context.Background(), model, desired, previous, tracked); diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	var findings []GovernanceFindingModel
+	model.Findings.ElementsAs(context.Background(), &findings, false)
+	if len(findings) != 2 || findings[0].key() != "security/CVE-1" || findings[1].key() != "security/CVE-3" {
+		t.Errorf("findings = %v, want CVE-1 and CVE-3", findings)
+	}
+	if len(model.ActionIDs.Elements()) != 2 {
+		t.Errorf("action_ids = %v, want 2 entries", model.ActionIDs)
+	}
+}
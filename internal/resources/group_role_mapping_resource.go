@@ -3,9 +3,7 @@ package resources
 import (
 	"context"
 	"fmt"
-	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -224,18 +222,49 @@ func (r *GroupRoleMappingResource) Delete(ctx context.Context, req resource.Dele
 	}
 }
 
+// ImportState imports a mapping from "group_name/role_name" or the legacy
+// "group_name:role_name". Group names may contain slashes, so the ID is split
+// at its last slash; when that reading matches no mapping, the colon form is
+// tried. description is not returned by the API and stays null.
 func (r *GroupRoleMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: group_name:role_name
-	parts := strings.SplitN(req.ID, ":", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+	candidates := importIDCandidates(req.ID)
+	if len(candidates) == 0 {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in format 'group_name:role_name' (note: group names must not contain colons), got: %s", req.ID),
+			fmt.Sprintf("Expected import ID in format 'group_name/role_name' or 'group_name:role_name', got: %s", req.ID),
 		)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_name"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_name"), parts[1])...)
+	for _, c := range candidates {
+		groupName, roleName := c[0], c[1]
+		roles, err := r.client.GetGroupRoles(ctx, groupName)
+		if err != nil {
+			if client.IsNotFound(err) {
+				continue
+			}
+			resp.Diagnostics.AddError("Error Importing Group Role Mapping", fmt.Sprintf("Could not get roles for group %q: %s", groupName, err))
+			return
+		}
+		for _, role := range roles {
+			if role.RoleName != roleName {
+				continue
+			}
+			provider := role.Provider
+			if provider == "" {
+				provider = "default"
+			}
+			state := GroupRoleMappingResourceModel{
+				ID:           types.StringValue(groupName + ":" + roleName),
+				GroupName:    types.StringValue(groupName),
+				RoleName:     types.StringValue(roleName),
+				AuthProvider: types.StringValue(provider),
+				Description:  types.StringNull(),
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError("Error Importing Group Role Mapping", fmt.Sprintf("No group role mapping matches import ID %s.", req.ID))
 }
@@ -7,12 +7,12 @@ import (
 	"testing"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
 )
 
 func TestGroupRoleMappingResource_Metadata(t *testing.T) {
@@ -113,30 +113,73 @@ func TestGroupRoleMappingResource_Update_ReturnsError(t *testing.T) {
 	}
 }
 
-func TestGroupRoleMappingResource_ImportState_ValidID(t *testing.T) {
-	r := NewGroupRoleMappingResource().(*GroupRoleMappingResource)
-	resp := &resource.ImportStateResponse{
-		State: newGroupRoleMappingState(),
+// groupRoleMappingTestClient returns a client for a fake API where the
+// platform-team and eng/platform groups hold the tenant:admin role.
+func groupRoleMappingTestClient(t *testing.T) *client.Client {
+	t.Helper()
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	for _, group := range []string{"platform-team", "eng/platform"} {
+		api.AddGroup(client.Group{Name: group})
+		if err := c.AssignGroupRole(context.Background(), group, client.GroupRoleRequest{RoleName: "tenant:admin", Provider: "okta"}); err != nil {
+			t.Fatalf("AssignGroupRole() error = %v", err)
+		}
 	}
-	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "platform-team:tenant:admin"}, resp)
+	return c
+}
 
-	if resp.Diagnostics.HasError() {
-		t.Fatalf("ImportState() unexpected error: %v", resp.Diagnostics)
-	}
+func TestGroupRoleMappingResource_ImportState_ValidID(t *testing.T) {
+	r := &GroupRoleMappingResource{client: groupRoleMappingTestClient(t)}
+	ctx := context.Background()
+
+	tests := []struct {
+		id        string
+		wantGroup string
+	}{
+		{"platform-team/tenant:admin", "platform-team"},
+		{"platform-team:tenant:admin", "platform-team"},
+		{"eng/platform/tenant:admin", "eng/platform"},
+	}
+	for _, tt := range tests {
+		resp := &resource.ImportStateResponse{
+			State: newGroupRoleMappingState(),
+		}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("ImportState(%q) unexpected error: %v", tt.id, resp.Diagnostics)
+		}
 
-	var groupName, roleName string
-	resp.State.GetAttribute(context.Background(), path.Root("group_name"), &groupName)
-	resp.State.GetAttribute(context.Background(), path.Root("role_name"), &roleName)
+		var got GroupRoleMappingResourceModel
+		resp.State.Get(ctx, &got)
+		if got.GroupName.ValueString() != tt.wantGroup {
+			t.Errorf("ImportState(%q): group_name = %q, want %q", tt.id, got.GroupName.ValueString(), tt.wantGroup)
+		}
+		if got.RoleName.ValueString() != "tenant:admin" {
+			t.Errorf("ImportState(%q): role_name = %q, want %q", tt.id, got.RoleName.ValueString(), "tenant:admin")
+		}
+		if got.AuthProvider.ValueString() != "okta" {
+			t.Errorf("ImportState(%q): auth_provider = %q, want %q", tt.id, got.AuthProvider.ValueString(), "okta")
+		}
+		if got.ID.ValueString() != tt.wantGroup+":tenant:admin" {
+			t.Errorf("ImportState(%q): id = %q", tt.id, got.ID.ValueString())
+		}
+	}
+}
 
-	if groupName != "platform-team" {
-		t.Errorf("group_name = %q, want %q", groupName, "platform-team")
+func TestGroupRoleMappingResource_ImportState_UnknownMapping(t *testing.T) {
+	r := &GroupRoleMappingResource{client: groupRoleMappingTestClient(t)}
+	resp := &resource.ImportStateResponse{
+		State: newGroupRoleMappingState(),
 	}
-	if roleName != "tenant:admin" {
-		t.Errorf("role_name = %q, want %q", roleName, "tenant:admin")
+	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "platform-team/editor"}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("ImportState() should return error for a mapping that does not exist")
 	}
 }
 
-func TestGroupRoleMappingResource_ImportState_InvalidID_NoColon(t *testing.T) {
+func TestGroupRoleMappingResource_ImportState_InvalidID_NoSeparator(t *testing.T) {
 	r := NewGroupRoleMappingResource().(*GroupRoleMappingResource)
 	resp := &resource.ImportStateResponse{
 		State: newGroupRoleMappingState(),
@@ -144,7 +187,7 @@ func TestGroupRoleMappingResource_ImportState_InvalidID_NoColon(t *testing.T) {
 	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "invalid-no-colon"}, resp)
 
 	if !resp.Diagnostics.HasError() {
-		t.Error("ImportState() should return error for ID without colon")
+		t.Error("ImportState() should return error for ID without a separator")
 	}
 }
 
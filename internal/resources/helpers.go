@@ -1,11 +1,40 @@
 package resources
 
 import (
+	"context"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// pollInterval is the delay between status checks while waiting for
+// long-running server-side operations. Tests shorten it.
+var pollInterval = 5 * time.Second
+
+// waitFor calls check immediately and then every pollInterval until it reports
+// done, returns an error, or ctx is done. Callers bound the wait by passing a
+// context with a deadline; on expiry the context error is returned.
+func waitFor(ctx context.Context, check func(ctx context.Context) (bool, error)) error {
+	for {
+		done, err := check(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
 // stringValueOrNull returns a types.StringValue for non-empty strings,
 // or types.StringNull for empty strings. This ensures that optional fields
 // are properly cleared in Terraform state when the API returns empty values.
@@ -59,3 +88,47 @@ func yamlQuote(s string) string {
 	s = strings.ReplaceAll(s, "\n", `\n`)
 	return `"` + s + `"`
 }
+
+// stringSetValues returns the strings in a set. Null and unknown sets yield none.
+func stringSetValues(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	var values []string
+	if set.IsNull() || set.IsUnknown() {
+		return values, nil
+	}
+	diags := set.ElementsAs(ctx, &values, false)
+	return values, diags
+}
+
+// setDifference returns the values in a that are not in b, sorted.
+func setDifference(a, b []string) []string {
+	exclude := make(map[string]bool, len(b))
+	for _, v := range b {
+		exclude[v] = true
+	}
+	var diff []string
+	for _, v := range a {
+		if !exclude[v] {
+			diff = append(diff, v)
+			exclude[v] = true
+		}
+	}
+	sort.Strings(diff)
+	return diff
+}
+
+// setIntersection returns the values present in both a and b, sorted.
+func setIntersection(a, b []string) []string {
+	keep := make(map[string]bool, len(b))
+	for _, v := range b {
+		keep[v] = true
+	}
+	var both []string
+	for _, v := range a {
+		if keep[v] {
+			both = append(both, v)
+			keep[v] = false
+		}
+	}
+	sort.Strings(both)
+	return both
+}
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -66,3 +67,15 @@ func TestStringValueOrNull_Empty(t *testing.T) {
 		t.Error("stringValueOrNull(\"\") should be null")
 	}
 }
+
+func TestSetDifferenceAndIntersection(t *testing.T) {
+	a := []string{"viewer", "admin", "editor", "admin"}
+	b := []string{"editor", "owner"}
+
+	if got := strings.Join(setDifference(a, b), ","); got != "admin,viewer" {
+		t.Errorf("setDifference() = %q, want %q", got, "admin,viewer")
+	}
+	if got := strings.Join(setIntersection(a, b), ","); got != "editor" {
+		t.Errorf("setIntersection() = %q, want %q", got, "editor")
+	}
+}
@@ -0,0 +1,49 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Resources that can be listed with terraform query declare a resource
+// identity: a single string attribute holding the same ID their ImportState
+// accepts. The identity is set whenever state is written, so list results,
+// import blocks with identity = {...} and refreshed state all agree.
+
+// stringIdentitySchema returns an identity schema with one string attribute.
+func stringIdentitySchema(attr, description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			attr: identityschema.StringAttribute{
+				Description:       description,
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// setStringIdentity sets a single-attribute identity. identity is nil when
+// the resource is called without one, as in unit tests.
+func setStringIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, attr string, value types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.SetAttribute(ctx, path.Root(attr), value)
+}
+
+// importIDFromIdentity returns the import ID, taken from the identity when
+// the resource is imported with an identity instead of an ID.
+func importIDFromIdentity(ctx context.Context, req resource.ImportStateRequest, attr string) (string, diag.Diagnostics) {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID, nil
+	}
+	var id types.String
+	diags := req.Identity.GetAttribute(ctx, path.Root(attr), &id)
+	return id.ValueString(), diags
+}
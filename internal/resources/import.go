@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// importIDCandidates returns the ways a two-part import ID can be read, in
+// order of preference: "first/second", split at the last slash because the
+// second part (a role) never contains one, then the legacy "first:second",
+// split at the first colon. Readings with an empty part are left out.
+func importIDCandidates(id string) [][2]string {
+	var candidates [][2]string
+	if i := strings.LastIndex(id, "/"); i > 0 && i < len(id)-1 {
+		candidates = append(candidates, [2]string{id[:i], id[i+1:]})
+	}
+	if first, second, ok := strings.Cut(id, ":"); ok && first != "" && second != "" {
+		candidates = append(candidates, [2]string{first, second})
+	}
+	return candidates
+}
+
+// expiresInDays recovers the expires_in_days a credential was created with
+// from its creation and expiry timestamps. It returns null if the credential
+// never expires or the timestamps cannot be parsed.
+func expiresInDays(createdAt, expiresAt string) types.Int64 {
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return types.Int64Null()
+	}
+	expires, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(math.Round(expires.Sub(created).Hours() / 24)))
+}
+
+// nullTimeouts returns an unset timeouts block for a resource whose schema
+// declares timeouts for the given operations, such as "create".
+func nullTimeouts(operations ...string) timeouts.Value {
+	attrTypes := make(map[string]attr.Type, len(operations))
+	for _, op := range operations {
+		attrTypes[op] = types.StringType
+	}
+	return timeouts.Value{Object: types.ObjectNull(attrTypes)}
+}
@@ -0,0 +1,185 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+// newImportResponse returns an ImportStateResponse with the empty state
+// Terraform passes to ImportState for r.
+func newImportResponse(r resource.Resource) *resource.ImportStateResponse {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	return &resource.ImportStateResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}}
+}
+
+func TestImportIDCandidates(t *testing.T) {
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{"platform-team/tenant:admin", "[[platform-team tenant:admin] [platform-team/tenant admin]]"},
+		{"eng/platform/admin", "[[eng/platform admin]]"},
+		{"u-1:tenant:admin", "[[u-1 tenant:admin]]"},
+		{"ada@example.com/admin", "[[ada@example.com admin]]"},
+		{"no-separator", "[]"},
+		{"/admin", "[]"},
+		{"group/", "[]"},
+		{":", "[]"},
+		{"", "[]"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprint(importIDCandidates(tt.id)); got != tt.want {
+			t.Errorf("importIDCandidates(%q) = %s, want %s", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestExpiresInDays(t *testing.T) {
+	tests := []struct {
+		createdAt, expiresAt string
+		want                 types.Int64
+	}{
+		{"2025-01-01T00:00:00Z", "2025-03-02T00:00:00Z", types.Int64Value(60)},
+		{"2025-01-01T00:00:00Z", "2025-01-31T00:00:05Z", types.Int64Value(30)},
+		{"2025-01-01T00:00:00Z", "", types.Int64Null()},
+		{"not a time", "2025-01-31T00:00:00Z", types.Int64Null()},
+	}
+	for _, tt := range tests {
+		if got := expiresInDays(tt.createdAt, tt.expiresAt); !got.Equal(tt.want) {
+			t.Errorf("expiresInDays(%q, %q) = %s, want %s", tt.createdAt, tt.expiresAt, got, tt.want)
+		}
+	}
+}
+
+func TestEntityResource_ImportState_MatchesConfig(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	ctx := context.Background()
+
+	config := EntityResourceModel{
+		Name:        types.StringValue("payments-api"),
+		Type:        types.StringValue("service"),
+		Description: types.StringValue("Payments API"),
+		Lifecycle:   types.StringValue("production"),
+		Owner:       types.StringValue("payments"),
+		Tags:        types.SetValueMust(types.StringType, []attr.Value{types.StringValue("go")}),
+		Links:       types.StringValue(`[{"name":"Runbook","url":"https://runbooks.example.com/payments"}]`),
+		Relations:   types.StringValue(`[{"type":"depends_on","target":"service:ledger"}]`),
+	}
+	if _, err := c.CreateEntity(ctx, client.CreateEntityRequest{Content: buildManifestYAML(&config), Source: "terraform"}); err != nil {
+		t.Fatalf("CreateEntity() error = %v", err)
+	}
+
+	r := &EntityResource{client: c}
+	resp := newImportResponse(r)
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "payments-api"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ImportState() error = %v", resp.Diagnostics)
+	}
+
+	var got EntityResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("State.Get() error = %v", resp.Diagnostics)
+	}
+	// Configuration generated from the imported state must plan cleanly, so
+	// every configurable attribute has to come back as it was written.
+	for name, pair := range map[string][2]interface{ String() string }{
+		"name":             {got.Name, config.Name},
+		"type":             {got.Type, config.Type},
+		"description":      {got.Description, config.Description},
+		"entity_lifecycle": {got.Lifecycle, config.Lifecycle},
+		"owner":            {got.Owner, config.Owner},
+		"tags":             {got.Tags, config.Tags},
+		"tier":             {got.Tier, types.StringNull()},
+	} {
+		if pair[0].String() != pair[1].String() {
+			t.Errorf("%s = %s, want %s", name, pair[0], pair[1])
+		}
+	}
+	if !linksEquivalent(got.Links.ValueString(), config.Links.ValueString()) {
+		t.Errorf("links = %s, want %s", got.Links, config.Links)
+	}
+	if !relationsEquivalent(got.Relations.ValueString(), config.Relations.ValueString()) {
+		t.Errorf("relations = %s, want %s", got.Relations, config.Relations)
+	}
+}
+
+func TestTeamResource_ImportState_HydratesMembers(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	ctx := context.Background()
+
+	team, err := c.CreateTeam(ctx, client.CreateTeamRequest{Name: "Payments", Slug: "payments", Description: "Payments team"})
+	if err != nil {
+		t.Fatalf("CreateTeam() error = %v", err)
+	}
+	if _, err := c.UpdateTeam(ctx, team.ID, client.UpdateTeamRequest{AddMembers: []client.AddMemberRequest{{UserID: "u-1", Role: "manager"}}}); err != nil {
+		t.Fatalf("UpdateTeam() error = %v", err)
+	}
+
+	r := &TeamResource{client: c}
+	resp := newImportResponse(r)
+	r.ImportState(ctx, resource.ImportStateRequest{ID: team.ID}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ImportState() error = %v", resp.Diagnostics)
+	}
+
+	var got TeamResourceModel
+	resp.State.Get(ctx, &got)
+	if got.Slug.ValueString() != "payments" || got.Description.ValueString() != "Payments team" {
+		t.Errorf("slug = %s, description = %s", got.Slug, got.Description)
+	}
+	if !membersEquivalent(got.Members.ValueString(), `[{"user_id":"u-1","role":"manager"}]`) {
+		t.Errorf("members = %s, want the manager u-1", got.Members)
+	}
+}
+
+func TestUserRoleResource_ImportState(t *testing.T) {
+	c := userRolesTestClient(t)
+	r := &UserRoleResource{client: c}
+	ctx := context.Background()
+
+	for id, wantEmail := range map[string]types.String{
+		"u-1/admin":             types.StringNull(),
+		"ada@example.com/admin": types.StringValue("ada@example.com"),
+		"u-1:admin":             types.StringNull(),
+	} {
+		resp := newImportResponse(r)
+		r.ImportState(ctx, resource.ImportStateRequest{ID: id}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("ImportState(%q) error = %v", id, resp.Diagnostics)
+		}
+
+		var got UserRoleResourceModel
+		resp.State.Get(ctx, &got)
+		if got.UserID.ValueString() != "u-1" || got.Role.ValueString() != "admin" || !got.UserEmail.Equal(wantEmail) {
+			t.Errorf("ImportState(%q): user_id = %s, role = %s, user_email = %s, want user_email %s", id, got.UserID, got.Role, got.UserEmail, wantEmail)
+		}
+		if got.ID.ValueString() != "u-1:admin" || got.Email.ValueString() != "ada@example.com" {
+			t.Errorf("ImportState(%q): id = %s, email = %s", id, got.ID, got.Email)
+		}
+	}
+
+	resp := newImportResponse(r)
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "u-1/owner"}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("ImportState() should fail for a role the user does not hold")
+	}
+}
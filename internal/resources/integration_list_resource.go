@@ -0,0 +1,95 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ list.ListResourceWithConfigure = &IntegrationListResource{}
+
+// IntegrationListResource lists integrations for terraform query.
+type IntegrationListResource struct {
+	client *client.Client
+}
+
+// IntegrationListConfigModel describes the list block configuration.
+type IntegrationListConfigModel struct {
+	Type   types.String `tfsdk:"type"`
+	TeamID types.String `tfsdk:"team_id"`
+}
+
+// NewIntegrationListResource creates a new integration list resource.
+func NewIntegrationListResource() list.ListResource {
+	return &IntegrationListResource{}
+}
+
+func (r *IntegrationListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+func (r *IntegrationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists Shoehorn integrations. Secret config values are masked by the API, so config_json in included resources must be completed before applying.",
+		Attributes: map[string]listschema.Attribute{
+			"type": listschema.StringAttribute{
+				Description: "Only list integrations of this type, e.g. github.",
+				Optional:    true,
+			},
+			"team_id": listschema.StringAttribute{
+				Description: "Only list integrations scoped to this team ID.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *IntegrationListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListClient(req, resp)
+}
+
+func (r *IntegrationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config IntegrationListConfigModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	integrations, err := r.client.ListIntegrations(ctx)
+	if err != nil {
+		listError(stream, "Error Listing Integrations", fmt.Sprintf("Could not list integrations: %s", err))
+		return
+	}
+	var matched []client.Integration
+	for _, i := range integrations {
+		if matchesFilter(config.Type, i.Type) && matchesFilter(config.TeamID, i.TeamID) {
+			matched = append(matched, i)
+		}
+	}
+
+	stream.Results = listResults(ctx, req, matched, func(i client.Integration, result *list.ListResult) {
+		id := strconv.Itoa(i.ID)
+		result.DisplayName = i.Name
+		result.Diagnostics.Append(setStringIdentity(ctx, result.Identity, "id", types.StringValue(id))...)
+		if !req.IncludeResource {
+			return
+		}
+
+		configJSON, err := json.Marshal(i.Config)
+		if err != nil {
+			result.Diagnostics.AddError("Error Reading Integration", fmt.Sprintf("Could not encode the config of integration %s: %s", id, err))
+			return
+		}
+		var state IntegrationResourceModel
+		mapIntegrationToState(&i, &state)
+		state.ConfigJSON = types.StringValue(string(configJSON))
+		result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+	})
+}
@@ -19,6 +19,8 @@ import (
 var (
 	_ resource.Resource                = &IntegrationResource{}
 	_ resource.ResourceWithImportState = &IntegrationResource{}
+	_ resource.ResourceWithIdentity    = &IntegrationResource{}
+	_ resource.ResourceWithModifyPlan  = &IntegrationResource{}
 )
 
 // IntegrationResource defines the resource implementation.
@@ -97,6 +99,10 @@ func (r *IntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *IntegrationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("id", "The numeric integration ID.")
+}
+
 func (r *IntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -114,6 +120,24 @@ func (r *IntegrationResource) Configure(_ context.Context, req resource.Configur
 	r.client = c
 }
 
+// ModifyPlan validates team_id when the provider enables validate_references.
+func (r *IntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state IntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateChangedReference(ctx, r.client, path.Root("team_id"), plan.TeamID, state.TeamID, referenceTeam)...)
+}
+
 func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Debug(ctx, "creating integration")
 
@@ -146,6 +170,7 @@ func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateReq
 
 	mapIntegrationToState(integration, &plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", plan.ID)...)
 }
 
 func (r *IntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -177,6 +202,7 @@ func (r *IntegrationResource) Read(ctx context.Context, req resource.ReadRequest
 	mapIntegrationToState(integration, &state)
 	// Preserve config_json from state since API masks sensitive fields
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", state.ID)...)
 }
 
 func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -202,17 +228,23 @@ func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 	}
 
-	integration, err := r.client.UpdateIntegration(ctx, id, client.UpdateIntegrationRequest{
+	updateCtx := withStateVersion(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integration, err := r.client.UpdateIntegration(updateCtx, id, client.UpdateIntegrationRequest{
 		Name:   plan.Name.ValueString(),
 		Config: configMap,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error Updating Integration", fmt.Sprintf("Could not update integration: %s", err))
+		addUpdateError(&resp.Diagnostics, "Error Updating Integration", "Could not update integration", err)
 		return
 	}
 
 	mapIntegrationToState(integration, &plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", plan.ID)...)
 }
 
 func (r *IntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -236,8 +268,43 @@ func (r *IntegrationResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+// ImportState imports an integration by ID. config_json is taken from the
+// API, which masks sensitive fields, so imported secrets must be replaced in
+// configuration before the next apply.
 func (r *IntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importID, diags := importIDFromIdentity(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(importID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric integration ID, got: %s", importID))
+		return
+	}
+
+	integration, err := r.client.GetIntegration(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Integration", fmt.Sprintf("Could not read integration %d: %s", id, err))
+		return
+	}
+
+	configJSON, err := json.Marshal(integration.Config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Integration", fmt.Sprintf("Could not encode the config of integration %d: %s", id, err))
+		return
+	}
+
+	var state IntegrationResourceModel
+	mapIntegrationToState(integration, &state)
+	state.ConfigJSON = types.StringValue(string(configJSON))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", state.ID)...)
+	resp.Diagnostics.AddWarning(
+		"Integration Secrets Masked",
+		fmt.Sprintf("config_json for integration %d was imported from the API, which masks sensitive fields. Replace any masked values in configuration before applying.", id),
+	)
 }
 
 func mapIntegrationToState(integration *client.Integration, state *IntegrationResourceModel) {
@@ -0,0 +1,248 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// validateAgainstSchema checks a decoded JSON value against the subset of JSON
+// Schema used by Forge molds and marketplace items: type, enum, pattern,
+// properties, required, additionalProperties (false only) and items.
+// It returns one human-readable problem per violation, prefixed with the
+// location of the offending value, or nil if the value is valid. Values of
+// secret properties (see isSecretSchema) are never included in the messages.
+func validateAgainstSchema(schema map[string]interface{}, value interface{}, location string) []string {
+	if len(schema) == 0 {
+		return nil
+	}
+
+	var problems []string
+
+	if t, ok := schema["type"].(string); ok && !matchesSchemaType(t, value) {
+		return []string{fmt.Sprintf("%s: expected %s, got %s", location, t, jsonTypeName(value))}
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && !enumContains(enum, value) {
+		if isSecretSchema(schema) {
+			problems = append(problems, fmt.Sprintf("%s: %s is not one of the allowed values", location, redactedValue))
+		} else {
+			problems = append(problems, fmt.Sprintf("%s: value %v is not one of %v", location, value, enum))
+		}
+	}
+
+	if pattern, ok := schema["pattern"].(string); ok {
+		if s, isString := value.(string); isString {
+			re, err := regexp.Compile(pattern)
+			if err == nil && !re.MatchString(s) {
+				if isSecretSchema(schema) {
+					problems = append(problems, fmt.Sprintf("%s: %s does not match pattern %q", location, redactedValue, pattern))
+				} else {
+					problems = append(problems, fmt.Sprintf("%s: value %q does not match pattern %q", location, s, pattern))
+				}
+			}
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		properties, _ := schema["properties"].(map[string]interface{})
+
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, r := range required {
+				name, _ := r.(string)
+				if _, present := v[name]; name != "" && !present {
+					problems = append(problems, fmt.Sprintf("%s: missing required property %q", location, name))
+				}
+			}
+		}
+
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			propSchema, known := properties[k].(map[string]interface{})
+			if !known {
+				if additional, ok := schema["additionalProperties"].(bool); ok && !additional {
+					problems = append(problems, fmt.Sprintf("%s: unknown property %q", location, k))
+				}
+				continue
+			}
+			problems = append(problems, validateAgainstSchema(propSchema, v[k], location+"."+k)...)
+		}
+	case []interface{}:
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range v {
+				problems = append(problems, validateAgainstSchema(items, item, fmt.Sprintf("%s[%d]", location, i))...)
+			}
+		}
+	}
+
+	return problems
+}
+
+// coerceToSchema converts string values, as they arrive from a Terraform
+// map(string), into the JSON types declared for each property in the schema.
+// Properties without a declared type are passed through as strings. Array and
+// object properties are expected to be JSON-encoded.
+func coerceToSchema(schema map[string]interface{}, values map[string]string) (map[string]interface{}, []string) {
+	properties, _ := schema["properties"].(map[string]interface{})
+
+	result := make(map[string]interface{}, len(values))
+	var problems []string
+	for k, raw := range values {
+		propSchema, _ := properties[k].(map[string]interface{})
+		t, _ := propSchema["type"].(string)
+
+		switch t {
+		case "boolean":
+			b, err := strconv.ParseBool(raw)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("%s: expected boolean, got %q", k, raw))
+				continue
+			}
+			result[k] = b
+		case "integer", "number":
+			n, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("%s: expected %s, got %q", k, t, raw))
+				continue
+			}
+			result[k] = n
+		case "array", "object":
+			var decoded interface{}
+			if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: expected JSON-encoded %s: %s", k, t, err))
+				continue
+			}
+			result[k] = decoded
+		default:
+			result[k] = raw
+		}
+	}
+
+	sort.Strings(problems)
+	return result, problems
+}
+
+// redactedValue replaces secret values in diagnostics.
+const redactedValue = "(sensitive value)"
+
+// isSecretSchema reports whether a property schema declares a secret, either
+// through the standard writeOnly keyword, the "password" format, or the
+// x-sensitive extension used by marketplace items.
+func isSecretSchema(schema map[string]interface{}) bool {
+	if writeOnly, _ := schema["writeOnly"].(bool); writeOnly {
+		return true
+	}
+	if sensitive, _ := schema["x-sensitive"].(bool); sensitive {
+		return true
+	}
+	format, _ := schema["format"].(string)
+	return format == "password"
+}
+
+// secretValues collects the string values of every secret property in value,
+// so they can be scrubbed from messages the API echoes back.
+func secretValues(schema map[string]interface{}, value interface{}) []string {
+	if len(schema) == 0 {
+		return nil
+	}
+
+	if isSecretSchema(schema) {
+		if s, ok := value.(string); ok && s != "" {
+			return []string{s}
+		}
+		return nil
+	}
+
+	var secrets []string
+	switch v := value.(type) {
+	case map[string]interface{}:
+		properties, _ := schema["properties"].(map[string]interface{})
+		for k, item := range v {
+			if propSchema, ok := properties[k].(map[string]interface{}); ok {
+				secrets = append(secrets, secretValues(propSchema, item)...)
+			}
+		}
+	case []interface{}:
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for _, item := range v {
+				secrets = append(secrets, secretValues(items, item)...)
+			}
+		}
+	}
+	return secrets
+}
+
+// redactSecrets replaces every occurrence of the given secrets in msg.
+func redactSecrets(msg string, secrets []string) string {
+	for _, secret := range secrets {
+		msg = strings.ReplaceAll(msg, secret, redactedValue)
+	}
+	return msg
+}
+
+// matchesSchemaType reports whether a decoded JSON value has the given JSON Schema type.
+func matchesSchemaType(t string, value interface{}) bool {
+	switch t {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		f, ok := value.(float64)
+		return ok && f == math.Trunc(f)
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "null":
+		return value == nil
+	}
+	// Unknown types are not enforced.
+	return true
+}
+
+// jsonTypeName returns the JSON Schema type name of a decoded JSON value.
+func jsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", value), "*")
+}
+
+// enumContains reports whether value equals any of the enum members.
+func enumContains(enum []interface{}, value interface{}) bool {
+	for _, e := range enum {
+		if fmt.Sprint(e) == fmt.Sprint(value) && jsonTypeName(e) == jsonTypeName(value) {
+			return true
+		}
+	}
+	return false
+}
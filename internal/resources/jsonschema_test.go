@@ -0,0 +1,179 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+)
+
+func testMoldSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": false,
+		"required":             []interface{}{"name", "replicas"},
+		"properties": map[string]interface{}{
+			"name":     map[string]interface{}{"type": "string", "pattern": "^[a-z][a-z0-9-]*$"},
+			"replicas": map[string]interface{}{"type": "integer"},
+			"public":   map[string]interface{}{"type": "boolean"},
+			"tier":     map[string]interface{}{"type": "string", "enum": []interface{}{"gold", "silver"}},
+			"tags":     map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}},
+		},
+	}
+}
+
+func TestValidateAgainstSchema_Valid(t *testing.T) {
+	value := map[string]interface{}{
+		"name":     "payments-api",
+		"replicas": float64(3),
+		"public":   true,
+		"tier":     "gold",
+		"tags":     []interface{}{"go", "backend"},
+	}
+
+	if problems := validateAgainstSchema(testMoldSchema(), value, "inputs"); len(problems) != 0 {
+		t.Errorf("unexpected problems: %v", problems)
+	}
+}
+
+func TestValidateAgainstSchema_Problems(t *testing.T) {
+	value := map[string]interface{}{
+		"name":    "Payments API",
+		"tier":    "bronze",
+		"tags":    []interface{}{"go", float64(1)},
+		"unknown": "x",
+	}
+
+	problems := validateAgainstSchema(testMoldSchema(), value, "inputs")
+	joined := strings.Join(problems, "\n")
+
+	for _, want := range []string{
+		`missing required property "replicas"`,
+		`inputs.name: value "Payments API" does not match pattern`,
+		"inputs.tier: value bronze is not one of",
+		"inputs.tags[1]: expected string, got number",
+		`unknown property "unknown"`,
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("problems missing %q:\n%s", want, joined)
+		}
+	}
+}
+
+func TestValidateAgainstSchema_IntegerRejectsFraction(t *testing.T) {
+	schema := map[string]interface{}{"type": "integer"}
+	if problems := validateAgainstSchema(schema, 1.5, "replicas"); len(problems) != 1 {
+		t.Errorf("problems = %v, want one", problems)
+	}
+}
+
+func TestValidateAgainstSchema_EmptySchemaAcceptsAnything(t *testing.T) {
+	if problems := validateAgainstSchema(nil, map[string]interface{}{"a": 1}, "inputs"); problems != nil {
+		t.Errorf("problems = %v, want nil", problems)
+	}
+}
+
+func TestCoerceToSchema(t *testing.T) {
+	values := map[string]string{
+		"name":     "payments-api",
+		"replicas": "3",
+		"public":   "true",
+		"tags":     `["go","backend"]`,
+		"extra":    "kept as string",
+	}
+
+	result, problems := coerceToSchema(testMoldSchema(), values)
+	if len(problems) != 0 {
+		t.Fatalf("unexpected problems: %v", problems)
+	}
+	if result["replicas"] != float64(3) {
+		t.Errorf("replicas = %#v, want 3", result["replicas"])
+	}
+	if result["public"] != true {
+		t.Errorf("public = %#v, want true", result["public"])
+	}
+	if tags, ok := result["tags"].([]interface{}); !ok || len(tags) != 2 {
+		t.Errorf("tags = %#v, want two-element array", result["tags"])
+	}
+	if result["extra"] != "kept as string" {
+		t.Errorf("extra = %#v, want string", result["extra"])
+	}
+}
+
+func TestCoerceToSchema_Problems(t *testing.T) {
+	values := map[string]string{
+		"replicas": "three",
+		"public":   "maybe",
+		"tags":     "go,backend",
+	}
+
+	_, problems := coerceToSchema(testMoldSchema(), values)
+	if len(problems) != 3 {
+		t.Errorf("problems = %v, want 3", problems)
+	}
+}
+
+func TestValidateAgainstSchema_RedactsSecrets(t *testing.T) {
+	schema := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"token": map[string]interface{}{"type": "string", "pattern": "^xoxb-", "writeOnly": true},
+			"tier":  map[string]interface{}{"type": "string", "enum": []interface{}{"a", "b"}, "x-sensitive": true},
+		},
+	}
+
+	problems := validateAgainstSchema(schema, map[string]interface{}{"token": "hunter2", "tier": "topsecret"}, "config")
+	if len(problems) != 2 {
+		t.Fatalf("problems = %v, want 2", problems)
+	}
+	for _, p := range problems {
+		if strings.Contains(p, "hunter2") || strings.Contains(p, "topsecret") {
+			t.Errorf("problem leaks a secret value: %q", p)
+		}
+	}
+}
+
+func TestIsSecretSchema(t *testing.T) {
+	tests := []struct {
+		schema map[string]interface{}
+		want   bool
+	}{
+		{schema: map[string]interface{}{"type": "string"}, want: false},
+		{schema: map[string]interface{}{"writeOnly": true}, want: true},
+		{schema: map[string]interface{}{"x-sensitive": true}, want: true},
+		{schema: map[string]interface{}{"format": "password"}, want: true},
+		{schema: map[string]interface{}{"format": "uri"}, want: false},
+	}
+	for _, tt := range tests {
+		if got := isSecretSchema(tt.schema); got != tt.want {
+			t.Errorf("isSecretSchema(%v) = %v, want %v", tt.schema, got, tt.want)
+		}
+	}
+}
+
+func TestSecretValuesAndRedact(t *testing.T) {
+	schema := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"channel": map[string]interface{}{"type": "string"},
+			"auth": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"password": map[string]interface{}{"type": "string", "format": "password"},
+				},
+			},
+		},
+	}
+	value := map[string]interface{}{
+		"channel": "#alerts",
+		"auth":    map[string]interface{}{"password": "s3cret"},
+	}
+
+	secrets := secretValues(schema, value)
+	if len(secrets) != 1 || secrets[0] != "s3cret" {
+		t.Fatalf("secretValues() = %v, want [s3cret]", secrets)
+	}
+
+	got := redactSecrets("login failed for s3cret in #alerts", secrets)
+	if got != "login failed for (sensitive value) in #alerts" {
+		t.Errorf("redactSecrets() = %q", got)
+	}
+}
@@ -14,7 +14,10 @@ import (
 	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
 )
 
-var _ resource.Resource = &K8sAgentResource{}
+var (
+	_ resource.Resource                = &K8sAgentResource{}
+	_ resource.ResourceWithImportState = &K8sAgentResource{}
+)
 
 // K8sAgentResource defines the resource implementation.
 type K8sAgentResource struct {
@@ -228,3 +231,31 @@ func (r *K8sAgentResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 }
+
+// ImportState imports an agent by cluster ID. The token is only returned on
+// registration, so token stays null for imported agents.
+func (r *K8sAgentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	agent, err := r.client.GetK8sAgent(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing K8s Agent", fmt.Sprintf("Could not read K8s agent %s: %s", req.ID, err))
+		return
+	}
+	if agent.Status == "revoked" {
+		resp.Diagnostics.AddError("Error Importing K8s Agent", fmt.Sprintf("K8s agent %s was revoked.", req.ID))
+		return
+	}
+
+	state := K8sAgentResourceModel{
+		ID:          types.StringValue(agent.ClusterID),
+		ClusterID:   types.StringValue(agent.ClusterID),
+		Name:        types.StringValue(agent.Name),
+		Description: stringValueOrNull(agent.Description),
+		ExpiresIn:   expiresInDays(agent.CreatedAt, agent.ExpiresAt),
+		Token:       types.StringNull(),
+		TokenPrefix: stringValueOrNull(agent.TokenPrefix),
+		Status:      types.StringValue(agent.Status),
+		ExpiresAt:   stringValueOrNull(agent.ExpiresAt),
+		CreatedAt:   stringValueOrNull(agent.CreatedAt),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+// List resources back the list blocks of terraform query. Each one shares the
+// type name of the managed resource it enumerates and identifies results by
+// that resource's identity, so query results can be turned straight into
+// import blocks.
+
+// configureListClient returns the client passed to a list resource's
+// Configure, or nil before the provider is configured.
+func configureListClient(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *client.Client {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return nil
+	}
+	return c
+}
+
+// listResults streams one result per item, stopping at the request's limit.
+// fill sets the display name and identity of a result and, when the request
+// includes resources, its state.
+func listResults[T any](ctx context.Context, req list.ListRequest, items []T, fill func(item T, result *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			result := req.NewListResult(ctx)
+			fill(item, &result)
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// listError streams a single error result.
+func listError(stream *list.ListResultsStream, summary, detail string) {
+	var diags diag.Diagnostics
+	diags.AddError(summary, detail)
+	stream.Results = list.ListResultsStreamDiagnostics(diags)
+}
+
+// matchesFilter reports whether value passes an optional, case-insensitive
+// filter attribute.
+func matchesFilter(filter types.String, value string) bool {
+	return filter.IsNull() || filter.IsUnknown() || strings.EqualFold(filter.ValueString(), value)
+}
@@ -0,0 +1,305 @@
+package resources
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+// newListRequest builds the request Terraform sends for a list block of
+// lr's type, with the given config attributes set and the rest null.
+func newListRequest(t *testing.T, lr list.ListResource, r resource.ResourceWithIdentity, config map[string]tftypes.Value, includeResource bool) list.ListRequest {
+	t.Helper()
+	ctx := context.Background()
+
+	configResp := &list.ListResourceSchemaResponse{}
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, configResp)
+	configType := configResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, typ := range configType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+		if v, ok := config[name]; ok {
+			values[name] = v
+		}
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	identityResp := &resource.IdentitySchemaResponse{}
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identityResp)
+
+	return list.ListRequest{
+		Config:                 tfsdk.Config{Schema: configResp.Schema, Raw: tftypes.NewValue(configType, values)},
+		IncludeResource:        includeResource,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}
+}
+
+// collectList runs List and returns the results, failing on any error.
+func collectList(t *testing.T, lr list.ListResource, req list.ListRequest) []list.ListResult {
+	t.Helper()
+	stream := &list.ListResultsStream{}
+	lr.List(context.Background(), req, stream)
+	var results []list.ListResult
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("List() result error = %v", result.Diagnostics)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// identities returns the string identity attribute of each result.
+func identities(t *testing.T, results []list.ListResult, attr string) []string {
+	t.Helper()
+	var ids []string
+	for _, result := range results {
+		var id types.String
+		if diags := result.Identity.GetAttribute(context.Background(), path.Root(attr), &id); diags.HasError() {
+			t.Fatalf("Identity.GetAttribute() error = %v", diags)
+		}
+		ids = append(ids, id.ValueString())
+	}
+	return ids
+}
+
+func TestListResources_TypeNamesMatchResources(t *testing.T) {
+	for _, lr := range []list.ListResource{
+		NewTeamListResource(), NewEntityListResource(), NewFeatureFlagListResource(),
+		NewIntegrationListResource(), NewForgeMoldListResource(), NewGovernanceActionListResource(),
+	} {
+		resp := &resource.MetadataResponse{}
+		lr.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+		if !slices.Contains([]string{"shoehorn_team", "shoehorn_entity", "shoehorn_feature_flag", "shoehorn_integration", "shoehorn_forge_mold", "shoehorn_governance_action"}, resp.TypeName) {
+			t.Errorf("%T TypeName = %q", lr, resp.TypeName)
+		}
+	}
+}
+
+func TestTeamListResource_List(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	ctx := context.Background()
+	for _, slug := range []string{"payments", "platform"} {
+		if _, err := c.CreateTeam(ctx, client.CreateTeamRequest{Name: slug, Slug: slug}); err != nil {
+			t.Fatalf("CreateTeam() error = %v", err)
+		}
+	}
+	lr := &TeamListResource{client: c}
+
+	results := collectList(t, lr, newListRequest(t, lr, &TeamResource{}, nil, true))
+	if len(results) != 2 {
+		t.Fatalf("List() returned %d results, want 2", len(results))
+	}
+	var state TeamResourceModel
+	if diags := results[0].Resource.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("Resource.Get() error = %v", diags)
+	}
+	if ids := identities(t, results, "id"); ids[0] != state.ID.ValueString() || results[0].DisplayName != state.Name.ValueString() {
+		t.Errorf("identity = %s, display name = %q, state = %+v", ids[0], results[0].DisplayName, state)
+	}
+
+	req := newListRequest(t, lr, &TeamResource{}, nil, false)
+	req.Limit = 1
+	if results := collectList(t, lr, req); len(results) != 1 {
+		t.Errorf("List() with limit 1 returned %d results", len(results))
+	}
+
+	req = newListRequest(t, lr, &TeamResource{}, map[string]tftypes.Value{"source": tftypes.NewValue(tftypes.String, "directory")}, false)
+	if results := collectList(t, lr, req); len(results) != 0 {
+		t.Errorf("List() with source = directory returned %d results, want 0", len(results))
+	}
+}
+
+func TestEntityListResource_List_Filters(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	ctx := context.Background()
+	for _, e := range []EntityResourceModel{
+		{Name: types.StringValue("payments-api"), Type: types.StringValue("service"), Owner: types.StringValue("payments")},
+		{Name: types.StringValue("ledger-db"), Type: types.StringValue("database"), Owner: types.StringValue("payments")},
+		{Name: types.StringValue("portal"), Type: types.StringValue("service"), Owner: types.StringValue("platform")},
+	} {
+		if _, err := c.CreateEntity(ctx, client.CreateEntityRequest{Content: buildManifestYAML(&e), Source: "terraform"}); err != nil {
+			t.Fatalf("CreateEntity() error = %v", err)
+		}
+	}
+	lr := &EntityListResource{client: c}
+
+	req := newListRequest(t, lr, &EntityResource{}, map[string]tftypes.Value{
+		"type":  tftypes.NewValue(tftypes.String, "service"),
+		"owner": tftypes.NewValue(tftypes.String, "payments"),
+	}, true)
+	results := collectList(t, lr, req)
+	if ids := identities(t, results, "id"); !slices.Equal(ids, []string{"payments-api"}) {
+		t.Fatalf("List() ids = %v, want [payments-api]", ids)
+	}
+	var state EntityResourceModel
+	results[0].Resource.Get(ctx, &state)
+	if state.Owner.ValueString() != "payments" || state.Type.ValueString() != "service" {
+		t.Errorf("state = %+v", state)
+	}
+}
+
+func TestFeatureFlagListResource_List(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	ctx := context.Background()
+	c.CreateFeatureFlag(ctx, client.CreateFeatureFlagRequest{Key: "new-ui", Name: "New UI", DefaultEnabled: true})
+	c.CreateFeatureFlag(ctx, client.CreateFeatureFlagRequest{Key: "beta", Name: "Beta"})
+	lr := &FeatureFlagListResource{client: c}
+
+	req := newListRequest(t, lr, &FeatureFlagResource{}, map[string]tftypes.Value{"default_enabled": tftypes.NewValue(tftypes.Bool, true)}, true)
+	results := collectList(t, lr, req)
+	if ids := identities(t, results, "key"); !slices.Equal(ids, []string{"new-ui"}) {
+		t.Fatalf("List() keys = %v, want [new-ui]", ids)
+	}
+	var state FeatureFlagResourceModel
+	results[0].Resource.Get(ctx, &state)
+	if !state.DefaultEnabled.ValueBool() || state.Name.ValueString() != "New UI" {
+		t.Errorf("state = %+v", state)
+	}
+}
+
+func TestIntegrationListResource_List(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	ctx := context.Background()
+	gh, err := c.CreateIntegration(ctx, client.CreateIntegrationRequest{Name: "GitHub", Type: "github", Config: map[string]interface{}{"org": "acme"}})
+	if err != nil {
+		t.Fatalf("CreateIntegration() error = %v", err)
+	}
+	c.CreateIntegration(ctx, client.CreateIntegrationRequest{Name: "Jira", Type: "jira", Config: map[string]interface{}{}})
+	lr := &IntegrationListResource{client: c}
+
+	req := newListRequest(t, lr, &IntegrationResource{}, map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "GitHub")}, true)
+	results := collectList(t, lr, req)
+	if len(results) != 1 || results[0].DisplayName != "GitHub" {
+		t.Fatalf("List() = %+v, want the GitHub integration", results)
+	}
+	var state IntegrationResourceModel
+	results[0].Resource.Get(ctx, &state)
+	if state.ID.ValueString() != identities(t, results, "id")[0] || state.ID.ValueString() != strconv.Itoa(gh.ID) {
+		t.Errorf("state id = %s, integration id = %d", state.ID, gh.ID)
+	}
+	if state.ConfigJSON.ValueString() != `{"org":"acme"}` {
+		t.Errorf("config_json = %s", state.ConfigJSON)
+	}
+}
+
+func TestForgeMoldListResource_List(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	ctx := context.Background()
+	for _, m := range []client.CreateForgeMoldRequest{
+		{Slug: "go-service", Name: "Go service", Version: "1.0.0", Visibility: "public", Category: "service", Actions: []client.ForgeMoldAction{{Action: "create", Label: "Create"}}},
+		{Slug: "docs-site", Name: "Docs site", Version: "1.0.0", Visibility: "public", Category: "docs", Actions: []client.ForgeMoldAction{{Action: "create", Label: "Create"}}},
+	} {
+		if _, err := c.CreateForgeMold(ctx, m); err != nil {
+			t.Fatalf("CreateForgeMold() error = %v", err)
+		}
+	}
+	lr := &ForgeMoldListResource{client: c}
+
+	req := newListRequest(t, lr, &ForgeMoldResource{}, map[string]tftypes.Value{"category": tftypes.NewValue(tftypes.String, "service")}, true)
+	results := collectList(t, lr, req)
+	if ids := identities(t, results, "slug"); !slices.Equal(ids, []string{"go-service"}) {
+		t.Fatalf("List() slugs = %v, want [go-service]", ids)
+	}
+	var state ForgeMoldResourceModel
+	results[0].Resource.Get(ctx, &state)
+	if len(state.Actions) != 1 || state.Actions[0].Action.ValueString() != "create" {
+		t.Errorf("actions = %+v", state.Actions)
+	}
+}
+
+func TestGovernanceActionListResource_List(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	ctx := context.Background()
+	for _, priority := range []string{"critical", "low"} {
+		if _, err := c.CreateGovernanceAction(ctx, client.CreateGovernanceActionRequest{EntityID: "payments-api", Title: priority + " finding", Priority: priority, SourceType: "security"}); err != nil {
+			t.Fatalf("CreateGovernanceAction() error = %v", err)
+		}
+	}
+	lr := &GovernanceActionListResource{client: c}
+
+	req := newListRequest(t, lr, &GovernanceActionResource{}, map[string]tftypes.Value{"priority": tftypes.NewValue(tftypes.String, "critical")}, true)
+	results := collectList(t, lr, req)
+	if len(results) != 1 || results[0].DisplayName != "critical finding" {
+		t.Fatalf("List() = %+v, want the critical finding", results)
+	}
+	var state GovernanceActionResourceModel
+	results[0].Resource.Get(ctx, &state)
+	if state.ID.ValueString() != identities(t, results, "id")[0] || state.Priority.ValueString() != "critical" {
+		t.Errorf("state = %+v", state)
+	}
+}
+
+func TestListResource_ListError(t *testing.T) {
+	api := fakeapi.NewServer()
+	c := api.Client()
+	api.Close()
+	lr := &TeamListResource{client: c}
+
+	stream := &list.ListResultsStream{}
+	lr.List(context.Background(), newListRequest(t, lr, &TeamResource{}, nil, false), stream)
+	for result := range stream.Results {
+		if !result.Diagnostics.HasError() {
+			t.Errorf("List() result = %+v, want an error", result)
+		}
+	}
+}
+
+func TestTeamResource_ImportState_ByIdentity(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	c := api.Client()
+	ctx := context.Background()
+	team, err := c.CreateTeam(ctx, client.CreateTeamRequest{Name: "Payments", Slug: "payments"})
+	if err != nil {
+		t.Fatalf("CreateTeam() error = %v", err)
+	}
+
+	r := &TeamResource{client: c}
+	identityResp := &resource.IdentitySchemaResponse{}
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identityResp)
+	identityType := identityResp.IdentitySchema.Type().TerraformType(ctx)
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identityResp.IdentitySchema,
+		Raw:    tftypes.NewValue(identityType, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, team.ID)}),
+	}
+
+	resp := newImportResponse(r)
+	resp.Identity = &tfsdk.ResourceIdentity{Schema: identityResp.IdentitySchema, Raw: tftypes.NewValue(identityType, nil)}
+	r.ImportState(ctx, resource.ImportStateRequest{Identity: identity}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ImportState() error = %v", resp.Diagnostics)
+	}
+	var state TeamResourceModel
+	resp.State.Get(ctx, &state)
+	if state.Slug.ValueString() != "payments" {
+		t.Errorf("slug = %s, want payments", state.Slug)
+	}
+	if !resp.Identity.Raw.Equal(identity.Raw) {
+		t.Errorf("identity = %s, want %s", resp.Identity.Raw, identity.Raw)
+	}
+}
@@ -3,8 +3,13 @@ package resources
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,6 +24,7 @@ import (
 var (
 	_ resource.Resource                = &MarketplaceInstallationResource{}
 	_ resource.ResourceWithImportState = &MarketplaceInstallationResource{}
+	_ resource.ResourceWithModifyPlan  = &MarketplaceInstallationResource{}
 )
 
 // MarketplaceInstallationResource defines the resource implementation.
@@ -28,18 +34,24 @@ type MarketplaceInstallationResource struct {
 
 // MarketplaceInstallationResourceModel describes the resource data model.
 type MarketplaceInstallationResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Slug        types.String `tfsdk:"slug"`
-	Enabled     types.Bool   `tfsdk:"enabled"`
-	ConfigJSON  types.String `tfsdk:"config_json"`
-	Kind        types.String `tfsdk:"kind"`
-	Version     types.String `tfsdk:"version"`
-	SyncStatus  types.String `tfsdk:"sync_status"`
-	InstalledBy types.String `tfsdk:"installed_by"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID                types.String   `tfsdk:"id"`
+	Slug              types.String   `tfsdk:"slug"`
+	Enabled           types.Bool     `tfsdk:"enabled"`
+	ConfigJSON        types.String   `tfsdk:"config_json"`
+	Kind              types.String   `tfsdk:"kind"`
+	Version           types.String   `tfsdk:"version"`
+	VersionConstraint types.String   `tfsdk:"version_constraint"`
+	SyncStatus        types.String   `tfsdk:"sync_status"`
+	InstalledBy       types.String   `tfsdk:"installed_by"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	UpdatedAt         types.String   `tfsdk:"updated_at"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
+// defaultMarketplaceSyncTimeout bounds how long Create and Update wait for the
+// installation's configuration to sync when no timeouts block is configured.
+const defaultMarketplaceSyncTimeout = 10 * time.Minute
+
 // NewMarketplaceInstallationResource creates a new marketplace installation resource.
 func NewMarketplaceInstallationResource() resource.Resource {
 	return &MarketplaceInstallationResource{}
@@ -49,7 +61,7 @@ func (r *MarketplaceInstallationResource) Metadata(_ context.Context, req resour
 	resp.TypeName = req.ProviderTypeName + "_marketplace_installation"
 }
 
-func (r *MarketplaceInstallationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *MarketplaceInstallationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Shoehorn marketplace item installation.",
 		Attributes: map[string]schema.Attribute{
@@ -74,7 +86,7 @@ func (r *MarketplaceInstallationResource) Schema(_ context.Context, _ resource.S
 				Default:     booldefault.StaticBool(true),
 			},
 			"config_json": schema.StringAttribute{
-				Description: "The addon configuration as a JSON string.",
+				Description: "The addon configuration as a JSON string. Validated against the item's declared config schema during plan; values of properties the schema declares secret are never echoed in diagnostics.",
 				Optional:    true,
 				Sensitive:   true,
 			},
@@ -85,6 +97,13 @@ func (r *MarketplaceInstallationResource) Schema(_ context.Context, _ resource.S
 			"version": schema.StringAttribute{
 				Description: "The installed version.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version_constraint": schema.StringAttribute{
+				Description: "Version constraint for the item: an exact version (\"1.4.2\") or a semver range (\">= 1.2, < 2.0\", \"~> 1.4\"). The newest catalog version satisfying the constraint is installed, and newer matching versions are planned as in-place upgrades. When omitted, the version offered by the catalog at install time is kept.",
+				Optional:    true,
 			},
 			"sync_status": schema.StringAttribute{
 				Description: "The synchronization status.",
@@ -105,6 +124,12 @@ func (r *MarketplaceInstallationResource) Schema(_ context.Context, _ resource.S
 				Description: "The last update timestamp.",
 				Computed:    true,
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				Update:            true,
+				CreateDescription: "How long to wait for the configuration to sync after install. Defaults to 10m.",
+				UpdateDescription: "How long to wait for the configuration to sync after an update. Defaults to 10m.",
+			}),
 		},
 	}
 }
@@ -137,8 +162,12 @@ func (r *MarketplaceInstallationResource) Create(ctx context.Context, req resour
 
 	slug := plan.Slug.ValueString()
 
-	// Install the marketplace item
-	installation, err := r.client.InstallMarketplaceItem(ctx, slug)
+	// Install the marketplace item at the version resolved during plan, if pinned
+	targetVersion := ""
+	if !plan.Version.IsNull() && !plan.Version.IsUnknown() {
+		targetVersion = plan.Version.ValueString()
+	}
+	installation, err := r.client.InstallMarketplaceItem(ctx, slug, targetVersion)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Installing Marketplace Item", fmt.Sprintf("Could not install marketplace item %q: %s", slug, err))
 		return
@@ -178,7 +207,17 @@ func (r *MarketplaceInstallationResource) Create(ctx context.Context, req resour
 		installation = updated
 	}
 
+	syncTimeout, diags := plan.Timeouts.Create(ctx, defaultMarketplaceSyncTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	installation, diags = waitForMarketplaceSync(ctx, r.client, installation, plan.ConfigJSON, syncTimeout)
+	resp.Diagnostics.Append(diags...)
+
 	mapMarketplaceInstallationToState(installation, &plan)
+	resp.Diagnostics.Append(checkInstalledVersion(plan.VersionConstraint, installation)...)
 	// Preserve user's config_json to avoid format diffs
 	if !plan.ConfigJSON.IsNull() && !plan.ConfigJSON.IsUnknown() {
 		// keep plan value
@@ -228,22 +267,41 @@ func (r *MarketplaceInstallationResource) Update(ctx context.Context, req resour
 
 	slug := plan.Slug.ValueString()
 
+	// Only the first write is conditional on the updated_at in state. Each write
+	// bumps updated_at, so the writes after it follow Terraform's own change.
+	writeCtx := withStateVersion(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Upgrade (or downgrade) if plan resolved a different version
+	if !plan.Version.IsNull() && !plan.Version.IsUnknown() && !plan.Version.Equal(state.Version) {
+		target := plan.Version.ValueString()
+		tflog.Debug(ctx, "changing installed marketplace item version", map[string]any{"slug": slug, "from": state.Version.ValueString(), "to": target})
+		if _, err := r.client.UpgradeMarketplaceItem(writeCtx, slug, target); err != nil {
+			addUpdateError(&resp.Diagnostics, "Error Upgrading Marketplace Item", fmt.Sprintf("Could not change marketplace item %q to version %s", slug, target), err)
+			return
+		}
+		writeCtx = ctx
+	}
+
 	// Toggle enabled/disabled if changed
 	planEnabled := plan.Enabled.ValueBool()
 	stateEnabled := state.Enabled.ValueBool()
 	if planEnabled != stateEnabled {
 		tflog.Debug(ctx, "toggling marketplace item enabled state", map[string]any{"slug": slug, "enabled": planEnabled})
 		if planEnabled {
-			if err := r.client.EnableMarketplaceItem(ctx, slug); err != nil {
-				resp.Diagnostics.AddError("Error Enabling Marketplace Item", fmt.Sprintf("Could not enable marketplace item %q: %s", slug, err))
+			if err := r.client.EnableMarketplaceItem(writeCtx, slug); err != nil {
+				addUpdateError(&resp.Diagnostics, "Error Enabling Marketplace Item", fmt.Sprintf("Could not enable marketplace item %q", slug), err)
 				return
 			}
 		} else {
-			if err := r.client.DisableMarketplaceItem(ctx, slug); err != nil {
-				resp.Diagnostics.AddError("Error Disabling Marketplace Item", fmt.Sprintf("Could not disable marketplace item %q: %s", slug, err))
+			if err := r.client.DisableMarketplaceItem(writeCtx, slug); err != nil {
+				addUpdateError(&resp.Diagnostics, "Error Disabling Marketplace Item", fmt.Sprintf("Could not disable marketplace item %q", slug), err)
 				return
 			}
 		}
+		writeCtx = ctx
 	}
 
 	// Update config if changed
@@ -254,8 +312,8 @@ func (r *MarketplaceInstallationResource) Update(ctx context.Context, req resour
 			return
 		}
 
-		if _, err := r.client.UpdateMarketplaceItemConfig(ctx, slug, configMap); err != nil {
-			resp.Diagnostics.AddError("Error Updating Marketplace Item Config", fmt.Sprintf("Could not update config for marketplace item %q: %s", slug, err))
+		if _, err := r.client.UpdateMarketplaceItemConfig(writeCtx, slug, configMap); err != nil {
+			addUpdateError(&resp.Diagnostics, "Error Updating Marketplace Item Config", fmt.Sprintf("Could not update config for marketplace item %q", slug), err)
 			return
 		}
 	}
@@ -267,7 +325,17 @@ func (r *MarketplaceInstallationResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	syncTimeout, diags := plan.Timeouts.Update(ctx, defaultMarketplaceSyncTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	installation, diags = waitForMarketplaceSync(ctx, r.client, installation, plan.ConfigJSON, syncTimeout)
+	resp.Diagnostics.Append(diags...)
+
 	mapMarketplaceInstallationToState(installation, &plan)
+	resp.Diagnostics.Append(checkInstalledVersion(plan.VersionConstraint, installation)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -291,8 +359,216 @@ func (r *MarketplaceInstallationResource) Delete(ctx context.Context, req resour
 	}
 }
 
+// ImportState imports an installation by item slug. config_json is taken from
+// the API; version_constraint is left unset, so the installed version is kept.
 func (r *MarketplaceInstallationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("slug"), req, resp)
+	installation, err := r.client.GetMarketplaceInstallation(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Marketplace Installation", fmt.Sprintf("Could not read marketplace installation %q: %s", req.ID, err))
+		return
+	}
+
+	state := MarketplaceInstallationResourceModel{Timeouts: nullTimeouts("create", "update")}
+	mapMarketplaceInstallationToState(installation, &state)
+	if len(installation.Config) > 0 {
+		configJSON, err := json.Marshal(installation.Config)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Importing Marketplace Installation", fmt.Sprintf("Could not encode the config of marketplace installation %q: %s", req.ID, err))
+			return
+		}
+		state.ConfigJSON = types.StringValue(string(configJSON))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ModifyPlan checks the configuration against the marketplace catalog.
+// config_json is validated against the item's declared config schema. The
+// version constraint is resolved to the newest satisfying version, which
+// becomes the planned version, so a newly published matching release
+// shows up as an in-place upgrade, and a constraint no catalog version
+// satisfies is rejected before apply.
+func (r *MarketplaceInstallationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to resolve on destroy, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var config MarketplaceInstallationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pinned := !config.VersionConstraint.IsNull() && !config.VersionConstraint.IsUnknown()
+	configured := !config.ConfigJSON.IsNull() && !config.ConfigJSON.IsUnknown()
+	if (!pinned && !configured) || config.Slug.IsUnknown() {
+		return
+	}
+
+	var plan MarketplaceInstallationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slug := config.Slug.ValueString()
+
+	item, err := r.client.GetMarketplaceItem(ctx, slug)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(path.Root("slug"), "Unknown Marketplace Item", fmt.Sprintf("The marketplace catalog has no item with slug %q.", slug))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Marketplace Catalog", fmt.Sprintf("Could not read marketplace item %q: %s", slug, err))
+		return
+	}
+
+	if configured {
+		resp.Diagnostics.Append(validateMarketplaceConfig(item, config.ConfigJSON.ValueString())...)
+	}
+
+	// Unpinned installations keep whatever version is installed.
+	if !pinned {
+		return
+	}
+
+	target, err := item.LatestMatchingVersion(config.VersionConstraint.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("version_constraint"), "No Matching Marketplace Item Version", err.Error())
+		return
+	}
+
+	if !plan.Version.Equal(types.StringValue(target)) {
+		tflog.Debug(ctx, "planning marketplace item version change", map[string]any{"slug": slug, "from": plan.Version.ValueString(), "to": target})
+		plan.Version = types.StringValue(target)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+}
+
+// validateMarketplaceConfig checks config_json against the config schema
+// declared by the marketplace item.
+func validateMarketplaceConfig(item *client.MarketplaceItem, configJSON string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(configJSON), &decoded); err != nil {
+		diags.AddAttributeError(path.Root("config_json"), "Invalid Config JSON", fmt.Sprintf("Could not parse config_json: %s", err))
+		return diags
+	}
+
+	if len(item.ConfigSchema) == 0 {
+		return diags
+	}
+
+	if problems := validateAgainstSchema(item.ConfigSchema, decoded, "config_json"); len(problems) > 0 {
+		diags.AddAttributeError(
+			path.Root("config_json"),
+			"Invalid Marketplace Item Config",
+			fmt.Sprintf("config_json does not match the config schema of marketplace item %q:\n  - %s", item.Slug, strings.Join(problems, "\n  - ")),
+		)
+	}
+	return diags
+}
+
+// waitForMarketplaceSync polls an installation until its sync status settles
+// and reports a failed sync as an error. It returns the last observed
+// installation, so callers can still record state when the wait fails.
+// Secret config values are scrubbed from the sync error reported by the API.
+func waitForMarketplaceSync(ctx context.Context, c *client.Client, installation *client.MarketplaceInstallation, configJSON types.String, timeout time.Duration) (*client.MarketplaceInstallation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	slug := installation.Slug
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := waitFor(waitCtx, func(ctx context.Context) (bool, error) {
+		if installation.SyncSettled() {
+			return true, nil
+		}
+		current, err := c.GetMarketplaceInstallation(ctx, slug)
+		if err != nil {
+			return false, err
+		}
+		if current.SyncStatus != installation.SyncStatus {
+			tflog.Debug(ctx, "marketplace installation sync status changed", map[string]any{"slug": slug, "sync_status": current.SyncStatus})
+		}
+		installation = current
+		return installation.SyncSettled(), nil
+	})
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		diags.AddError(
+			"Timeout Waiting For Marketplace Item Sync",
+			fmt.Sprintf("Marketplace item %q did not finish syncing within %s; last observed sync status: %s.", slug, timeout, installation.SyncStatus),
+		)
+	case err != nil:
+		diags.AddError("Error Waiting For Marketplace Item Sync", fmt.Sprintf("Could not poll marketplace installation %q: %s", slug, err))
+	case installation.SyncFailed():
+		detail := fmt.Sprintf("Marketplace item %q failed to sync its configuration (status %s).", slug, installation.SyncStatus)
+		if installation.SyncError != "" {
+			detail += " " + redactSecrets(installation.SyncError, marketplaceConfigSecrets(ctx, c, slug, configJSON))
+		}
+		diags.AddError("Marketplace Item Sync Failed", detail)
+	}
+
+	return installation, diags
+}
+
+// marketplaceConfigSecrets returns the secret values in config_json according
+// to the item's config schema. If the schema cannot be read, every string value
+// in the config is treated as secret.
+func marketplaceConfigSecrets(ctx context.Context, c *client.Client, slug string, configJSON types.String) []string {
+	if configJSON.IsNull() || configJSON.IsUnknown() {
+		return nil
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(configJSON.ValueString()), &decoded); err != nil {
+		return nil
+	}
+
+	item, err := c.GetMarketplaceItem(ctx, slug)
+	if err != nil || len(item.ConfigSchema) == 0 {
+		return stringLeaves(decoded)
+	}
+	return secretValues(item.ConfigSchema, decoded)
+}
+
+// stringLeaves returns every non-empty string nested anywhere in a decoded JSON value.
+func stringLeaves(value interface{}) []string {
+	var leaves []string
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			leaves = append(leaves, v)
+		}
+	case map[string]interface{}:
+		for _, item := range v {
+			leaves = append(leaves, stringLeaves(item)...)
+		}
+	case []interface{}:
+		for _, item := range v {
+			leaves = append(leaves, stringLeaves(item)...)
+		}
+	}
+	return leaves
+}
+
+// checkInstalledVersion guards against the API installing a version outside
+// the configured constraint, e.g. when the catalog changed between plan and apply.
+func checkInstalledVersion(constraint types.String, installation *client.MarketplaceInstallation) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if constraint.IsNull() || constraint.IsUnknown() || installation.Version == "" {
+		return diags
+	}
+
+	item := client.MarketplaceItem{Slug: installation.Slug, Version: installation.Version}
+	if _, err := item.LatestMatchingVersion(constraint.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("version_constraint"), "Installed Version Outside Constraint",
+			fmt.Sprintf("Marketplace item %q was installed at version %s, which does not satisfy %q. Re-run plan to resolve the constraint against the current catalog.", installation.Slug, installation.Version, constraint.ValueString()))
+	}
+	return diags
 }
 
 func mapMarketplaceInstallationToState(installation *client.MarketplaceInstallation, state *MarketplaceInstallationResourceModel) {
@@ -2,12 +2,18 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
 )
 
 func TestMarketplaceInstallationResource_Metadata(t *testing.T) {
@@ -28,7 +34,8 @@ func TestMarketplaceInstallationResource_Schema_HasRequiredAttributes(t *testing
 	attrs := resp.Schema.Attributes
 	expectedAttrs := []string{
 		"id", "slug", "enabled", "config_json", "kind",
-		"version", "sync_status", "installed_by", "created_at", "updated_at",
+		"version", "version_constraint", "sync_status", "installed_by", "created_at", "updated_at",
+		"timeouts",
 	}
 	for _, name := range expectedAttrs {
 		if _, ok := attrs[name]; !ok {
@@ -65,6 +72,23 @@ func TestMarketplaceInstallationResource_Schema_ConfigJSONIsSensitive(t *testing
 	}
 }
 
+func TestMarketplaceInstallationResource_Schema_VersionIsInstalledVersion(t *testing.T) {
+	r := NewMarketplaceInstallationResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	// version has always reported the installed version; the constraint is separate
+	// so that removing it is not kept in the plan.
+	version := resp.Schema.Attributes["version"]
+	if version.IsOptional() || !version.IsComputed() {
+		t.Errorf("version: optional = %v, computed = %v, want computed only", version.IsOptional(), version.IsComputed())
+	}
+	constraint := resp.Schema.Attributes["version_constraint"]
+	if !constraint.IsOptional() || constraint.IsComputed() {
+		t.Errorf("version_constraint: optional = %v, computed = %v, want optional only", constraint.IsOptional(), constraint.IsComputed())
+	}
+}
+
 func TestMarketplaceInstallationResource_Schema_IDIsComputed(t *testing.T) {
 	r := NewMarketplaceInstallationResource()
 	resp := &resource.SchemaResponse{}
@@ -140,6 +164,9 @@ func TestMapMarketplaceInstallationToState(t *testing.T) {
 	if state.Version.ValueString() != "2.1.0" {
 		t.Errorf("Version = %q, want %q", state.Version.ValueString(), "2.1.0")
 	}
+	if !state.VersionConstraint.IsNull() {
+		t.Errorf("VersionConstraint = %q, want null without a configured constraint", state.VersionConstraint.ValueString())
+	}
 	if state.SyncStatus.ValueString() != "synced" {
 		t.Errorf("SyncStatus = %q, want %q", state.SyncStatus.ValueString(), "synced")
 	}
@@ -197,3 +224,218 @@ func TestMapMarketplaceInstallationToState_ClearsOptionalFields(t *testing.T) {
 		t.Errorf("InstalledBy should be null when API returns empty, got %q", state.InstalledBy.ValueString())
 	}
 }
+
+func TestMapMarketplaceInstallationToState_KeepsVersionConstraint(t *testing.T) {
+	state := &MarketplaceInstallationResourceModel{
+		VersionConstraint: types.StringValue("~> 1.2"),
+	}
+
+	installation := &client.MarketplaceInstallation{
+		ID:      "inst-123",
+		Slug:    "slack-notifier",
+		Version: "1.4.0",
+	}
+
+	mapMarketplaceInstallationToState(installation, state)
+
+	if state.VersionConstraint.ValueString() != "~> 1.2" {
+		t.Errorf("VersionConstraint = %q, want constraint %q preserved", state.VersionConstraint.ValueString(), "~> 1.2")
+	}
+	if state.Version.ValueString() != "1.4.0" {
+		t.Errorf("Version = %q, want %q", state.Version.ValueString(), "1.4.0")
+	}
+}
+
+func TestMarketplaceInstallationResource_UpdateRejectsChangeSinceRefresh(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	api.AddMarketplaceItem(client.MarketplaceItem{Slug: "slack-notifier", Kind: "integration", Name: "Slack", Version: "1.0.0"})
+	c := api.Client()
+	r := &MarketplaceInstallationResource{client: c}
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	installation, err := c.InstallMarketplaceItem(ctx, "slack-notifier", "")
+	if err != nil {
+		t.Fatalf("InstallMarketplaceItem() error = %v", err)
+	}
+	state := MarketplaceInstallationResourceModel{
+		ConfigJSON: types.StringNull(), VersionConstraint: types.StringNull(), Timeouts: nullTimeouts("create", "update"),
+	}
+	mapMarketplaceInstallationToState(installation, &state)
+	plan := state
+	plan.Enabled = types.BoolValue(false)
+	plan.ConfigJSON = types.StringValue(`{"channel":"#ops"}`)
+
+	update := func(state MarketplaceInstallationResourceModel) *resource.UpdateResponse {
+		t.Helper()
+		priorState := tfsdk.State{Schema: schemaResp.Schema}
+		planState := tfsdk.State{Schema: schemaResp.Schema}
+		if diags := append(priorState.Set(ctx, &state), planState.Set(ctx, &plan)...); diags.HasError() {
+			t.Fatalf("encoding state: %v", diags)
+		}
+		resp := &resource.UpdateResponse{State: priorState}
+		r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: planState.Schema, Raw: planState.Raw}, State: priorState}, resp)
+		return resp
+	}
+
+	// State last refreshed before someone else changed the installation.
+	stale := state
+	stale.UpdatedAt = types.StringValue("2020-01-01T00:00:00Z")
+	resp := update(stale)
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "changed outside Terraform") {
+		t.Fatalf("Update() with stale state diagnostics = %v, want a re-plan error", resp.Diagnostics)
+	}
+	current, _ := c.GetMarketplaceInstallation(ctx, "slack-notifier")
+	if !current.Enabled || len(current.Config) != 0 {
+		t.Errorf("installation = %+v, want the stale update not applied", current)
+	}
+
+	// With fresh state every write goes through, including those after the first.
+	resp = update(state)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update() error = %v", resp.Diagnostics)
+	}
+	current, _ = c.GetMarketplaceInstallation(ctx, "slack-notifier")
+	if current.Enabled || current.Config["channel"] != "#ops" {
+		t.Errorf("installation = %+v, want disabled with the new config", current)
+	}
+}
+
+func TestCheckInstalledVersion(t *testing.T) {
+	installation := &client.MarketplaceInstallation{Slug: "slack-notifier", Version: "2.0.0"}
+
+	if diags := checkInstalledVersion(types.StringNull(), installation); diags.HasError() {
+		t.Errorf("unpinned installation should pass, got %v", diags)
+	}
+	if diags := checkInstalledVersion(types.StringValue(">= 1.0"), installation); diags.HasError() {
+		t.Errorf("satisfying version should pass, got %v", diags)
+	}
+	if diags := checkInstalledVersion(types.StringValue("~> 1.2"), installation); !diags.HasError() {
+		t.Error("version outside the constraint should be rejected")
+	}
+}
+
+func testMarketplaceItemWithSchema() *client.MarketplaceItem {
+	return &client.MarketplaceItem{
+		Slug: "slack-notifier",
+		ConfigSchema: map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": false,
+			"required":             []interface{}{"webhook_url"},
+			"properties": map[string]interface{}{
+				"webhook_url": map[string]interface{}{"type": "string", "pattern": "^https://", "writeOnly": true},
+				"channel":     map[string]interface{}{"type": "string"},
+			},
+		},
+	}
+}
+
+func TestValidateMarketplaceConfig(t *testing.T) {
+	item := testMarketplaceItemWithSchema()
+
+	if diags := validateMarketplaceConfig(item, `{"webhook_url":"https://hooks.slack.com/x","channel":"#ops"}`); diags.HasError() {
+		t.Errorf("valid config rejected: %v", diags)
+	}
+
+	diags := validateMarketplaceConfig(item, `{"webhook_url":"http://insecure-secret","colour":"red"}`)
+	if !diags.HasError() {
+		t.Fatal("expected invalid config to be rejected")
+	}
+	detail := diags[0].Detail()
+	if !strings.Contains(detail, `unknown property "colour"`) {
+		t.Errorf("detail should mention the unknown property, got %q", detail)
+	}
+	if strings.Contains(detail, "insecure-secret") {
+		t.Errorf("detail leaks a secret value: %q", detail)
+	}
+}
+
+func TestValidateMarketplaceConfig_InvalidJSON(t *testing.T) {
+	diags := validateMarketplaceConfig(&client.MarketplaceItem{Slug: "slack-notifier"}, `{not json`)
+	if !diags.HasError() || diags[0].Summary() != "Invalid Config JSON" {
+		t.Errorf("expected Invalid Config JSON error, got %v", diags)
+	}
+}
+
+func TestValidateMarketplaceConfig_NoSchema(t *testing.T) {
+	if diags := validateMarketplaceConfig(&client.MarketplaceItem{Slug: "slack-notifier"}, `{"anything":1}`); diags.HasError() {
+		t.Errorf("config should not be validated without a schema, got %v", diags)
+	}
+}
+
+func TestWaitForMarketplaceSync_Settles(t *testing.T) {
+	previous := pollInterval
+	pollInterval = time.Millisecond
+	t.Cleanup(func() { pollInterval = previous })
+
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := client.MarketplaceSyncStatusSyncing
+		if polls >= 2 {
+			status = client.MarketplaceSyncStatusSynced
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": "inst-1", "itemSlug": "slack-notifier", "syncStatus": status})
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "key", 30*time.Second)
+	pending := &client.MarketplaceInstallation{ID: "inst-1", Slug: "slack-notifier", SyncStatus: client.MarketplaceSyncStatusPending}
+
+	installation, diags := waitForMarketplaceSync(context.Background(), c, pending, types.StringNull(), time.Second)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if installation.SyncStatus != client.MarketplaceSyncStatusSynced {
+		t.Errorf("SyncStatus = %q, want %q", installation.SyncStatus, client.MarketplaceSyncStatusSynced)
+	}
+	if polls != 2 {
+		t.Errorf("polls = %d, want 2", polls)
+	}
+}
+
+func TestWaitForMarketplaceSync_AlreadySettled(t *testing.T) {
+	c := client.NewClient("http://127.0.0.1:0", "key", time.Second)
+	synced := &client.MarketplaceInstallation{Slug: "slack-notifier", SyncStatus: client.MarketplaceSyncStatusSynced}
+
+	if _, diags := waitForMarketplaceSync(context.Background(), c, synced, types.StringNull(), time.Second); diags.HasError() {
+		t.Errorf("unexpected errors: %v", diags)
+	}
+}
+
+func TestWaitForMarketplaceSync_FailureRedactsSecrets(t *testing.T) {
+	previous := pollInterval
+	pollInterval = time.Millisecond
+	t.Cleanup(func() { pollInterval = previous })
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/marketplace" {
+			json.NewEncoder(w).Encode(map[string]interface{}{"items": []interface{}{testMarketplaceItemWithSchema()}})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"itemSlug":   "slack-notifier",
+			"syncStatus": client.MarketplaceSyncStatusFailed,
+			"syncError":  "POST https://hooks.slack.com/T000/secret returned 404 for #ops",
+		})
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "key", 30*time.Second)
+	pending := &client.MarketplaceInstallation{Slug: "slack-notifier", SyncStatus: client.MarketplaceSyncStatusPending}
+	configJSON := types.StringValue(`{"webhook_url":"https://hooks.slack.com/T000/secret","channel":"#ops"}`)
+
+	_, diags := waitForMarketplaceSync(context.Background(), c, pending, configJSON, time.Second)
+	if !diags.HasError() {
+		t.Fatal("expected sync failure to be reported")
+	}
+	detail := diags[0].Detail()
+	if strings.Contains(detail, "hooks.slack.com/T000/secret") {
+		t.Errorf("detail leaks a secret value: %q", detail)
+	}
+	if !strings.Contains(detail, "#ops") {
+		t.Errorf("detail should keep non-secret values, got %q", detail)
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -36,6 +36,7 @@ type PlatformPolicyResourceModel struct {
 	Enabled     types.Bool   `tfsdk:"enabled"`
 	Enforcement types.String `tfsdk:"enforcement"`
 	System      types.Bool   `tfsdk:"system"`
+	OnDestroy   types.String `tfsdk:"on_destroy"`
 	CreatedAt   types.String `tfsdk:"created_at"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
 }
@@ -51,7 +52,7 @@ func (r *PlatformPolicyResource) Metadata(_ context.Context, req resource.Metada
 
 func (r *PlatformPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a Shoehorn platform policy configuration. Policies are pre-seeded and cannot be created or destroyed. Use this resource to configure enabled state and enforcement level.",
+		Description: "Manages a Shoehorn platform policy configuration. Policies are pre-seeded and cannot be created or destroyed. Use this resource to configure enabled state and enforcement level; on_destroy controls whether removing it leaves, restores or resets them.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the policy.",
@@ -91,6 +92,7 @@ func (r *PlatformPolicyResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Description: "Whether this is a system policy (cannot be disabled).",
 				Computed:    true,
 			},
+			"on_destroy": onDestroyAttribute("the policy's enabled state and enforcement level"),
 			"created_at": schema.StringAttribute{
 				Description: "The creation timestamp.",
 				Computed:    true,
@@ -136,6 +138,11 @@ func (r *PlatformPolicyResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	resp.Diagnostics.Append(saveSnapshot(ctx, resp.Private, policySnapshot(policy))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update the policy configuration
 	enabled := plan.Enabled.ValueBool()
 	updated, err := r.client.UpdatePolicy(ctx, policy.ID, client.UpdatePolicyRequest{
@@ -171,6 +178,7 @@ func (r *PlatformPolicyResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	state.OnDestroy = onDestroyOrDefault(state.OnDestroy)
 	mapPolicyToState(policy, &state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -185,12 +193,17 @@ func (r *PlatformPolicyResource) Update(ctx context.Context, req resource.Update
 	}
 
 	enabled := plan.Enabled.ValueBool()
-	updated, err := r.client.UpdatePolicy(ctx, plan.ID.ValueString(), client.UpdatePolicyRequest{
+	updateCtx := withStateVersion(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdatePolicy(updateCtx, plan.ID.ValueString(), client.UpdatePolicyRequest{
 		Enabled:     &enabled,
 		Enforcement: plan.Enforcement.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error Updating Policy", fmt.Sprintf("Could not update policy %s: %s", plan.Key.ValueString(), err))
+		addUpdateError(&resp.Diagnostics, "Error Updating Policy", fmt.Sprintf("Could not update policy %s", plan.Key.ValueString()), err)
 		return
 	}
 
@@ -198,14 +211,67 @@ func (r *PlatformPolicyResource) Update(ctx context.Context, req resource.Update
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *PlatformPolicyResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
-	tflog.Debug(ctx, "deleting platform policy (state only)")
-	// Policies cannot be deleted - they are pre-seeded.
-	// Removing from Terraform state only. The policy remains in Shoehorn.
+func (r *PlatformPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "deleting platform policy")
+
+	var state PlatformPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Policies cannot be deleted - they are pre-seeded. Depending on
+	// on_destroy the configuration is left in place, restored or reset.
+	resp.Diagnostics.Append(destroyPlatformPolicy(ctx, r.client, state.ID.ValueString(), onDestroyOrDefault(state.OnDestroy).ValueString(), req.Private)...)
 }
 
 func (r *PlatformPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+	policy, err := r.client.GetPolicy(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Policy", fmt.Sprintf("Could not read policy %s: %s", req.ID, err))
+		return
+	}
+	resp.Diagnostics.Append(saveSnapshot(ctx, resp.Private, policySnapshot(policy))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := PlatformPolicyResourceModel{OnDestroy: types.StringValue(onDestroyRetain)}
+	mapPolicyToState(policy, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// policySnapshot returns the request that puts policy's configuration back.
+func policySnapshot(policy *client.PlatformPolicy) client.UpdatePolicyRequest {
+	enabled := policy.Enabled
+	return client.UpdatePolicyRequest{Enabled: &enabled, Enforcement: policy.Enforcement}
+}
+
+// destroyPlatformPolicy applies the on_destroy mode when the resource is
+// destroyed.
+func destroyPlatformPolicy(ctx context.Context, c *client.Client, id, mode string, p privateState) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch mode {
+	case onDestroyRestore:
+		var snapshot client.UpdatePolicyRequest
+		found, d := loadSnapshot(ctx, p, &snapshot)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		if !found {
+			diags.AddWarning("No Policy Snapshot", fmt.Sprintf("No snapshot of policy %s was captured when this resource was created or imported, so its configuration was left in place.", id))
+			return diags
+		}
+		if _, err := c.UpdatePolicy(ctx, id, snapshot); err != nil {
+			diags.AddError("Error Restoring Policy", fmt.Sprintf("Could not restore policy %s: %s", id, err))
+		}
+	case onDestroyReset:
+		if _, err := c.ResetPolicy(ctx, id); err != nil {
+			diags.AddError("Error Resetting Policy", fmt.Sprintf("Could not reset policy %s: %s", id, err))
+		}
+	}
+	return diags
 }
 
 func mapPolicyToState(policy *client.PlatformPolicy, state *PlatformPolicyResourceModel) {
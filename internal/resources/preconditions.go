@@ -0,0 +1,42 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+// Updates are conditional on the updated_at an object had when Terraform last
+// refreshed it. If someone changes the object between plan and apply, the API
+// rejects the update instead of Terraform silently overwriting their change.
+
+// errChangedSinceRefresh is returned when the provider itself finds that an
+// object no longer has the values Terraform last refreshed.
+var errChangedSinceRefresh = errors.New("the values in state no longer match the API")
+
+// withStateVersion returns ctx with an If-Match precondition on the updated_at
+// in state. Objects whose state has no updated_at are updated unconditionally.
+func withStateVersion(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics) context.Context {
+	var updatedAt types.String
+	diags.Append(state.GetAttribute(ctx, path.Root("updated_at"), &updatedAt)...)
+	return client.WithIfMatch(ctx, updatedAt.ValueString())
+}
+
+// addUpdateError adds the error for a failed update. detail describes the
+// update, e.g. "Could not update team platform". A failed precondition means
+// the object changed since it was last refreshed, so the user is asked to re-plan.
+func addUpdateError(diags *diag.Diagnostics, summary, detail string, err error) {
+	if client.IsPreconditionFailed(err) || errors.Is(err, errChangedSinceRefresh) {
+		diags.AddError(summary, fmt.Sprintf(
+			"%s: it was changed outside Terraform since it was last refreshed, so the update was not applied to avoid overwriting that change. "+
+				"Run terraform plan again to review the change, then apply. (%s)", detail, err))
+		return
+	}
+	diags.AddError(summary, fmt.Sprintf("%s: %s", detail, err))
+}
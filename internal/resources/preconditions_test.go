@@ -0,0 +1,33 @@
+package resources
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+func TestAddUpdateError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantDetail string
+	}{
+		{"conflict", &client.APIError{StatusCode: 412, Code: "precondition_failed"}, "changed outside Terraform"},
+		{"other", errors.New("boom"), "Could not update team platform: boom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addUpdateError(&diags, "Error Updating Team", "Could not update team platform", tt.err)
+			if len(diags) != 1 || diags[0].Summary() != "Error Updating Team" {
+				t.Fatalf("diags = %v", diags)
+			}
+			if !strings.Contains(diags[0].Detail(), tt.wantDetail) {
+				t.Errorf("detail = %q, want it to contain %q", diags[0].Detail(), tt.wantDetail)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+// Kinds of Shoehorn objects that attributes can reference.
+const (
+	referenceTeam   = "team"
+	referenceEntity = "entity"
+	referenceUser   = "user"
+	referenceRole   = "role"
+)
+
+// referenceChanged reports whether a planned reference should be validated:
+// it must be known, non-empty and different from the prior state value.
+func referenceChanged(planned, prior types.String) bool {
+	if planned.IsNull() || planned.IsUnknown() || planned.ValueString() == "" {
+		return false
+	}
+	return prior.IsNull() || prior.IsUnknown() || prior.ValueString() != planned.ValueString()
+}
+
+// validateReference checks that ref resolves to an existing object of one of
+// the given kinds and adds an attribute error at p if it does not. It does
+// nothing unless the provider enables validate_references.
+func validateReference(ctx context.Context, c *client.Client, p path.Path, ref string, kinds ...string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if c == nil || c.References == nil {
+		return diags
+	}
+
+	for _, kind := range kinds {
+		var found bool
+		var err error
+		switch kind {
+		case referenceTeam:
+			found, err = c.References.HasTeam(ctx, ref)
+		case referenceEntity:
+			found, err = c.References.HasEntity(ctx, ref)
+		case referenceUser:
+			found, err = c.References.HasUser(ctx, ref)
+		case referenceRole:
+			found, err = c.References.HasRole(ctx, ref)
+		}
+		if err != nil {
+			diags.AddError("Error Validating References", fmt.Sprintf("Could not look up %ss: %s", kind, err))
+			return diags
+		}
+		if found {
+			return diags
+		}
+	}
+
+	diags.AddAttributeError(
+		p,
+		"Unknown Reference",
+		fmt.Sprintf("%q does not match any existing %s.", ref, strings.Join(kinds, " or ")),
+	)
+	return diags
+}
+
+// validateChangedReference validates planned against kinds when it differs
+// from the prior state value.
+func validateChangedReference(ctx context.Context, c *client.Client, p path.Path, planned, prior types.String, kinds ...string) diag.Diagnostics {
+	if !referenceChanged(planned, prior) {
+		return nil
+	}
+	return validateReference(ctx, c, p, planned.ValueString(), kinds...)
+}
+
+// validateRelationTargets checks that each relation target in the planned
+// relations JSON resolves to an existing entity. Targets already present in
+// the prior relations are not checked again.
+func validateRelationTargets(ctx context.Context, c *client.Client, planned, prior types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if c == nil || c.References == nil || !referenceChanged(planned, prior) {
+		return diags
+	}
+
+	targets := relationTargets(planned.ValueString())
+	previous := map[string]bool{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		for _, t := range relationTargets(prior.ValueString()) {
+			previous[t] = true
+		}
+	}
+
+	for _, target := range targets {
+		if previous[target] {
+			continue
+		}
+		found, err := c.References.HasEntity(ctx, target)
+		if err != nil {
+			diags.AddError("Error Validating References", fmt.Sprintf("Could not look up entities: %s", err))
+			return diags
+		}
+		if !found {
+			diags.AddAttributeError(
+				path.Root("relations"),
+				"Unknown Reference",
+				fmt.Sprintf("Relation target %q does not match any existing entity.", target),
+			)
+		}
+	}
+	return diags
+}
+
+// relationTargets returns the targets of a relations JSON array. Invalid JSON
+// yields no targets; it is reported when the manifest is built.
+func relationTargets(relationsJSON string) []string {
+	var relations []struct {
+		Target string `json:"target"`
+	}
+	if err := json.Unmarshal([]byte(relationsJSON), &relations); err != nil {
+		return nil
+	}
+	targets := make([]string, 0, len(relations))
+	for _, rel := range relations {
+		if rel.Target != "" {
+			targets = append(targets, rel.Target)
+		}
+	}
+	return targets
+}
@@ -0,0 +1,130 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+// referencesTestClient returns a client with reference validation enabled,
+// backed by a fake API seeded with a team, an entity, a user and a role.
+func referencesTestClient(t *testing.T) *client.Client {
+	t.Helper()
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	api.AddUser(client.DirectoryUser{ID: "u-1", Username: "ada", Email: "ada@example.com", Enabled: true})
+
+	c := api.Client()
+	ctx := context.Background()
+	if _, err := c.CreateTeam(ctx, client.CreateTeamRequest{Name: "Platform", Slug: "platform"}); err != nil {
+		t.Fatalf("CreateTeam() error = %v", err)
+	}
+	if _, err := c.CreateEntity(ctx, client.CreateEntityRequest{Content: "service:\n  id: payments\n  name: payments\n  type: service\n"}); err != nil {
+		t.Fatalf("CreateEntity() error = %v", err)
+	}
+	if err := c.AddUserRole(ctx, "u-1", client.RoleRequest{Role: "admin"}); err != nil {
+		t.Fatalf("AddUserRole() error = %v", err)
+	}
+
+	c.References = client.NewReferences(c)
+	return c
+}
+
+func TestValidateReference_DisabledByDefault(t *testing.T) {
+	c := client.NewClient("http://127.0.0.1:0", "key", 0)
+	if diags := validateReference(context.Background(), c, path.Root("owner"), "nope", referenceTeam); diags.HasError() {
+		t.Errorf("validation should be skipped without References, got %v", diags)
+	}
+}
+
+func TestValidateChangedReference(t *testing.T) {
+	c := referencesTestClient(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		planned, prior types.String
+		kinds          []string
+		wantErr        bool
+	}{
+		{"existing team", types.StringValue("platform"), types.StringNull(), []string{referenceTeam}, false},
+		{"missing team", types.StringValue("platfrom"), types.StringNull(), []string{referenceTeam}, true},
+		{"unchanged reference is not checked", types.StringValue("deleted-team"), types.StringValue("deleted-team"), []string{referenceTeam}, false},
+		{"unknown value is not checked", types.StringUnknown(), types.StringNull(), []string{referenceTeam}, false},
+		{"entity by type and id", types.StringValue("service:payments"), types.StringNull(), []string{referenceEntity}, false},
+		{"missing entity", types.StringValue("service:ledger"), types.StringNull(), []string{referenceEntity}, true},
+		{"user or team matches team", types.StringValue("platform"), types.StringNull(), []string{referenceUser, referenceTeam}, false},
+		{"user or team matches email", types.StringValue("ada@example.com"), types.StringNull(), []string{referenceUser, referenceTeam}, false},
+		{"missing user or team", types.StringValue("grace"), types.StringNull(), []string{referenceUser, referenceTeam}, true},
+	}
+	for _, tt := range tests {
+		diags := validateChangedReference(ctx, c, path.Root("ref"), tt.planned, tt.prior, tt.kinds...)
+		if diags.HasError() != tt.wantErr {
+			t.Errorf("%s: HasError() = %v, want %v (%v)", tt.name, diags.HasError(), tt.wantErr, diags)
+		}
+	}
+}
+
+func TestValidateRelationTargets(t *testing.T) {
+	c := referencesTestClient(t)
+	planned := types.StringValue(`[{"type":"depends_on","target":"service:payments"},{"type":"calls","target":"service:ledger"},{"type":"calls","target":"service:legacy"}]`)
+	prior := types.StringValue(`[{"type":"calls","target":"service:legacy"}]`)
+
+	diags := validateRelationTargets(context.Background(), c, planned, prior)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("ErrorsCount() = %d, want 1: %v", diags.ErrorsCount(), diags)
+	}
+	if !strings.Contains(diags[0].Detail(), "service:ledger") {
+		t.Errorf("detail = %q, want it to name service:ledger", diags[0].Detail())
+	}
+}
+
+func TestUserRoleResource_ModifyPlan_ValidatesReferences(t *testing.T) {
+	r := &UserRoleResource{client: referencesTestClient(t)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+
+	planFor := func(userID, role string) tfsdk.Plan {
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		m := UserRoleResourceModel{ID: types.StringUnknown(), UserID: types.StringValue(userID), Role: types.StringValue(role), Email: types.StringUnknown()}
+		if diags := state.Set(context.Background(), &m); diags.HasError() {
+			t.Fatalf("encoding model: %v", diags)
+		}
+		return tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw}
+	}
+
+	tests := []struct {
+		userID, role string
+		wantPaths    []string
+	}{
+		{"u-1", "admin", nil},
+		{"u-2", "admin", []string{"user_id"}},
+		{"u-1", "amdin", []string{"role"}},
+	}
+	for _, tt := range tests {
+		plan := planFor(tt.userID, tt.role)
+		req := resource.ModifyPlanRequest{
+			Plan:  plan,
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: nullStateLike(tfsdk.State{Raw: plan.Raw})},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(context.Background(), req, resp)
+
+		var got []string
+		for _, d := range resp.Diagnostics.Errors() {
+			if withPath, ok := d.(interface{ Path() path.Path }); ok {
+				got = append(got, withPath.Path().String())
+			}
+		}
+		if strings.Join(got, ",") != strings.Join(tt.wantPaths, ",") {
+			t.Errorf("%s/%s: error paths = %v, want %v (%v)", tt.userID, tt.role, got, tt.wantPaths, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// What destroying a resource that configures pre-existing server state does
+// with that state.
+const (
+	onDestroyRetain  = "retain"
+	onDestroyRestore = "restore"
+	onDestroyReset   = "reset_to_defaults"
+)
+
+// snapshotKey is the private state key holding the server values captured
+// before Terraform first changed them.
+const snapshotKey = "snapshot"
+
+// privateState is the part of the framework's resource private state used to
+// keep snapshots.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// onDestroyAttribute returns the on_destroy schema attribute. what names the
+// server state being configured, e.g. "the tenant settings".
+func onDestroyAttribute(what string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf(
+			"What destroying the resource does to %s: %q leaves the last applied values in place, %q puts back the values captured when the resource was created or imported, and %q resets them to the Shoehorn defaults. Defaults to %q.",
+			what, onDestroyRetain, onDestroyRestore, onDestroyReset, onDestroyRetain,
+		),
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(onDestroyRetain),
+		Validators: []validator.String{
+			stringvalidator.OneOf(onDestroyRetain, onDestroyRestore, onDestroyReset),
+		},
+	}
+}
+
+// onDestroyOrDefault returns the stored on_destroy mode, which is null right
+// after import.
+func onDestroyOrDefault(v types.String) types.String {
+	if v.IsNull() || v.IsUnknown() {
+		return types.StringValue(onDestroyRetain)
+	}
+	return v
+}
+
+// saveSnapshot stores snapshot in private state unless one was already
+// captured, so the values from before Terraform's first change are kept.
+func saveSnapshot(ctx context.Context, p privateState, snapshot any) diag.Diagnostics {
+	existing, diags := p.GetKey(ctx, snapshotKey)
+	if diags.HasError() || len(existing) > 0 {
+		return diags
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		diags.AddError("Error Saving Snapshot", fmt.Sprintf("Could not encode the current server values: %s", err))
+		return diags
+	}
+	return p.SetKey(ctx, snapshotKey, data)
+}
+
+// loadSnapshot decodes the snapshot in private state into v and reports
+// whether one was found.
+func loadSnapshot(ctx context.Context, p privateState, v any) (bool, diag.Diagnostics) {
+	data, diags := p.GetKey(ctx, snapshotKey)
+	if diags.HasError() || len(data) == 0 {
+		return false, diags
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		diags.AddError("Error Reading Snapshot", fmt.Sprintf("Could not decode the snapshot saved at create or import: %s", err))
+		return false, diags
+	}
+	return true, diags
+}
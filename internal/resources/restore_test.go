@@ -0,0 +1,184 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+// memoryPrivateState is an in-memory privateState for tests.
+type memoryPrivateState map[string][]byte
+
+func (m memoryPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return m[key], nil
+}
+
+func (m memoryPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	m[key] = value
+	return nil
+}
+
+func TestSaveSnapshot_KeepsFirstSnapshot(t *testing.T) {
+	ctx := context.Background()
+	p := memoryPrivateState{}
+
+	saveSnapshot(ctx, p, client.UpdatePolicyRequest{Enforcement: "warn"})
+	saveSnapshot(ctx, p, client.UpdatePolicyRequest{Enforcement: "block"})
+
+	var got client.UpdatePolicyRequest
+	found, diags := loadSnapshot(ctx, p, &got)
+	if diags.HasError() || !found {
+		t.Fatalf("loadSnapshot() found = %v, diags = %v", found, diags)
+	}
+	if got.Enforcement != "warn" {
+		t.Errorf("Enforcement = %q, want the first snapshot's %q", got.Enforcement, "warn")
+	}
+}
+
+func TestDestroyTenantSettings(t *testing.T) {
+	ctx := context.Background()
+	original := client.TenantSettings{
+		Appearance:   client.AppearanceSettings{PlatformName: "Acme Portal", PrimaryColor: "#111111"},
+		Announcement: client.AnnouncementSettings{Enabled: true, Message: "Welcome"},
+	}
+
+	tests := []struct {
+		mode             string
+		wantPlatformName string
+	}{
+		{onDestroyRetain, "Terraform Portal"},
+		{onDestroyRestore, "Acme Portal"},
+		{onDestroyReset, ""},
+	}
+	for _, tt := range tests {
+		api := fakeapi.NewServer()
+		t.Cleanup(api.Close)
+		api.SetSettings(original)
+		c := api.Client()
+		r := &TenantSettingsResource{client: c}
+
+		p := memoryPrivateState{}
+		if diags := r.snapshotSettings(ctx, p); diags.HasError() {
+			t.Fatalf("snapshotSettings() error = %v", diags)
+		}
+		if _, err := c.UpdateSettings(ctx, client.UpdateSettingsRequest{Appearance: client.AppearanceSettings{PlatformName: "Terraform Portal"}}); err != nil {
+			t.Fatalf("UpdateSettings() error = %v", err)
+		}
+
+		if diags := destroyTenantSettings(ctx, c, tt.mode, p); diags.HasError() {
+			t.Fatalf("%s: destroyTenantSettings() error = %v", tt.mode, diags)
+		}
+		got, _ := c.GetSettings(ctx)
+		if got.Appearance.PlatformName != tt.wantPlatformName {
+			t.Errorf("%s: platform name = %q, want %q", tt.mode, got.Appearance.PlatformName, tt.wantPlatformName)
+		}
+		if tt.mode == onDestroyRestore && (!got.Announcement.Enabled || got.Announcement.Message != "Welcome") {
+			t.Errorf("restore: announcement = %+v, want the original", got.Announcement)
+		}
+	}
+}
+
+func TestDestroyTenantSettings_RestoreWithoutSnapshotWarns(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+
+	diags := destroyTenantSettings(context.Background(), api.Client(), onDestroyRestore, memoryPrivateState{})
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("diags = %v, want a single warning", diags)
+	}
+}
+
+func TestDestroyPlatformPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		mode            string
+		wantEnabled     bool
+		wantEnforcement string
+	}{
+		{onDestroyRetain, false, "block"},
+		{onDestroyRestore, true, "audit"},
+		{onDestroyReset, true, "warn"},
+	}
+	for _, tt := range tests {
+		api := fakeapi.NewServer()
+		t.Cleanup(api.Close)
+		api.AddPolicy(client.PlatformPolicy{ID: "p-1", Key: "required-docs", Enabled: true, Enforcement: "warn"})
+		c := api.Client()
+
+		// Someone changed the policy before Terraform took it over.
+		enabled := true
+		if _, err := c.UpdatePolicy(ctx, "p-1", client.UpdatePolicyRequest{Enabled: &enabled, Enforcement: "audit"}); err != nil {
+			t.Fatalf("UpdatePolicy() error = %v", err)
+		}
+		policy, _ := c.GetPolicy(ctx, "required-docs")
+		p := memoryPrivateState{}
+		saveSnapshot(ctx, p, policySnapshot(policy))
+
+		enabled = false
+		if _, err := c.UpdatePolicy(ctx, "p-1", client.UpdatePolicyRequest{Enabled: &enabled, Enforcement: "block"}); err != nil {
+			t.Fatalf("UpdatePolicy() error = %v", err)
+		}
+
+		if diags := destroyPlatformPolicy(ctx, c, "p-1", tt.mode, p); diags.HasError() {
+			t.Fatalf("%s: destroyPlatformPolicy() error = %v", tt.mode, diags)
+		}
+		got, _ := c.GetPolicy(ctx, "required-docs")
+		if got.Enabled != tt.wantEnabled || got.Enforcement != tt.wantEnforcement {
+			t.Errorf("%s: policy = enabled %v, enforcement %q; want %v, %q", tt.mode, got.Enabled, got.Enforcement, tt.wantEnabled, tt.wantEnforcement)
+		}
+	}
+}
+
+func TestDestroySettingsSection(t *testing.T) {
+	ctx := context.Background()
+	original := client.TenantSettings{
+		Appearance: client.AppearanceSettings{PlatformName: "Acme Portal"},
+		Forge:      client.ForgeSettings{DefaultOrg: "acme"},
+	}
+
+	tests := []struct {
+		mode           string
+		wantDefaultOrg string
+	}{
+		{onDestroyRetain, "terraform"},
+		{onDestroyRestore, "acme"},
+		{onDestroyReset, ""},
+	}
+	for _, tt := range tests {
+		api := fakeapi.NewServer()
+		t.Cleanup(api.Close)
+		api.SetSettings(original)
+		c := api.Client()
+		p := memoryPrivateState{}
+
+		if diags := snapshotSettingsSection(ctx, c, p, tenantForgeSection); diags.HasError() {
+			t.Fatalf("snapshotSettingsSection() error = %v", diags)
+		}
+		if _, err := applySettingsSection(ctx, c, tenantForgeSection, nil, client.PatchSettingsRequest{Forge: &client.ForgeSettings{DefaultOrg: "terraform"}}); err != nil {
+			t.Fatalf("applySettingsSection() error = %v", err)
+		}
+		// Someone else rebrands the portal while the forge settings are managed.
+		if _, err := c.PatchSettings(ctx, client.PatchSettingsRequest{Appearance: &client.AppearanceSettings{PlatformName: "Rebranded"}}); err != nil {
+			t.Fatalf("PatchSettings() error = %v", err)
+		}
+
+		if diags := destroySettingsSection(ctx, c, tt.mode, p, tenantForgeSection, client.PatchSettingsRequest{Forge: &client.ForgeSettings{}}); diags.HasError() {
+			t.Fatalf("%s: destroySettingsSection() error = %v", tt.mode, diags)
+		}
+
+		settings, err := c.GetSettings(ctx)
+		if err != nil {
+			t.Fatalf("GetSettings() error = %v", err)
+		}
+		if settings.Forge.DefaultOrg != tt.wantDefaultOrg {
+			t.Errorf("%s: DefaultOrg = %q, want %q", tt.mode, settings.Forge.DefaultOrg, tt.wantDefaultOrg)
+		}
+		if settings.Appearance.PlatformName != "Rebranded" {
+			t.Errorf("%s: PlatformName = %q, want the other section left alone", tt.mode, settings.Appearance.PlatformName)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var (
+	_ resource.Resource                = &RoleResource{}
+	_ resource.ResourceWithImportState = &RoleResource{}
+)
+
+// RoleResource defines the resource implementation.
+type RoleResource struct {
+	client *client.Client
+}
+
+// RoleResourceModel describes the resource data model.
+type RoleResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Description types.String `tfsdk:"description"`
+	Color       types.String `tfsdk:"color"`
+	Icon        types.String `tfsdk:"icon"`
+	Permissions types.Set    `tfsdk:"permissions"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// NewRoleResource creates a new role resource.
+func NewRoleResource() resource.Resource {
+	return &RoleResource{}
+}
+
+func (r *RoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *RoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom Shoehorn role (permission bundle). The role name can then be assigned with shoehorn_user_role, shoehorn_user_roles and shoehorn_group_role_mapping. Built-in roles cannot be managed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the role.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The role name used in role assignments and group mappings. Changing this forces a new role.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The human-readable name shown in the Shoehorn UI.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of what the role grants.",
+				Optional:    true,
+			},
+			"color": schema.StringAttribute{
+				Description: "Badge color for the role (hex, e.g., #3b82f6).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`),
+						"must be a valid hex color code (e.g., #3b82f6)",
+					),
+				},
+			},
+			"icon": schema.StringAttribute{
+				Description: "Icon name shown next to the role in the Shoehorn UI.",
+				Optional:    true,
+			},
+			"permissions": schema.SetAttribute{
+				Description: "The permissions granted by the role, e.g. `entity:read`. Use the shoehorn_roles data source to see the permissions of existing roles.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The creation timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The last update timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *RoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "creating role")
+
+	var plan RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bundleReq, diags := roleRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bundle, err := r.client.CreateBundle(ctx, bundleReq)
+	if err != nil {
+		if client.IsAlreadyExists(err) {
+			resp.Diagnostics.AddError(
+				"Role Already Exists",
+				fmt.Sprintf("A role named %q already exists. Use `terraform import shoehorn_role.<name> %s` to adopt it.", plan.Name.ValueString(), plan.Name.ValueString()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Error Creating Role", fmt.Sprintf("Could not create role: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(mapBundleToState(ctx, bundle, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "reading role")
+
+	var state RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bundle, err := r.client.GetBundle(ctx, state.Name.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "role not found, removing from state", map[string]any{"name": state.Name.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Role", fmt.Sprintf("Could not read role %s: %s", state.Name.ValueString(), err))
+		return
+	}
+
+	if bundle.System {
+		resp.Diagnostics.AddError(
+			"Built-in Role",
+			fmt.Sprintf("Role %q is a built-in role and cannot be managed by shoehorn_role. Use the shoehorn_roles data source to reference it.", bundle.Name),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapBundleToState(ctx, bundle, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "updating role")
+
+	var plan RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bundleReq, diags := roleRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateCtx := withStateVersion(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bundle, err := r.client.UpdateBundle(updateCtx, plan.Name.ValueString(), bundleReq)
+	if err != nil {
+		addUpdateError(&resp.Diagnostics, "Error Updating Role", fmt.Sprintf("Could not update role %s", plan.Name.ValueString()), err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapBundleToState(ctx, bundle, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "deleting role")
+
+	var state RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteBundle(ctx, state.Name.ValueString()); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Role", fmt.Sprintf("Could not delete role %s: %s", state.Name.ValueString(), err))
+		return
+	}
+}
+
+func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	bundle, err := r.client.GetBundle(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Role", fmt.Sprintf("Could not read role %s: %s", req.ID, err))
+		return
+	}
+	if bundle.System {
+		resp.Diagnostics.AddError(
+			"Built-in Role",
+			fmt.Sprintf("Role %q is a built-in role and cannot be managed by shoehorn_role. Use the shoehorn_roles data source to reference it.", bundle.Name),
+		)
+		return
+	}
+
+	var state RoleResourceModel
+	resp.Diagnostics.Append(mapBundleToState(ctx, bundle, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// roleRequest builds the bundle create/replace request from the plan.
+func roleRequest(ctx context.Context, plan RoleResourceModel) (client.BundleRequest, diag.Diagnostics) {
+	var permissions []string
+	diags := plan.Permissions.ElementsAs(ctx, &permissions, false)
+
+	return client.BundleRequest{
+		Name:        plan.Name.ValueString(),
+		DisplayName: plan.DisplayName.ValueString(),
+		Description: plan.Description.ValueString(),
+		Color:       plan.Color.ValueString(),
+		Icon:        plan.Icon.ValueString(),
+		Permissions: permissions,
+	}, diags
+}
+
+func mapBundleToState(ctx context.Context, bundle *client.Bundle, state *RoleResourceModel) diag.Diagnostics {
+	state.ID = types.StringValue(bundle.ID)
+	state.Name = types.StringValue(bundle.Name)
+	state.DisplayName = types.StringValue(bundle.DisplayName)
+
+	state.Description = preserveOrNull(bundle.Description, state.Description)
+	state.Color = preserveOrNull(bundle.Color, state.Color)
+	state.Icon = preserveOrNull(bundle.Icon, state.Icon)
+	state.CreatedAt = stringValueOrNull(bundle.CreatedAt)
+	state.UpdatedAt = stringValueOrNull(bundle.UpdatedAt)
+
+	permissions := bundle.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+	var diags diag.Diagnostics
+	state.Permissions, diags = types.SetValueFrom(ctx, types.StringType, permissions)
+	return diags
+}
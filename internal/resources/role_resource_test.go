@@ -0,0 +1,165 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+func roleSchema() resource.SchemaResponse {
+	resp := resource.SchemaResponse{}
+	NewRoleResource().Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	return resp
+}
+
+// rolePlan encodes m as a plan for the role resource.
+func rolePlan(t *testing.T, m RoleResourceModel) tfsdk.Plan {
+	t.Helper()
+	state := tfsdk.State{Schema: roleSchema().Schema}
+	if diags := state.Set(context.Background(), &m); diags.HasError() {
+		t.Fatalf("encoding model: %v", diags)
+	}
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+func TestRoleResource_Metadata(t *testing.T) {
+	r := NewRoleResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_role" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_role")
+	}
+}
+
+func TestRoleResource_Schema_HasRequiredAttributes(t *testing.T) {
+	attrs := roleSchema().Schema.Attributes
+	for _, name := range []string{"id", "name", "display_name", "description", "color", "icon", "permissions", "created_at", "updated_at"} {
+		if _, ok := attrs[name]; !ok {
+			t.Errorf("schema missing attribute %q", name)
+		}
+	}
+	for _, name := range []string{"name", "display_name", "permissions"} {
+		if !attrs[name].IsRequired() {
+			t.Errorf("%s should be required", name)
+		}
+	}
+}
+
+func TestRoleResource_Configure_WithValidClient(t *testing.T) {
+	r := &RoleResource{}
+	c := client.NewClient("https://test.example.com", "key", 30*time.Second)
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: c,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if r.client != c {
+		t.Error("client not set correctly")
+	}
+}
+
+func TestRoleResource_Configure_WrongType(t *testing.T) {
+	r := &RoleResource{}
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: "not a client",
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected error for wrong provider data type")
+	}
+}
+
+func TestRoleResource_Lifecycle(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	r := &RoleResource{client: api.Client()}
+	ctx := context.Background()
+	schemaResp := roleSchema()
+
+	permissions, _ := types.SetValueFrom(ctx, types.StringType, []string{"entity:write", "entity:read"})
+	plan := rolePlan(t, RoleResourceModel{
+		ID: types.StringUnknown(), Name: types.StringValue("catalog-editor"), DisplayName: types.StringValue("Catalog Editor"),
+		Description: types.StringNull(), Color: types.StringValue("#3b82f6"), Icon: types.StringNull(),
+		Permissions: permissions, CreatedAt: types.StringUnknown(), UpdatedAt: types.StringUnknown(),
+	})
+
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() error = %v", createResp.Diagnostics)
+	}
+	var created RoleResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.ID.IsNull() || created.Color.ValueString() != "#3b82f6" || !created.Description.IsNull() {
+		t.Errorf("created = %+v", created)
+	}
+
+	// Creating the same role again points at import.
+	dupResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, dupResp)
+	if !dupResp.Diagnostics.HasError() || !strings.Contains(dupResp.Diagnostics.Errors()[0].Detail(), "terraform import") {
+		t.Errorf("duplicate Create() diagnostics = %v, want an import hint", dupResp.Diagnostics)
+	}
+
+	permissions, _ = types.SetValueFrom(ctx, types.StringType, []string{"entity:read"})
+	created.Permissions = permissions
+	created.Icon = types.StringValue("book")
+	updateResp := &resource.UpdateResponse{State: createResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: rolePlan(t, created), State: createResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update() error = %v", updateResp.Diagnostics)
+	}
+
+	bundle, err := api.Client().GetBundle(ctx, "catalog-editor")
+	if err != nil {
+		t.Fatalf("GetBundle() error = %v", err)
+	}
+	if strings.Join(bundle.Permissions, ",") != "entity:read" || bundle.Icon != "book" {
+		t.Errorf("bundle = %+v", bundle)
+	}
+
+	deleteResp := &resource.DeleteResponse{State: updateResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Delete() error = %v", deleteResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, readResp)
+	if readResp.Diagnostics.HasError() || !readResp.State.Raw.IsNull() {
+		t.Errorf("Read() after delete should remove the resource: %v", readResp.Diagnostics)
+	}
+}
+
+func TestRoleResource_Read_BuiltInRole(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	api.AddBundle(client.Bundle{Name: "tenant:admin", DisplayName: "Admin", Permissions: []string{"*"}})
+	r := &RoleResource{client: api.Client()}
+	ctx := context.Background()
+
+	state := tfsdk.State(rolePlan(t, RoleResourceModel{
+		ID: types.StringNull(), Name: types.StringValue("tenant:admin"), DisplayName: types.StringNull(),
+		Description: types.StringNull(), Color: types.StringNull(), Icon: types.StringNull(),
+		Permissions: types.SetNull(types.StringType), CreatedAt: types.StringNull(), UpdatedAt: types.StringNull(),
+	}))
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("Read() should refuse to manage a built-in role")
+	}
+}
@@ -0,0 +1,362 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &TeamGroupSyncResource{}
+	_ resource.ResourceWithImportState    = &TeamGroupSyncResource{}
+	_ resource.ResourceWithValidateConfig = &TeamGroupSyncResource{}
+	_ resource.ResourceWithModifyPlan     = &TeamGroupSyncResource{}
+)
+
+// groupPathPattern matches absolute IdP group paths such as /eng/payments.
+var groupPathPattern = regexp.MustCompile(`^/.+`)
+
+// TeamGroupSyncResource defines the resource implementation.
+type TeamGroupSyncResource struct {
+	client *client.Client
+}
+
+// TeamGroupSyncResourceModel describes the resource data model.
+type TeamGroupSyncResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	TeamID           types.String `tfsdk:"team_id"`
+	GroupPaths       types.Set    `tfsdk:"group_paths"`
+	IncludeSubgroups types.Bool   `tfsdk:"include_subgroups"`
+	Role             types.String `tfsdk:"role"`
+	RoleMappings     types.Map    `tfsdk:"role_mappings"`
+	MemberCount      types.Int64  `tfsdk:"member_count"`
+	LastSyncedAt     types.String `tfsdk:"last_synced_at"`
+}
+
+// NewTeamGroupSyncResource creates a new team group sync resource.
+func NewTeamGroupSyncResource() resource.Resource {
+	return &TeamGroupSyncResource{}
+}
+
+func (r *TeamGroupSyncResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_group_sync"
+}
+
+func (r *TeamGroupSyncResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Keeps a team's membership in sync with one or more IdP groups. Members of the groups become team members; they are managed by this resource and are not reported in the team's members attribute. Destroying the resource removes the members it added. Use the shoehorn_team_group_sync_preview data source to see the resulting membership.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the synced team.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The ID or slug of the team whose membership is synced. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_paths": schema.SetAttribute{
+				Description: "IdP group paths whose members become team members, e.g. /eng/payments.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(groupPathPattern, "must be a group path starting with /")),
+				},
+			},
+			"include_subgroups": schema.BoolAttribute{
+				Description: "Whether members of subgroups of group_paths are included. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"role": schema.StringAttribute{
+				Description: "The team role given to synced members without a role mapping. Defaults to member.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("member"),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"role_mappings": schema.MapAttribute{
+				Description: "Team roles keyed by group path, e.g. { \"/eng/payments/leads\" = \"manager\" }. A member in several mapped groups gets the role of the deepest one. Each path must be one of group_paths or, with include_subgroups, below one.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(groupPathPattern, "must be a group path starting with /")),
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"member_count": schema.Int64Attribute{
+				Description: "The number of team members produced by the rule at the last sync.",
+				Computed:    true,
+			},
+			"last_synced_at": schema.StringAttribute{
+				Description: "When the membership was last synced.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *TeamGroupSyncResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ValidateConfig rejects role mappings for groups the rule does not cover.
+func (r *TeamGroupSyncResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config TeamGroupSyncResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.GroupPaths.IsUnknown() || config.IncludeSubgroups.IsUnknown() || config.RoleMappings.IsNull() || config.RoleMappings.IsUnknown() {
+		return
+	}
+
+	var roots []string
+	var mappings map[string]types.String
+	resp.Diagnostics.Append(config.GroupPaths.ElementsAs(ctx, &roots, true)...)
+	resp.Diagnostics.Append(config.RoleMappings.ElementsAs(ctx, &mappings, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for groupPath := range mappings {
+		if !groupPathCovered(groupPath, roots, config.IncludeSubgroups.ValueBool()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("role_mappings").AtMapKey(groupPath),
+				"Unsynced Group In Role Mapping",
+				fmt.Sprintf("Group %q is not synced by this rule. Add it to group_paths, or set include_subgroups if it is below one of them.", groupPath),
+			)
+		}
+	}
+}
+
+// ModifyPlan validates team_id when the provider enables validate_references.
+func (r *TeamGroupSyncResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state TeamGroupSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateChangedReference(ctx, r.client, path.Root("team_id"), plan.TeamID, state.TeamID, referenceTeam)...)
+}
+
+func (r *TeamGroupSyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "creating team group sync")
+
+	var plan TeamGroupSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := plan.TeamID.ValueString()
+	if _, err := r.client.GetTeamGroupSync(ctx, teamID); err == nil {
+		resp.Diagnostics.AddError(
+			"Team Group Sync Already Exists",
+			fmt.Sprintf("Team %q already has a group sync rule. Use `terraform import shoehorn_team_group_sync.<name> %s` to adopt it.", teamID, teamID),
+		)
+		return
+	} else if !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error Creating Team Group Sync", fmt.Sprintf("Could not check for an existing group sync rule: %s", err))
+		return
+	}
+
+	syncReq, diags := teamGroupSyncRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sync, err := r.client.SetTeamGroupSync(ctx, teamID, syncReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Team Group Sync", fmt.Sprintf("Could not sync team %s with its groups: %s", teamID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(mapTeamGroupSyncToState(ctx, sync, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TeamGroupSyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "reading team group sync")
+
+	var state TeamGroupSyncResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sync, err := r.client.GetTeamGroupSync(ctx, state.TeamID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "team group sync not found, removing from state", map[string]any{"team_id": state.TeamID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Team Group Sync", fmt.Sprintf("Could not read group sync for team %s: %s", state.TeamID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(mapTeamGroupSyncToState(ctx, sync, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *TeamGroupSyncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "updating team group sync")
+
+	var plan TeamGroupSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	syncReq, diags := teamGroupSyncRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sync, err := r.client.SetTeamGroupSync(ctx, plan.TeamID.ValueString(), syncReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Team Group Sync", fmt.Sprintf("Could not sync team %s with its groups: %s", plan.TeamID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(mapTeamGroupSyncToState(ctx, sync, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TeamGroupSyncResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "deleting team group sync")
+
+	var state TeamGroupSyncResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteTeamGroupSync(ctx, state.TeamID.ValueString()); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Team Group Sync", fmt.Sprintf("Could not delete group sync for team %s: %s", state.TeamID.ValueString(), err))
+		return
+	}
+}
+
+// ImportState imports a sync rule by team ID or slug. team_id keeps the import
+// ID as given, so a config that names the team by slug does not plan a replace.
+func (r *TeamGroupSyncResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	sync, err := r.client.GetTeamGroupSync(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Team Group Sync", fmt.Sprintf("Could not read group sync for team %s: %s", req.ID, err))
+		return
+	}
+
+	state := TeamGroupSyncResourceModel{TeamID: types.StringValue(req.ID), RoleMappings: types.MapNull(types.StringType)}
+	resp.Diagnostics.Append(mapTeamGroupSyncToState(ctx, sync, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// teamGroupSyncRequest builds the group sync request from the plan.
+func teamGroupSyncRequest(ctx context.Context, plan TeamGroupSyncResourceModel) (client.TeamGroupSyncRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := client.TeamGroupSyncRequest{
+		IncludeSubgroups: plan.IncludeSubgroups.ValueBool(),
+		DefaultRole:      plan.Role.ValueString(),
+	}
+	diags.Append(plan.GroupPaths.ElementsAs(ctx, &req.GroupPaths, false)...)
+	if !plan.RoleMappings.IsNull() && !plan.RoleMappings.IsUnknown() {
+		diags.Append(plan.RoleMappings.ElementsAs(ctx, &req.RoleMappings, false)...)
+	}
+	return req, diags
+}
+
+func mapTeamGroupSyncToState(ctx context.Context, sync *client.TeamGroupSync, state *TeamGroupSyncResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(sync.TeamID)
+	if state.TeamID.IsNull() || state.TeamID.IsUnknown() {
+		state.TeamID = types.StringValue(sync.TeamID)
+	}
+	state.IncludeSubgroups = types.BoolValue(sync.IncludeSubgroups)
+	state.Role = types.StringValue(sync.DefaultRole)
+	state.MemberCount = types.Int64Value(int64(sync.MemberCount))
+	state.LastSyncedAt = stringValueOrNull(sync.LastSyncedAt)
+
+	groupPaths := sync.GroupPaths
+	if groupPaths == nil {
+		groupPaths = []string{}
+	}
+	var d diag.Diagnostics
+	state.GroupPaths, d = types.SetValueFrom(ctx, types.StringType, groupPaths)
+	diags.Append(d...)
+
+	// An empty mapping and an unset one are the same to the API; keep
+	// whichever form is configured.
+	if len(sync.RoleMappings) == 0 {
+		if state.RoleMappings.IsUnknown() || len(state.RoleMappings.Elements()) > 0 {
+			state.RoleMappings = types.MapNull(types.StringType)
+		}
+	} else {
+		state.RoleMappings, d = types.MapValueFrom(ctx, types.StringType, sync.RoleMappings)
+		diags.Append(d...)
+	}
+	return diags
+}
+
+// groupPathCovered reports whether groupPath is synced by a rule for roots.
+func groupPathCovered(groupPath string, roots []string, includeSubgroups bool) bool {
+	for _, root := range roots {
+		if client.GroupPathWithin(groupPath, root, includeSubgroups) {
+			return true
+		}
+	}
+	return false
+}
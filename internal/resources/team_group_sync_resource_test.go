@@ -0,0 +1,212 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+func teamGroupSyncSchema() resource.SchemaResponse {
+	resp := resource.SchemaResponse{}
+	NewTeamGroupSyncResource().Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	return resp
+}
+
+// teamGroupSyncModel returns a configuration syncing groupPaths into team
+// payments.
+func teamGroupSyncModel(t *testing.T, includeSubgroups bool, mappings map[string]string, groupPaths ...string) TeamGroupSyncResourceModel {
+	t.Helper()
+	ctx := context.Background()
+	paths, _ := types.SetValueFrom(ctx, types.StringType, groupPaths)
+	roleMappings := types.MapNull(types.StringType)
+	if mappings != nil {
+		roleMappings, _ = types.MapValueFrom(ctx, types.StringType, mappings)
+	}
+	return TeamGroupSyncResourceModel{
+		ID: types.StringUnknown(), TeamID: types.StringValue("payments"), GroupPaths: paths,
+		IncludeSubgroups: types.BoolValue(includeSubgroups), Role: types.StringValue("member"), RoleMappings: roleMappings,
+		MemberCount: types.Int64Unknown(), LastSyncedAt: types.StringUnknown(),
+	}
+}
+
+func teamGroupSyncState(t *testing.T, m TeamGroupSyncResourceModel) tfsdk.State {
+	t.Helper()
+	state := tfsdk.State{Schema: teamGroupSyncSchema().Schema}
+	if diags := state.Set(context.Background(), &m); diags.HasError() {
+		t.Fatalf("encoding model: %v", diags)
+	}
+	return state
+}
+
+func TestTeamGroupSyncResource_Metadata(t *testing.T) {
+	r := NewTeamGroupSyncResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_team_group_sync" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_team_group_sync")
+	}
+}
+
+func TestTeamGroupSyncResource_Schema(t *testing.T) {
+	attrs := teamGroupSyncSchema().Schema.Attributes
+	for _, name := range []string{"id", "team_id", "group_paths", "include_subgroups", "role", "role_mappings", "member_count", "last_synced_at"} {
+		if _, ok := attrs[name]; !ok {
+			t.Errorf("schema missing attribute %q", name)
+		}
+	}
+	if !attrs["team_id"].IsRequired() || !attrs["group_paths"].IsRequired() {
+		t.Error("team_id and group_paths should be required")
+	}
+}
+
+func TestTeamGroupSyncResource_Configure_WrongType(t *testing.T) {
+	r := &TeamGroupSyncResource{}
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: "not a client",
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected error for wrong provider data type")
+	}
+}
+
+func TestTeamGroupSyncResource_Configure_WithValidClient(t *testing.T) {
+	r := &TeamGroupSyncResource{}
+	c := client.NewClient("https://test.example.com", "key", 30*time.Second)
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: c,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if r.client != c {
+		t.Error("client not set correctly")
+	}
+}
+
+func TestTeamGroupSyncResource_ValidateConfig_RoleMappingsMustBeSynced(t *testing.T) {
+	tests := []struct {
+		includeSubgroups bool
+		mapping          string
+		wantErr          bool
+	}{
+		{false, "/eng/payments", false},
+		{false, "/eng/payments/leads", true},
+		{true, "/eng/payments/leads", false},
+		{true, "/eng/billing", true},
+	}
+
+	for _, tt := range tests {
+		state := teamGroupSyncState(t, teamGroupSyncModel(t, tt.includeSubgroups, map[string]string{tt.mapping: "manager"}, "/eng/payments"))
+		resp := &resource.ValidateConfigResponse{}
+		(&TeamGroupSyncResource{}).ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+		}, resp)
+
+		if resp.Diagnostics.HasError() != tt.wantErr {
+			t.Errorf("include_subgroups=%v mapping %q: HasError = %v, want %v (%v)", tt.includeSubgroups, tt.mapping, resp.Diagnostics.HasError(), tt.wantErr, resp.Diagnostics)
+		}
+	}
+}
+
+func TestTeamGroupSyncResource_Lifecycle(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	api.AddUser(client.DirectoryUser{ID: "u1", Email: "ada@example.com"})
+	api.AddUser(client.DirectoryUser{ID: "u2", Email: "grace@example.com"})
+	api.AddGroup(client.Group{Name: "payments", Path: "/eng/payments", SubGroups: []client.Group{{Name: "leads", Path: "/eng/payments/leads"}}})
+	api.AddGroupMember("/eng/payments", "u1")
+	api.AddGroupMember("/eng/payments/leads", "u2")
+
+	c := api.Client()
+	ctx := context.Background()
+	team, err := c.CreateTeam(ctx, client.CreateTeamRequest{Name: "Payments", Slug: "payments"})
+	if err != nil {
+		t.Fatalf("CreateTeam() error = %v", err)
+	}
+
+	r := &TeamGroupSyncResource{client: c}
+	schemaResp := teamGroupSyncSchema()
+	plan := teamGroupSyncState(t, teamGroupSyncModel(t, false, nil, "/eng/payments"))
+
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() error = %v", createResp.Diagnostics)
+	}
+	var created TeamGroupSyncResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.ID.ValueString() != team.ID || created.TeamID.ValueString() != "payments" || created.MemberCount.ValueInt64() != 1 || !created.RoleMappings.IsNull() {
+		t.Errorf("created = %+v", created)
+	}
+
+	// Synced members are not reported as shoehorn_team members.
+	got, _ := c.GetTeam(ctx, team.ID)
+	var teamState TeamResourceModel
+	mapTeamToState(got, &teamState)
+	if !teamState.Members.IsNull() {
+		t.Errorf("team members = %s, want synced members omitted", teamState.Members.ValueString())
+	}
+
+	dupResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, dupResp)
+	if !dupResp.Diagnostics.HasError() || !strings.Contains(dupResp.Diagnostics.Errors()[0].Detail(), "terraform import") {
+		t.Errorf("duplicate Create() diagnostics = %v, want an import hint", dupResp.Diagnostics)
+	}
+
+	updated := teamGroupSyncState(t, teamGroupSyncModel(t, true, map[string]string{"/eng/payments/leads": "manager"}, "/eng/payments"))
+	updateResp := &resource.UpdateResponse{State: createResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(updated), State: createResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update() error = %v", updateResp.Diagnostics)
+	}
+	var afterUpdate TeamGroupSyncResourceModel
+	updateResp.State.Get(ctx, &afterUpdate)
+	if afterUpdate.MemberCount.ValueInt64() != 2 || len(afterUpdate.RoleMappings.Elements()) != 1 {
+		t.Errorf("updated = %+v", afterUpdate)
+	}
+
+	// Importing by slug keeps the slug in team_id, matching a config that uses it.
+	importResp := &resource.ImportStateResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "payments"}, importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("ImportState() error = %v", importResp.Diagnostics)
+	}
+	var imported TeamGroupSyncResourceModel
+	importResp.State.Get(ctx, &imported)
+	if imported.TeamID.ValueString() != "payments" || imported.ID.ValueString() != team.ID {
+		t.Errorf("imported team_id = %q, id = %q, want %q and %q", imported.TeamID.ValueString(), imported.ID.ValueString(), "payments", team.ID)
+	}
+
+	deleteResp := &resource.DeleteResponse{State: updateResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Delete() error = %v", deleteResp.Diagnostics)
+	}
+	if got, _ := c.GetTeam(ctx, team.ID); len(got.Members) != 0 {
+		t.Errorf("team members after delete = %+v, want none", got.Members)
+	}
+
+	readResp := &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, readResp)
+	if readResp.Diagnostics.HasError() || !readResp.State.Raw.IsNull() {
+		t.Errorf("Read() after delete should remove the resource: %v", readResp.Diagnostics)
+	}
+}
@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var _ list.ListResourceWithConfigure = &TeamListResource{}
+
+// TeamListResource lists teams for terraform query.
+type TeamListResource struct {
+	client *client.Client
+}
+
+// TeamListConfigModel describes the list block configuration.
+type TeamListConfigModel struct {
+	Source types.String `tfsdk:"source"`
+}
+
+// NewTeamListResource creates a new team list resource.
+func NewTeamListResource() list.ListResource {
+	return &TeamListResource{}
+}
+
+func (r *TeamListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (r *TeamListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists Shoehorn teams.",
+		Attributes: map[string]listschema.Attribute{
+			"source": listschema.StringAttribute{
+				Description: "Only list teams created from this source, such as `manual`.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *TeamListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureListClient(req, resp)
+}
+
+func (r *TeamListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config TeamListConfigModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	teams, err := r.client.ListTeams(ctx)
+	if err != nil {
+		listError(stream, "Error Listing Teams", fmt.Sprintf("Could not list teams: %s", err))
+		return
+	}
+	var matched []client.Team
+	for _, t := range teams {
+		if matchesFilter(config.Source, t.Source) {
+			matched = append(matched, t)
+		}
+	}
+
+	stream.Results = listResults(ctx, req, matched, func(t client.Team, result *list.ListResult) {
+		result.DisplayName = t.Name
+		result.Diagnostics.Append(setStringIdentity(ctx, result.Identity, "id", types.StringValue(t.ID))...)
+		if !req.IncludeResource {
+			return
+		}
+
+		// The list omits members, so read each team the way import does.
+		team, err := r.client.GetTeam(ctx, t.ID)
+		if err != nil {
+			result.Diagnostics.AddError("Error Reading Team", fmt.Sprintf("Could not read team %s: %s", t.ID, err))
+			return
+		}
+		var state TeamResourceModel
+		mapTeamToState(team, &state)
+		state.MemberIDs = types.MapNull(types.StringType)
+		result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -18,6 +19,7 @@ import (
 var (
 	_ resource.Resource                = &TeamResource{}
 	_ resource.ResourceWithImportState = &TeamResource{}
+	_ resource.ResourceWithIdentity    = &TeamResource{}
 )
 
 // TeamResource defines the resource implementation.
@@ -34,6 +36,7 @@ type TeamResourceModel struct {
 	Description types.String `tfsdk:"description"`
 	Metadata    types.String `tfsdk:"metadata"`
 	Members     types.String `tfsdk:"members"`
+	MemberIDs   types.Map    `tfsdk:"member_user_ids"`
 	IsActive    types.Bool   `tfsdk:"is_active"`
 	MemberCount types.Int64  `tfsdk:"member_count"`
 	CreatedAt   types.String `tfsdk:"created_at"`
@@ -85,9 +88,14 @@ func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 			},
 			"members": schema.StringAttribute{
-				Description: "JSON-encoded array of team members. Each member has user_id (required) and optional role (e.g., manager, admin, member).",
+				Description: "JSON-encoded array of team members. Each member has user_id or user_email and an optional role (e.g., manager, admin, member). Emails are resolved to directory user IDs. Members added by shoehorn_team_group_sync are not included.",
 				Optional:    true,
 			},
+			"member_user_ids": schema.MapAttribute{
+				Description: "Directory user IDs resolved for members given by user_email, keyed by email. If an email later belongs to a different user, the membership is reported as drift.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"is_active": schema.BoolAttribute{
 				Description: "Whether the team is active.",
 				Computed:    true,
@@ -108,6 +116,10 @@ func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (r *TeamResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("id", "The team ID.")
+}
+
 func (r *TeamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -168,15 +180,24 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	// Save partial state immediately so the team is tracked even if member addition fails
 	mapTeamToState(team, &plan)
+	plan.MemberIDs = types.MapNull(types.StringType)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", plan.ID)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Add members if specified (use saved plan value, not the overwritten one)
 	if !plannedMembers.IsNull() && !plannedMembers.IsUnknown() {
+		memberIDs, diags := resolveUserEmails(ctx, r.client, path.Root("members"), memberEmails(plannedMembers))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resolvedMembers := withMemberIDs(plannedMembers, memberIDs)
+
 		var members []client.AddMemberRequest
-		if err := json.Unmarshal([]byte(plannedMembers.ValueString()), &members); err != nil {
+		if err := json.Unmarshal([]byte(resolvedMembers.ValueString()), &members); err != nil {
 			resp.Diagnostics.AddError("Invalid Members JSON", fmt.Sprintf("Team was created but members could not be parsed: %s. Fix the members JSON configuration and run terraform apply again.", err))
 			return
 		}
@@ -192,9 +213,11 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 			}
 			// Update state with members; preserve planned members if API omits them
 			mapTeamToState(team, &plan)
-			if plan.Members.IsNull() && !plannedMembers.IsNull() {
+			if plan.Members.IsNull() || membersEquivalent(resolvedMembers.ValueString(), plan.Members.ValueString()) {
 				plan.Members = plannedMembers
 			}
+			plan.MemberIDs, diags = userIDsMapValue(ctx, memberIDs)
+			resp.Diagnostics.Append(diags...)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 		}
 	}
@@ -223,15 +246,27 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	mapTeamToState(team, &state)
+	state.MemberIDs = types.MapNull(types.StringType)
 
-	// Preserve original members order if semantically equivalent
+	// Preserve original members order if semantically equivalent. Members
+	// given by email are compared using the IDs their emails resolve to now,
+	// so an email that moved to another user shows up as drift.
 	if !prevMembers.IsNull() && !state.Members.IsNull() {
-		if membersEquivalent(prevMembers.ValueString(), state.Members.ValueString()) {
+		memberIDs, err := currentUserIDs(ctx, r.client, memberEmails(prevMembers))
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Team", fmt.Sprintf("Could not resolve member emails for team %s: %s", state.ID.ValueString(), err))
+			return
+		}
+		if membersEquivalent(withMemberIDs(prevMembers, memberIDs).ValueString(), state.Members.ValueString()) {
 			state.Members = prevMembers
+			var diags diag.Diagnostics
+			state.MemberIDs, diags = userIDsMapValue(ctx, memberIDs)
+			resp.Diagnostics.Append(diags...)
 		}
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", state.ID)...)
 }
 
 func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -264,23 +299,41 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		updateReq.Metadata = metadata
 	}
 
-	// Compute member diff
-	addMembers, removeMembers := computeMemberDiff(state.Members, plan.Members)
+	// Compute member diff on user IDs, resolving any member emails first
+	memberIDs, diags := resolveUserEmails(ctx, r.client, path.Root("members"), append(memberEmails(state.Members), memberEmails(plan.Members)...))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resolvedMembers := withMemberIDs(plan.Members, memberIDs)
+	addMembers, removeMembers := computeMemberDiff(withMemberIDs(state.Members, memberIDs), resolvedMembers)
 	updateReq.AddMembers = addMembers
 	updateReq.RemoveMembers = removeMembers
 
-	team, err := r.client.UpdateTeam(ctx, state.ID.ValueString(), updateReq)
+	updateCtx := withStateVersion(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, err := r.client.UpdateTeam(updateCtx, state.ID.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error Updating Team", fmt.Sprintf("Could not update team %s: %s", state.ID.ValueString(), err))
+		addUpdateError(&resp.Diagnostics, "Error Updating Team", fmt.Sprintf("Could not update team %s", state.ID.ValueString()), err)
 		return
 	}
 
 	plannedMembers := plan.Members
 	mapTeamToState(team, &plan)
-	if plan.Members.IsNull() && !plannedMembers.IsNull() {
+	if !plannedMembers.IsNull() && (plan.Members.IsNull() || membersEquivalent(resolvedMembers.ValueString(), plan.Members.ValueString())) {
 		plan.Members = plannedMembers
 	}
+	plannedIDs := make(map[string]string)
+	for _, email := range memberEmails(plannedMembers) {
+		plannedIDs[email] = memberIDs[email]
+	}
+	plan.MemberIDs, diags = userIDsMapValue(ctx, plannedIDs)
+	resp.Diagnostics.Append(diags...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", plan.ID)...)
 }
 
 func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -298,8 +351,26 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+// ImportState imports a team by ID. Members are imported by user_id; members
+// managed by shoehorn_team_group_sync are left out as in Read.
 func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importID, diags := importIDFromIdentity(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, err := r.client.GetTeam(ctx, importID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Team", fmt.Sprintf("Could not read team %s: %s", importID, err))
+		return
+	}
+
+	var state TeamResourceModel
+	mapTeamToState(team, &state)
+	state.MemberIDs = types.MapNull(types.StringType)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setStringIdentity(ctx, resp.Identity, "id", state.ID)...)
 }
 
 func mapTeamToState(team *client.Team, state *TeamResourceModel) {
@@ -323,19 +394,23 @@ func mapTeamToState(team *client.Team, state *TeamResourceModel) {
 		state.Metadata = types.StringNull()
 	}
 
-	// Map members from API response to terraform state
-	if len(team.Members) > 0 {
-		type tfMember struct {
-			UserID string `json:"user_id"`
-			Role   string `json:"role,omitempty"`
-		}
-		tfMembers := make([]tfMember, len(team.Members))
-		for i, m := range team.Members {
-			tfMembers[i] = tfMember{
-				UserID: m.UserID,
-				Role:   m.Role,
-			}
+	// Map members from API response to terraform state. Members added by a
+	// group sync rule are managed by shoehorn_team_group_sync.
+	type tfMember struct {
+		UserID string `json:"user_id"`
+		Role   string `json:"role,omitempty"`
+	}
+	var tfMembers []tfMember
+	for _, m := range team.Members {
+		if m.Source == client.TeamMemberSourceGroupSync {
+			continue
 		}
+		tfMembers = append(tfMembers, tfMember{
+			UserID: m.UserID,
+			Role:   m.Role,
+		})
+	}
+	if len(tfMembers) > 0 {
 		membersJSON, err := json.Marshal(tfMembers)
 		if err == nil {
 			state.Members = types.StringValue(string(membersJSON))
@@ -347,8 +422,51 @@ func mapTeamToState(team *client.Team, state *TeamResourceModel) {
 
 // tfMemberEntry represents a member entry in terraform config.
 type tfMemberEntry struct {
-	UserID string `json:"user_id"`
-	Role   string `json:"role,omitempty"`
+	UserID    string `json:"user_id"`
+	UserEmail string `json:"user_email,omitempty"`
+	Role      string `json:"role,omitempty"`
+}
+
+// memberEmails returns the user_email values of the members in a members
+// JSON attribute.
+func memberEmails(membersAttr types.String) []string {
+	if membersAttr.IsNull() || membersAttr.IsUnknown() {
+		return nil
+	}
+	var members []tfMemberEntry
+	if err := json.Unmarshal([]byte(membersAttr.ValueString()), &members); err != nil {
+		return nil
+	}
+	var emails []string
+	for _, m := range members {
+		if m.UserID == "" && m.UserEmail != "" {
+			emails = append(emails, m.UserEmail)
+		}
+	}
+	return emails
+}
+
+// withMemberIDs returns the members JSON with every member given by
+// user_email rewritten to the user_id resolved for that email. Members whose
+// email is missing from ids are left unchanged.
+func withMemberIDs(membersAttr types.String, ids map[string]string) types.String {
+	if len(ids) == 0 || membersAttr.IsNull() || membersAttr.IsUnknown() {
+		return membersAttr
+	}
+	var members []tfMemberEntry
+	if err := json.Unmarshal([]byte(membersAttr.ValueString()), &members); err != nil {
+		return membersAttr
+	}
+	for i, m := range members {
+		if id, ok := ids[m.UserEmail]; ok && m.UserID == "" {
+			members[i] = tfMemberEntry{UserID: id, Role: m.Role}
+		}
+	}
+	resolved, err := json.Marshal(members)
+	if err != nil {
+		return membersAttr
+	}
+	return types.StringValue(string(resolved))
 }
 
 // computeMemberDiff computes the add/remove member operations needed to go from
@@ -550,3 +550,23 @@ func TestMapTeamToState_ClearsNullFields(t *testing.T) {
 		t.Errorf("DisplayName should be null, got %q", state.DisplayName.ValueString())
 	}
 }
+
+func TestMemberEmails_WithMemberIDs(t *testing.T) {
+	members := types.StringValue(`[{"user_email":"ada@example.com","role":"manager"},{"user_id":"u-2"}]`)
+
+	emails := memberEmails(members)
+	if len(emails) != 1 || emails[0] != "ada@example.com" {
+		t.Fatalf("memberEmails() = %v, want [ada@example.com]", emails)
+	}
+
+	resolved := withMemberIDs(members, map[string]string{"ada@example.com": "u-1"})
+	if !membersEquivalent(resolved.ValueString(), `[{"user_id":"u-2"},{"user_id":"u-1","role":"manager"}]`) {
+		t.Errorf("withMemberIDs() = %s", resolved.ValueString())
+	}
+
+	// An email that no longer resolves leaves the member unmatched.
+	unresolved := withMemberIDs(members, map[string]string{})
+	if membersEquivalent(unresolved.ValueString(), `[{"user_id":"u-2"},{"user_id":"u-1","role":"manager"}]`) {
+		t.Error("unresolved email should not match the team's members")
+	}
+}
@@ -0,0 +1,217 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var (
+	_ resource.Resource                = &TenantAnnouncementResource{}
+	_ resource.ResourceWithImportState = &TenantAnnouncementResource{}
+)
+
+// TenantAnnouncementResource defines the resource implementation.
+type TenantAnnouncementResource struct {
+	client *client.Client
+}
+
+// TenantAnnouncementResourceModel describes the resource data model.
+type TenantAnnouncementResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	Message   types.String `tfsdk:"message"`
+	Type      types.String `tfsdk:"type"`
+	Pinned    types.Bool   `tfsdk:"pinned"`
+	LinkURL   types.String `tfsdk:"link_url"`
+	LinkText  types.String `tfsdk:"link_text"`
+	OnDestroy types.String `tfsdk:"on_destroy"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// NewTenantAnnouncementResource creates a new tenant announcement resource.
+func NewTenantAnnouncementResource() resource.Resource {
+	return &TenantAnnouncementResource{}
+}
+
+func (r *TenantAnnouncementResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_announcement"
+}
+
+func (r *TenantAnnouncementResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique identifier of the settings.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"on_destroy": onDestroyAttribute("the announcement bar"),
+	}
+	maps.Copy(attributes, tenantAnnouncementAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the announcement bar section of the Shoehorn tenant settings. Only this section is changed, so branding and forge settings can be managed from other workspaces with shoehorn_tenant_appearance and shoehorn_tenant_forge_settings. Do not combine with shoehorn_tenant_settings. For banners with start and end times, use shoehorn_announcement.",
+		Attributes:  attributes,
+	}
+}
+
+func (r *TenantAnnouncementResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *TenantAnnouncementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "creating tenant announcement")
+
+	var plan TenantAnnouncementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(snapshotSettingsSection(ctx, r.client, resp.Private, tenantAnnouncementSection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, nil, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TenantAnnouncementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "reading tenant announcement")
+
+	var state TenantAnnouncementResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Tenant Announcement", fmt.Sprintf("Could not read settings: %s", err))
+		return
+	}
+
+	state.OnDestroy = onDestroyOrDefault(state.OnDestroy)
+	mapTenantAnnouncementToState(settings, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *TenantAnnouncementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "updating tenant announcement")
+
+	var plan, state TenantAnnouncementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &state, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TenantAnnouncementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "deleting tenant announcement")
+
+	var state TenantAnnouncementResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(destroySettingsSection(ctx, r.client, onDestroyOrDefault(state.OnDestroy).ValueString(), req.Private,
+		tenantAnnouncementSection, client.PatchSettingsRequest{Announcement: &client.AnnouncementSettings{}})...)
+}
+
+func (r *TenantAnnouncementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	settings, diags := importSettingsSection(ctx, r.client, resp.Private, tenantAnnouncementSection)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := TenantAnnouncementResourceModel{OnDestroy: types.StringValue(onDestroyRetain)}
+	mapTenantAnnouncementToState(settings, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// apply sets the announcement section to the plan and maps the result back.
+// prior is the state being updated, or nil on create.
+func (r *TenantAnnouncementResource) apply(ctx context.Context, prior, plan *TenantAnnouncementResourceModel, diags *diag.Diagnostics) {
+	var priorSection *client.PatchSettingsRequest
+	if prior != nil {
+		section := tenantAnnouncementRequest(prior)
+		priorSection = &section
+	}
+
+	settings, err := applySettingsSection(ctx, r.client, tenantAnnouncementSection, priorSection, tenantAnnouncementRequest(plan))
+	if err != nil {
+		settingsSectionError(diags, "Error Updating Tenant Announcement", "the announcement bar", err)
+		return
+	}
+	mapTenantAnnouncementToState(settings, plan)
+}
+
+// tenantAnnouncementRequest returns the patch that sets the announcement bar to m.
+func tenantAnnouncementRequest(m *TenantAnnouncementResourceModel) client.PatchSettingsRequest {
+	return client.PatchSettingsRequest{Announcement: &client.AnnouncementSettings{
+		Enabled:  m.Enabled.ValueBool(),
+		Message:  m.Message.ValueString(),
+		Type:     m.Type.ValueString(),
+		Pinned:   m.Pinned.ValueBool(),
+		LinkURL:  m.LinkURL.ValueString(),
+		LinkText: m.LinkText.ValueString(),
+	}}
+}
+
+// tenantAnnouncementSection is the settingsSection for the announcement bar.
+// The announcement's own updated_at is set by the API, so it is left out.
+func tenantAnnouncementSection(settings *client.TenantSettings) client.PatchSettingsRequest {
+	announcement := settings.Announcement
+	announcement.UpdatedAt = ""
+	return client.PatchSettingsRequest{Announcement: &announcement}
+}
+
+func mapTenantAnnouncementToState(settings *client.TenantSettings, state *TenantAnnouncementResourceModel) {
+	announcement := settings.Announcement
+
+	state.ID = types.StringValue(settings.ID)
+	state.Enabled = types.BoolValue(announcement.Enabled)
+	state.Message = types.StringValue(announcement.Message)
+	state.Type = types.StringValue(announcement.Type)
+	state.Pinned = types.BoolValue(announcement.Pinned)
+	state.LinkURL = types.StringValue(announcement.LinkURL)
+	state.LinkText = types.StringValue(announcement.LinkText)
+	state.UpdatedAt = stringValueOrNull(announcement.UpdatedAt)
+}
@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+func TestTenantAnnouncementResource_Metadata(t *testing.T) {
+	r := NewTenantAnnouncementResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_tenant_announcement" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_tenant_announcement")
+	}
+}
+
+func TestTenantAnnouncementResource_Schema_HasAnnouncementAttributes(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewTenantAnnouncementResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	attrs := resp.Schema.Attributes
+	for _, name := range []string{"id", "enabled", "message", "type", "pinned", "link_url", "link_text", "on_destroy", "updated_at"} {
+		if _, ok := attrs[name]; !ok {
+			t.Errorf("schema missing attribute %q", name)
+		}
+	}
+}
+
+func TestTenantAnnouncementResource_Configure_WithValidClient(t *testing.T) {
+	r := &TenantAnnouncementResource{}
+	c := client.NewClient("https://test.example.com", "key", 30*time.Second)
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: c,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if r.client != c {
+		t.Error("client not set correctly")
+	}
+}
+
+func TestTenantAnnouncementResource_Configure_WrongType(t *testing.T) {
+	r := &TenantAnnouncementResource{}
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: "not a client",
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected error for wrong provider data type")
+	}
+}
+
+func TestTenantAnnouncementResource_ApplyOnlyChangesAnnouncement(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	api.SetSettings(client.TenantSettings{
+		ID:         "settings-1",
+		Appearance: client.AppearanceSettings{PlatformName: "Acme Portal", PrimaryColor: "#3b82f6"},
+	})
+	r := &TenantAnnouncementResource{client: api.Client()}
+
+	plan := &TenantAnnouncementResourceModel{
+		Enabled: types.BoolValue(true), Message: types.StringValue("Maintenance tonight"), Type: types.StringValue("warning"),
+		Pinned: types.BoolUnknown(), LinkURL: types.StringUnknown(), LinkText: types.StringUnknown(),
+	}
+	var diags diag.Diagnostics
+	r.apply(context.Background(), nil, plan, &diags)
+	if diags.HasError() {
+		t.Fatalf("apply() error = %v", diags)
+	}
+	if plan.Pinned.IsUnknown() || plan.LinkURL.IsUnknown() || plan.UpdatedAt.IsNull() {
+		t.Errorf("state = %+v, want computed values known", plan)
+	}
+
+	settings, err := api.Client().GetSettings(context.Background())
+	if err != nil {
+		t.Fatalf("GetSettings() error = %v", err)
+	}
+	if !settings.Announcement.Enabled || settings.Announcement.Type != "warning" {
+		t.Errorf("Announcement = %+v", settings.Announcement)
+	}
+	if settings.Appearance.PlatformName != "Acme Portal" || settings.Appearance.PrimaryColor != "#3b82f6" {
+		t.Errorf("Appearance = %+v, want it untouched", settings.Appearance)
+	}
+}
@@ -0,0 +1,254 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var (
+	_ resource.Resource                = &TenantAppearanceResource{}
+	_ resource.ResourceWithImportState = &TenantAppearanceResource{}
+)
+
+// TenantAppearanceResource defines the resource implementation.
+type TenantAppearanceResource struct {
+	client *client.Client
+}
+
+// TenantAppearanceResourceModel describes the resource data model.
+type TenantAppearanceResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	PrimaryColor        types.String `tfsdk:"primary_color"`
+	SecondaryColor      types.String `tfsdk:"secondary_color"`
+	AccentColor         types.String `tfsdk:"accent_color"`
+	LogoURL             types.String `tfsdk:"logo_url"`
+	FaviconURL          types.String `tfsdk:"favicon_url"`
+	DefaultTheme        types.String `tfsdk:"default_theme"`
+	PlatformName        types.String `tfsdk:"platform_name"`
+	PlatformDescription types.String `tfsdk:"platform_description"`
+	CompanyName         types.String `tfsdk:"company_name"`
+	HiddenPages         types.List   `tfsdk:"hidden_pages"`
+	OnDestroy           types.String `tfsdk:"on_destroy"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+}
+
+// NewTenantAppearanceResource creates a new tenant appearance resource.
+func NewTenantAppearanceResource() resource.Resource {
+	return &TenantAppearanceResource{}
+}
+
+func (r *TenantAppearanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_appearance"
+}
+
+func (r *TenantAppearanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique identifier of the settings.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"on_destroy": onDestroyAttribute("the appearance settings"),
+		"updated_at": schema.StringAttribute{
+			Description: "When any section of the tenant settings was last updated.",
+			Computed:    true,
+		},
+	}
+	maps.Copy(attributes, tenantAppearanceAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the branding and appearance section of the Shoehorn tenant settings. Only this section is changed, so announcement and forge settings can be managed from other workspaces with shoehorn_tenant_announcement and shoehorn_tenant_forge_settings. Do not combine with shoehorn_tenant_settings.",
+		Attributes:  attributes,
+	}
+}
+
+func (r *TenantAppearanceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *TenantAppearanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "creating tenant appearance")
+
+	var plan TenantAppearanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(snapshotSettingsSection(ctx, r.client, resp.Private, tenantAppearanceSection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, nil, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TenantAppearanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "reading tenant appearance")
+
+	var state TenantAppearanceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Tenant Appearance", fmt.Sprintf("Could not read settings: %s", err))
+		return
+	}
+
+	state.OnDestroy = onDestroyOrDefault(state.OnDestroy)
+	resp.Diagnostics.Append(mapAppearanceToState(ctx, settings, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *TenantAppearanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "updating tenant appearance")
+
+	var plan, state TenantAppearanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &state, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TenantAppearanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "deleting tenant appearance")
+
+	var state TenantAppearanceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(destroySettingsSection(ctx, r.client, onDestroyOrDefault(state.OnDestroy).ValueString(), req.Private,
+		tenantAppearanceSection, client.PatchSettingsRequest{Appearance: &client.AppearanceSettings{}})...)
+}
+
+func (r *TenantAppearanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	settings, diags := importSettingsSection(ctx, r.client, resp.Private, tenantAppearanceSection)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := TenantAppearanceResourceModel{OnDestroy: types.StringValue(onDestroyRetain)}
+	resp.Diagnostics.Append(mapAppearanceToState(ctx, settings, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// apply sets the appearance section to the plan and maps the result back.
+// prior is the state being updated, or nil on create.
+func (r *TenantAppearanceResource) apply(ctx context.Context, prior, plan *TenantAppearanceResourceModel, diags *diag.Diagnostics) {
+	desired := tenantAppearanceRequest(ctx, plan, diags)
+	var priorSection *client.PatchSettingsRequest
+	if prior != nil {
+		section := tenantAppearanceRequest(ctx, prior, diags)
+		priorSection = &section
+	}
+	if diags.HasError() {
+		return
+	}
+
+	settings, err := applySettingsSection(ctx, r.client, tenantAppearanceSection, priorSection, desired)
+	if err != nil {
+		settingsSectionError(diags, "Error Updating Tenant Appearance", "the appearance settings", err)
+		return
+	}
+	diags.Append(mapAppearanceToState(ctx, settings, plan)...)
+}
+
+// tenantAppearanceRequest returns the patch that sets the appearance section to m.
+func tenantAppearanceRequest(ctx context.Context, m *TenantAppearanceResourceModel, diags *diag.Diagnostics) client.PatchSettingsRequest {
+	appearance := client.AppearanceSettings{
+		PrimaryColor:        m.PrimaryColor.ValueString(),
+		SecondaryColor:      m.SecondaryColor.ValueString(),
+		AccentColor:         m.AccentColor.ValueString(),
+		LogoURL:             m.LogoURL.ValueString(),
+		FaviconURL:          m.FaviconURL.ValueString(),
+		DefaultTheme:        m.DefaultTheme.ValueString(),
+		PlatformName:        m.PlatformName.ValueString(),
+		PlatformDescription: m.PlatformDescription.ValueString(),
+		CompanyName:         m.CompanyName.ValueString(),
+	}
+	if !m.HiddenPages.IsNull() && !m.HiddenPages.IsUnknown() {
+		diags.Append(m.HiddenPages.ElementsAs(ctx, &appearance.HiddenPages, false)...)
+	}
+	return client.PatchSettingsRequest{Appearance: &appearance}
+}
+
+// tenantAppearanceSection is the settingsSection for the appearance settings.
+func tenantAppearanceSection(settings *client.TenantSettings) client.PatchSettingsRequest {
+	appearance := settings.Appearance
+	return client.PatchSettingsRequest{Appearance: &appearance}
+}
+
+func mapAppearanceToState(ctx context.Context, settings *client.TenantSettings, state *TenantAppearanceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	appearance := settings.Appearance
+
+	state.ID = types.StringValue(settings.ID)
+	state.PrimaryColor = preserveOrNull(appearance.PrimaryColor, state.PrimaryColor)
+	state.SecondaryColor = preserveOrNull(appearance.SecondaryColor, state.SecondaryColor)
+	state.AccentColor = preserveOrNull(appearance.AccentColor, state.AccentColor)
+	state.LogoURL = preserveOrNull(appearance.LogoURL, state.LogoURL)
+	state.FaviconURL = preserveOrNull(appearance.FaviconURL, state.FaviconURL)
+	state.DefaultTheme = preserveOrNull(appearance.DefaultTheme, state.DefaultTheme)
+	state.PlatformName = preserveOrNull(appearance.PlatformName, state.PlatformName)
+	state.PlatformDescription = preserveOrNull(appearance.PlatformDescription, state.PlatformDescription)
+	state.CompanyName = preserveOrNull(appearance.CompanyName, state.CompanyName)
+	state.UpdatedAt = stringValueOrNull(settings.UpdatedAt)
+
+	// Keep a configured empty list when the API omits hidden_pages.
+	if len(appearance.HiddenPages) > 0 {
+		var d diag.Diagnostics
+		state.HiddenPages, d = types.ListValueFrom(ctx, types.StringType, appearance.HiddenPages)
+		diags.Append(d...)
+	} else if state.HiddenPages.IsNull() || state.HiddenPages.IsUnknown() {
+		state.HiddenPages = types.ListNull(types.StringType)
+	}
+	return diags
+}
@@ -0,0 +1,205 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+func TestTenantAppearanceResource_Metadata(t *testing.T) {
+	r := NewTenantAppearanceResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_tenant_appearance" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_tenant_appearance")
+	}
+}
+
+func TestTenantAppearanceResource_Schema_HasAppearanceAttributes(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewTenantAppearanceResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	attrs := resp.Schema.Attributes
+	for _, name := range []string{"id", "primary_color", "platform_name", "hidden_pages", "on_destroy", "updated_at"} {
+		if _, ok := attrs[name]; !ok {
+			t.Errorf("schema missing attribute %q", name)
+		}
+	}
+	for _, name := range []string{"announcement", "forge"} {
+		if _, ok := attrs[name]; ok {
+			t.Errorf("schema has attribute %q owned by another section", name)
+		}
+	}
+}
+
+func TestTenantAppearanceResource_Configure_WithValidClient(t *testing.T) {
+	r := &TenantAppearanceResource{}
+	c := client.NewClient("https://test.example.com", "key", 30*time.Second)
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: c,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if r.client != c {
+		t.Error("client not set correctly")
+	}
+}
+
+func TestTenantAppearanceResource_Configure_WrongType(t *testing.T) {
+	r := &TenantAppearanceResource{}
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: "not a client",
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected error for wrong provider data type")
+	}
+}
+
+func TestTenantAppearanceResource_ApplyOnlyChangesAppearance(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	api.SetSettings(client.TenantSettings{
+		ID:           "settings-1",
+		Appearance:   client.AppearanceSettings{PlatformName: "Old Portal"},
+		Announcement: client.AnnouncementSettings{Enabled: true, Message: "Owned by comms"},
+		Forge:        client.ForgeSettings{DefaultOrg: "acme"},
+		UpdatedAt:    "2025-01-15T12:00:00Z",
+	})
+	r := &TenantAppearanceResource{client: api.Client()}
+	ctx := context.Background()
+
+	plan := &TenantAppearanceResourceModel{
+		PlatformName: types.StringValue("Acme Portal"),
+		PrimaryColor: types.StringValue("#3b82f6"),
+		HiddenPages:  types.ListNull(types.StringType),
+	}
+	var diags diag.Diagnostics
+	r.apply(ctx, nil, plan, &diags)
+	if diags.HasError() {
+		t.Fatalf("apply() error = %v", diags)
+	}
+	if plan.ID.ValueString() != "settings-1" || plan.PlatformName.ValueString() != "Acme Portal" || plan.UpdatedAt.IsNull() {
+		t.Errorf("state = %+v", plan)
+	}
+
+	settings, err := api.Client().GetSettings(ctx)
+	if err != nil {
+		t.Fatalf("GetSettings() error = %v", err)
+	}
+	if settings.Appearance.PrimaryColor != "#3b82f6" {
+		t.Errorf("PrimaryColor = %q, want %q", settings.Appearance.PrimaryColor, "#3b82f6")
+	}
+	if settings.Announcement.Message != "Owned by comms" || settings.Forge.DefaultOrg != "acme" {
+		t.Errorf("settings = %+v, want the announcement and forge sections untouched", settings)
+	}
+
+	// Applying the same values again sends nothing.
+	updatedAt := settings.UpdatedAt
+	api.SetSettings(client.TenantSettings{ID: "settings-1", Appearance: settings.Appearance, UpdatedAt: "2025-01-15T13:00:00Z"})
+	r.apply(ctx, nil, plan, &diags)
+	if diags.HasError() {
+		t.Fatalf("second apply() error = %v", diags)
+	}
+	if plan.UpdatedAt.ValueString() != "2025-01-15T13:00:00Z" {
+		t.Errorf("UpdatedAt = %q, want the unchanged settings' %q (was %q)", plan.UpdatedAt.ValueString(), "2025-01-15T13:00:00Z", updatedAt)
+	}
+}
+
+func TestTenantAppearanceResource_ApplyRejectsSectionChangedSinceRefresh(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	r := &TenantAppearanceResource{client: api.Client()}
+	ctx := context.Background()
+
+	prior := &TenantAppearanceResourceModel{PlatformName: types.StringValue("Old Portal"), HiddenPages: types.ListNull(types.StringType)}
+	plan := &TenantAppearanceResourceModel{PlatformName: types.StringValue("Acme Portal"), HiddenPages: types.ListNull(types.StringType)}
+
+	// Someone renamed the portal in the UI after the plan was made.
+	api.SetSettings(client.TenantSettings{
+		ID:         "settings-1",
+		Appearance: client.AppearanceSettings{PlatformName: "UI Portal"},
+		UpdatedAt:  "2025-01-15T12:00:00Z",
+	})
+	var diags diag.Diagnostics
+	r.apply(ctx, prior, plan, &diags)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "terraform plan again") {
+		t.Fatalf("apply() diagnostics = %v, want a re-plan error", diags)
+	}
+	settings, _ := api.Client().GetSettings(ctx)
+	if settings.Appearance.PlatformName != "UI Portal" {
+		t.Errorf("PlatformName = %q, want the UI change kept", settings.Appearance.PlatformName)
+	}
+
+	// A change to another section does not block the update.
+	api.SetSettings(client.TenantSettings{
+		ID:           "settings-1",
+		Appearance:   client.AppearanceSettings{PlatformName: "Old Portal"},
+		Announcement: client.AnnouncementSettings{Enabled: true, Message: "Changed by comms"},
+		UpdatedAt:    "2025-01-15T12:05:00Z",
+	})
+	diags = nil
+	r.apply(ctx, prior, plan, &diags)
+	if diags.HasError() {
+		t.Fatalf("apply() error = %v", diags)
+	}
+	settings, _ = api.Client().GetSettings(ctx)
+	if settings.Appearance.PlatformName != "Acme Portal" || settings.Announcement.Message != "Changed by comms" {
+		t.Errorf("settings = %+v", settings)
+	}
+}
+
+func TestTenantAppearanceResource_ApplyAcceptsConcurrentWriteOfPlannedValue(t *testing.T) {
+	// The first read sees the prior value; another writer then sets the planned
+	// value, so the patch fails its precondition and the retry finds nothing to do.
+	reads := []client.TenantSettings{
+		{ID: "settings-1", Appearance: client.AppearanceSettings{PlatformName: "Old Portal"}, UpdatedAt: "2025-01-15T12:00:00Z"},
+		{ID: "settings-1", Appearance: client.AppearanceSettings{PlatformName: "Acme Portal"}, UpdatedAt: "2025-01-15T12:00:05Z"},
+	}
+	var gets, patches int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(reads[min(gets, len(reads)-1)])
+			gets++
+		case http.MethodPatch:
+			patches++
+			w.WriteHeader(http.StatusPreconditionFailed)
+			json.NewEncoder(w).Encode(map[string]string{"code": "precondition_failed", "message": "settings changed"})
+		}
+	}))
+	defer server.Close()
+
+	r := &TenantAppearanceResource{client: client.NewClient(server.URL, "key", 30*time.Second)}
+	prior := &TenantAppearanceResourceModel{PlatformName: types.StringValue("Old Portal"), HiddenPages: types.ListNull(types.StringType)}
+	plan := &TenantAppearanceResourceModel{PlatformName: types.StringValue("Acme Portal"), HiddenPages: types.ListNull(types.StringType)}
+
+	var diags diag.Diagnostics
+	r.apply(context.Background(), prior, plan, &diags)
+	if diags.HasError() {
+		t.Fatalf("apply() error = %v", diags)
+	}
+	if gets != 2 || patches != 1 {
+		t.Errorf("gets = %d, patches = %d, want 2 and 1", gets, patches)
+	}
+	if plan.UpdatedAt.ValueString() != "2025-01-15T12:00:05Z" {
+		t.Errorf("UpdatedAt = %q, want the concurrent write's", plan.UpdatedAt.ValueString())
+	}
+}
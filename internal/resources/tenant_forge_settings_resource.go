@@ -0,0 +1,223 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var (
+	_ resource.Resource                = &TenantForgeSettingsResource{}
+	_ resource.ResourceWithImportState = &TenantForgeSettingsResource{}
+)
+
+// TenantForgeSettingsResource defines the resource implementation.
+type TenantForgeSettingsResource struct {
+	client *client.Client
+}
+
+// TenantForgeSettingsResourceModel describes the resource data model.
+type TenantForgeSettingsResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	AllowedOrgs types.List   `tfsdk:"allowed_orgs"`
+	DefaultOrg  types.String `tfsdk:"default_org"`
+	OnDestroy   types.String `tfsdk:"on_destroy"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// NewTenantForgeSettingsResource creates a new tenant forge settings resource.
+func NewTenantForgeSettingsResource() resource.Resource {
+	return &TenantForgeSettingsResource{}
+}
+
+func (r *TenantForgeSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_forge_settings"
+}
+
+func (r *TenantForgeSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique identifier of the settings.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"on_destroy": onDestroyAttribute("the forge settings"),
+		"updated_at": schema.StringAttribute{
+			Description: "When any section of the tenant settings was last updated.",
+			Computed:    true,
+		},
+	}
+	maps.Copy(attributes, tenantForgeAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the forge section of the Shoehorn tenant settings. Only this section is changed, so branding and the announcement bar can be managed from other workspaces with shoehorn_tenant_appearance and shoehorn_tenant_announcement. Do not combine with shoehorn_tenant_settings.",
+		Attributes:  attributes,
+	}
+}
+
+func (r *TenantForgeSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *TenantForgeSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "creating tenant forge settings")
+
+	var plan TenantForgeSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(snapshotSettingsSection(ctx, r.client, resp.Private, tenantForgeSection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, nil, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TenantForgeSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "reading tenant forge settings")
+
+	var state TenantForgeSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Tenant Forge Settings", fmt.Sprintf("Could not read settings: %s", err))
+		return
+	}
+
+	state.OnDestroy = onDestroyOrDefault(state.OnDestroy)
+	resp.Diagnostics.Append(mapTenantForgeToState(ctx, settings, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *TenantForgeSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "updating tenant forge settings")
+
+	var plan, state TenantForgeSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &state, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TenantForgeSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "deleting tenant forge settings")
+
+	var state TenantForgeSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(destroySettingsSection(ctx, r.client, onDestroyOrDefault(state.OnDestroy).ValueString(), req.Private,
+		tenantForgeSection, client.PatchSettingsRequest{Forge: &client.ForgeSettings{}})...)
+}
+
+func (r *TenantForgeSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	settings, diags := importSettingsSection(ctx, r.client, resp.Private, tenantForgeSection)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := TenantForgeSettingsResourceModel{OnDestroy: types.StringValue(onDestroyRetain)}
+	resp.Diagnostics.Append(mapTenantForgeToState(ctx, settings, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// apply sets the forge section to the plan and maps the result back. prior is
+// the state being updated, or nil on create.
+func (r *TenantForgeSettingsResource) apply(ctx context.Context, prior, plan *TenantForgeSettingsResourceModel, diags *diag.Diagnostics) {
+	desired := tenantForgeRequest(ctx, plan, diags)
+	var priorSection *client.PatchSettingsRequest
+	if prior != nil {
+		section := tenantForgeRequest(ctx, prior, diags)
+		priorSection = &section
+	}
+	if diags.HasError() {
+		return
+	}
+
+	settings, err := applySettingsSection(ctx, r.client, tenantForgeSection, priorSection, desired)
+	if err != nil {
+		settingsSectionError(diags, "Error Updating Tenant Forge Settings", "the forge settings", err)
+		return
+	}
+	diags.Append(mapTenantForgeToState(ctx, settings, plan)...)
+}
+
+// tenantForgeRequest returns the patch that sets the forge section to m.
+func tenantForgeRequest(ctx context.Context, m *TenantForgeSettingsResourceModel, diags *diag.Diagnostics) client.PatchSettingsRequest {
+	forge := client.ForgeSettings{DefaultOrg: m.DefaultOrg.ValueString()}
+	if !m.AllowedOrgs.IsNull() && !m.AllowedOrgs.IsUnknown() {
+		diags.Append(m.AllowedOrgs.ElementsAs(ctx, &forge.AllowedOrgs, false)...)
+	}
+	return client.PatchSettingsRequest{Forge: &forge}
+}
+
+// tenantForgeSection is the settingsSection for the forge settings.
+func tenantForgeSection(settings *client.TenantSettings) client.PatchSettingsRequest {
+	forge := settings.Forge
+	return client.PatchSettingsRequest{Forge: &forge}
+}
+
+func mapTenantForgeToState(ctx context.Context, settings *client.TenantSettings, state *TenantForgeSettingsResourceModel) diag.Diagnostics {
+	state.ID = types.StringValue(settings.ID)
+	state.DefaultOrg = types.StringValue(settings.Forge.DefaultOrg)
+	state.UpdatedAt = stringValueOrNull(settings.UpdatedAt)
+
+	orgs := settings.Forge.AllowedOrgs
+	if orgs == nil {
+		orgs = []string{}
+	}
+	var diags diag.Diagnostics
+	state.AllowedOrgs, diags = types.ListValueFrom(ctx, types.StringType, orgs)
+	return diags
+}
@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+func TestTenantForgeSettingsResource_Metadata(t *testing.T) {
+	r := NewTenantForgeSettingsResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_tenant_forge_settings" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_tenant_forge_settings")
+	}
+}
+
+func TestTenantForgeSettingsResource_Schema_HasForgeAttributes(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewTenantForgeSettingsResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	attrs := resp.Schema.Attributes
+	for _, name := range []string{"id", "allowed_orgs", "default_org", "on_destroy", "updated_at"} {
+		if _, ok := attrs[name]; !ok {
+			t.Errorf("schema missing attribute %q", name)
+		}
+	}
+}
+
+func TestTenantForgeSettingsResource_Configure_WithValidClient(t *testing.T) {
+	r := &TenantForgeSettingsResource{}
+	c := client.NewClient("https://test.example.com", "key", 30*time.Second)
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: c,
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if r.client != c {
+		t.Error("client not set correctly")
+	}
+}
+
+func TestTenantForgeSettingsResource_Configure_WrongType(t *testing.T) {
+	r := &TenantForgeSettingsResource{}
+
+	resp := &resource.ConfigureResponse{}
+	r.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: "not a client",
+	}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected error for wrong provider data type")
+	}
+}
+
+func TestTenantForgeSettingsResource_ApplyOnlyChangesForge(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	api.SetSettings(client.TenantSettings{ID: "settings-1", Appearance: client.AppearanceSettings{PlatformName: "Acme Portal"}})
+	r := &TenantForgeSettingsResource{client: api.Client()}
+	ctx := context.Background()
+
+	orgs, _ := types.ListValueFrom(ctx, types.StringType, []string{"acme", "acme-labs"})
+	plan := &TenantForgeSettingsResourceModel{AllowedOrgs: orgs, DefaultOrg: types.StringValue("acme")}
+	var diags diag.Diagnostics
+	r.apply(ctx, nil, plan, &diags)
+	if diags.HasError() {
+		t.Fatalf("apply() error = %v", diags)
+	}
+
+	settings, err := api.Client().GetSettings(ctx)
+	if err != nil {
+		t.Fatalf("GetSettings() error = %v", err)
+	}
+	if len(settings.Forge.AllowedOrgs) != 2 || settings.Forge.DefaultOrg != "acme" {
+		t.Errorf("Forge = %+v", settings.Forge)
+	}
+	if settings.Appearance.PlatformName != "Acme Portal" {
+		t.Errorf("Appearance = %+v, want it untouched", settings.Appearance)
+	}
+}
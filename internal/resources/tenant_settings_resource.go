@@ -3,12 +3,12 @@ package resources
 import (
 	"context"
 	"fmt"
+	"maps"
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -45,6 +45,7 @@ type TenantSettingsResourceModel struct {
 	HiddenPages         types.List   `tfsdk:"hidden_pages"`
 	Announcement        types.Object `tfsdk:"announcement"`
 	Forge               types.Object `tfsdk:"forge"`
+	OnDestroy           types.String `tfsdk:"on_destroy"`
 	CreatedAt           types.String `tfsdk:"created_at"`
 	UpdatedAt           types.String `tfsdk:"updated_at"`
 }
@@ -76,165 +77,188 @@ func (r *TenantSettingsResource) Metadata(_ context.Context, req resource.Metada
 }
 
 func (r *TenantSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "Manages Shoehorn tenant appearance settings. This is a singleton resource per tenant - create performs an upsert and delete only removes from Terraform state.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "The unique identifier of the settings.",
-				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-			"primary_color": schema.StringAttribute{
-				Description: "Primary brand color (hex, e.g., #3b82f6). Used for active states and primary buttons.",
-				Optional:    true,
-				Validators: []validator.String{
-					stringvalidator.RegexMatches(
-						regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`),
-						"must be a valid hex color code (e.g., #3b82f6)",
-					),
-				},
-			},
-			"secondary_color": schema.StringAttribute{
-				Description: "Secondary brand color (hex, e.g., #64748b). Used for hover states and secondary UI elements.",
-				Optional:    true,
-				Validators: []validator.String{
-					stringvalidator.RegexMatches(
-						regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`),
-						"must be a valid hex color code (e.g., #64748b)",
-					),
-				},
-			},
-			"accent_color": schema.StringAttribute{
-				Description: "Accent color (hex, e.g., #8b5cf6). Used for highlights, badges, and emphasis.",
-				Optional:    true,
-				Validators: []validator.String{
-					stringvalidator.RegexMatches(
-						regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`),
-						"must be a valid hex color code (e.g., #8b5cf6)",
-					),
-				},
-			},
-			"logo_url": schema.StringAttribute{
-				Description: "URL to the company logo (must be http:// or https://).",
-				Optional:    true,
-				Validators: []validator.String{
-					stringvalidator.RegexMatches(
-						regexp.MustCompile(`^https?://`),
-						"must be an HTTP or HTTPS URL",
-					),
-				},
-			},
-			"favicon_url": schema.StringAttribute{
-				Description: "URL to the favicon (must be http:// or https://).",
-				Optional:    true,
-				Validators: []validator.String{
-					stringvalidator.RegexMatches(
-						regexp.MustCompile(`^https?://`),
-						"must be an HTTP or HTTPS URL",
-					),
-				},
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique identifier of the settings.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"default_theme": schema.StringAttribute{
-				Description: "Default theme for users. Valid values: light, dark, system.",
-				Optional:    true,
-				Validators: []validator.String{
-					stringvalidator.OneOf("light", "dark", "system"),
-				},
-			},
-			"platform_name": schema.StringAttribute{
-				Description: "Name of the platform displayed in the UI.",
-				Optional:    true,
+		},
+		"announcement": schema.SingleNestedAttribute{
+			Description: "Announcement bar configuration.",
+			Optional:    true,
+			Attributes:  tenantAnnouncementAttributes(),
+		},
+		"forge": schema.SingleNestedAttribute{
+			Description: "Forge configuration for scaffolding and templates.",
+			Optional:    true,
+			Attributes:  tenantForgeAttributes(),
+		},
+		"on_destroy": onDestroyAttribute("the tenant settings"),
+		"created_at": schema.StringAttribute{
+			Description: "The creation timestamp.",
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The last update timestamp.",
+			Computed:    true,
+		},
+	}
+	maps.Copy(attributes, tenantAppearanceAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Manages Shoehorn tenant appearance settings. This is a singleton resource per tenant - create performs an upsert after capturing the existing settings, and on_destroy controls whether delete leaves, restores or resets them.",
+		Attributes:  attributes,
+	}
+}
+
+// tenantAppearanceAttributes returns the appearance attributes shared by
+// shoehorn_tenant_settings and shoehorn_tenant_appearance.
+func tenantAppearanceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"primary_color": schema.StringAttribute{
+			Description: "Primary brand color (hex, e.g., #3b82f6). Used for active states and primary buttons.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`),
+					"must be a valid hex color code (e.g., #3b82f6)",
+				),
 			},
-			"platform_description": schema.StringAttribute{
-				Description: "Description of the platform.",
-				Optional:    true,
+		},
+		"secondary_color": schema.StringAttribute{
+			Description: "Secondary brand color (hex, e.g., #64748b). Used for hover states and secondary UI elements.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`),
+					"must be a valid hex color code (e.g., #64748b)",
+				),
 			},
-			"company_name": schema.StringAttribute{
-				Description: "Company name.",
-				Optional:    true,
+		},
+		"accent_color": schema.StringAttribute{
+			Description: "Accent color (hex, e.g., #8b5cf6). Used for highlights, badges, and emphasis.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`),
+					"must be a valid hex color code (e.g., #8b5cf6)",
+				),
 			},
-			"hidden_pages": schema.ListAttribute{
-				ElementType: types.StringType,
-				Description: "List of page slugs to hide from non-admin users (e.g., forge, insights).",
-				Optional:    true,
+		},
+		"logo_url": schema.StringAttribute{
+			Description: "URL to the company logo (must be http:// or https://).",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(`^https?://`),
+					"must be an HTTP or HTTPS URL",
+				),
 			},
-			"announcement": schema.SingleNestedAttribute{
-				Description: "Announcement bar configuration.",
-				Optional:    true,
-				Attributes: map[string]schema.Attribute{
-					"enabled": schema.BoolAttribute{
-						Description: "Whether announcement bar is enabled.",
-						Optional:    true,
-						Computed:    true,
-					},
-					"message": schema.StringAttribute{
-						Description: "Announcement message text.",
-						Optional:    true,
-						Computed:    true,
-					},
-					"type": schema.StringAttribute{
-						Description: "Announcement type. Valid values: info, warning, error, success.",
-						Optional:    true,
-						Computed:    true,
-						Validators: []validator.String{
-							stringvalidator.OneOf("info", "warning", "error", "success"),
-						},
-					},
-					"pinned": schema.BoolAttribute{
-						Description: "If true, users cannot dismiss the announcement.",
-						Optional:    true,
-						Computed:    true,
-					},
-					"link_url": schema.StringAttribute{
-						Description: "Optional call-to-action link URL (must be http:// or https://).",
-						Optional:    true,
-						Computed:    true,
-						Validators: []validator.String{
-							stringvalidator.RegexMatches(
-								regexp.MustCompile(`^https?://`),
-								"must be an HTTP or HTTPS URL",
-							),
-						},
-					},
-					"link_text": schema.StringAttribute{
-						Description: "Optional call-to-action link text.",
-						Optional:    true,
-						Computed:    true,
-					},
-					"updated_at": schema.StringAttribute{
-						Description: "Announcement last update timestamp (used for dismiss tracking).",
-						Computed:    true,
-					},
-				},
+		},
+		"favicon_url": schema.StringAttribute{
+			Description: "URL to the favicon (must be http:// or https://).",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(`^https?://`),
+					"must be an HTTP or HTTPS URL",
+				),
 			},
-			"forge": schema.SingleNestedAttribute{
-				Description: "Forge configuration for scaffolding and templates.",
-				Optional:    true,
-				Attributes: map[string]schema.Attribute{
-					"allowed_orgs": schema.ListAttribute{
-						ElementType: types.StringType,
-						Description: "List of GitHub organizations allowed for Forge templates.",
-						Optional:    true,
-						Computed:    true,
-					},
-					"default_org": schema.StringAttribute{
-						Description: "Default GitHub organization for Forge templates.",
-						Optional:    true,
-						Computed:    true,
-					},
-				},
+		},
+		"default_theme": schema.StringAttribute{
+			Description: "Default theme for users. Valid values: light, dark, system.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("light", "dark", "system"),
 			},
-			"created_at": schema.StringAttribute{
-				Description: "The creation timestamp.",
-				Computed:    true,
+		},
+		"platform_name": schema.StringAttribute{
+			Description: "Name of the platform displayed in the UI.",
+			Optional:    true,
+		},
+		"platform_description": schema.StringAttribute{
+			Description: "Description of the platform.",
+			Optional:    true,
+		},
+		"company_name": schema.StringAttribute{
+			Description: "Company name.",
+			Optional:    true,
+		},
+		"hidden_pages": schema.ListAttribute{
+			ElementType: types.StringType,
+			Description: "List of page slugs to hide from non-admin users (e.g., forge, insights).",
+			Optional:    true,
+		},
+	}
+}
+
+// tenantAnnouncementAttributes returns the announcement attributes shared by
+// shoehorn_tenant_settings and shoehorn_tenant_announcement.
+func tenantAnnouncementAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"enabled": schema.BoolAttribute{
+			Description: "Whether announcement bar is enabled.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"message": schema.StringAttribute{
+			Description: "Announcement message text.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "Announcement type. Valid values: info, warning, error, success.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("info", "warning", "error", "success"),
 			},
-			"updated_at": schema.StringAttribute{
-				Description: "The last update timestamp.",
-				Computed:    true,
+		},
+		"pinned": schema.BoolAttribute{
+			Description: "If true, users cannot dismiss the announcement.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"link_url": schema.StringAttribute{
+			Description: "Optional call-to-action link URL (must be http:// or https://).",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(`^https?://`),
+					"must be an HTTP or HTTPS URL",
+				),
 			},
 		},
+		"link_text": schema.StringAttribute{
+			Description: "Optional call-to-action link text.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "Announcement last update timestamp (used for dismiss tracking).",
+			Computed:    true,
+		},
+	}
+}
+
+// tenantForgeAttributes returns the forge attributes shared by
+// shoehorn_tenant_settings and shoehorn_tenant_forge_settings.
+func tenantForgeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"allowed_orgs": schema.ListAttribute{
+			ElementType: types.StringType,
+			Description: "List of GitHub organizations allowed for Forge templates.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"default_org": schema.StringAttribute{
+			Description: "Default GitHub organization for Forge templates.",
+			Optional:    true,
+			Computed:    true,
+		},
 	}
 }
 
@@ -271,6 +295,11 @@ func (r *TenantSettingsResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	resp.Diagnostics.Append(r.snapshotSettings(ctx, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	settings, err := r.client.UpdateSettings(ctx, client.UpdateSettingsRequest{
 		Appearance:   appearance,
 		Announcement: announcement,
@@ -303,6 +332,7 @@ func (r *TenantSettingsResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	state.OnDestroy = onDestroyOrDefault(state.OnDestroy)
 	mapSettingsToState(ctx, settings, &state, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -326,13 +356,18 @@ func (r *TenantSettingsResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	settings, err := r.client.UpdateSettings(ctx, client.UpdateSettingsRequest{
+	updateCtx := withStateVersion(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSettings(updateCtx, client.UpdateSettingsRequest{
 		Appearance:   appearance,
 		Announcement: announcement,
 		Forge:        forge,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error Updating Tenant Settings", fmt.Sprintf("Could not update settings: %s", err))
+		addUpdateError(&resp.Diagnostics, "Error Updating Tenant Settings", "Could not update settings", err)
 		return
 	}
 
@@ -343,14 +378,76 @@ func (r *TenantSettingsResource) Update(ctx context.Context, req resource.Update
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *TenantSettingsResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
-	tflog.Debug(ctx, "deleting tenant settings (state only)")
-	// Tenant settings are a singleton and can't be truly deleted.
-	// Removing from state only - the settings will remain in the API.
+func (r *TenantSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "deleting tenant settings")
+
+	var state TenantSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tenant settings are a singleton and can't be truly deleted. Depending
+	// on on_destroy they are left in place, restored or reset.
+	resp.Diagnostics.Append(destroyTenantSettings(ctx, r.client, onDestroyOrDefault(state.OnDestroy).ValueString(), req.Private)...)
 }
 
 func (r *TenantSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	settings, err := r.client.GetSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Tenant Settings", fmt.Sprintf("Could not read settings: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(saveSnapshot(ctx, resp.Private, settings.UpdateRequest())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := TenantSettingsResourceModel{OnDestroy: types.StringValue(onDestroyRetain)}
+	mapSettingsToState(ctx, settings, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// snapshotSettings captures the current settings in private state so that
+// on_destroy = "restore" can put them back.
+func (r *TenantSettingsResource) snapshotSettings(ctx context.Context, p privateState) diag.Diagnostics {
+	current, err := r.client.GetSettings(ctx)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error Reading Tenant Settings", fmt.Sprintf("Could not read the current settings to snapshot: %s", err))
+		return diags
+	}
+	return saveSnapshot(ctx, p, current.UpdateRequest())
+}
+
+// destroyTenantSettings applies the on_destroy mode when the resource is
+// destroyed.
+func destroyTenantSettings(ctx context.Context, c *client.Client, mode string, p privateState) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch mode {
+	case onDestroyRestore:
+		var snapshot client.UpdateSettingsRequest
+		found, d := loadSnapshot(ctx, p, &snapshot)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		if !found {
+			diags.AddWarning("No Settings Snapshot", "No snapshot of the settings was captured when this resource was created or imported, so the settings were left in place.")
+			return diags
+		}
+		if _, err := c.UpdateSettings(ctx, snapshot); err != nil {
+			diags.AddError("Error Restoring Tenant Settings", fmt.Sprintf("Could not restore settings: %s", err))
+		}
+	case onDestroyReset:
+		if _, err := c.ResetSettings(ctx); err != nil {
+			diags.AddError("Error Resetting Tenant Settings", fmt.Sprintf("Could not reset settings: %s", err))
+		}
+	}
+	return diags
 }
 
 func buildAppearanceFromModel(ctx context.Context, model *TenantSettingsResourceModel, diags *diag.Diagnostics) client.AppearanceSettings {
@@ -0,0 +1,114 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+// The shoehorn_tenant_appearance, shoehorn_tenant_announcement and
+// shoehorn_tenant_forge_settings resources each own one section of the tenant
+// settings. They only ever patch their own section, so separate workspaces can
+// manage the sections without overwriting each other.
+
+// settingsSection extracts the patch that sets a resource's section to its
+// value in settings.
+type settingsSection func(settings *client.TenantSettings) client.PatchSettingsRequest
+
+// applySettingsSection sets a section to the value in desired by
+// read-modify-write. Nothing is sent if the section already has that value,
+// so a no-op apply does not bump the settings' updated_at. When prior is set,
+// the update is only made while the section still has that value; if it was
+// changed outside Terraform since the last refresh, errChangedSinceRefresh is
+// returned. Changes to other sections are retried over.
+func applySettingsSection(ctx context.Context, c *client.Client, section settingsSection, prior *client.PatchSettingsRequest, desired client.PatchSettingsRequest) (*client.TenantSettings, error) {
+	return c.ModifySettings(ctx, func(current *client.TenantSettings) (*client.PatchSettingsRequest, error) {
+		if sameJSON(section(current), desired) {
+			return nil, nil
+		}
+		if prior != nil && !sameJSON(section(current), *prior) {
+			return nil, errChangedSinceRefresh
+		}
+		return &desired, nil
+	})
+}
+
+// snapshotSettingsSection captures the current value of a section in private
+// state so that on_destroy = "restore" can put it back.
+func snapshotSettingsSection(ctx context.Context, c *client.Client, p privateState, section settingsSection) diag.Diagnostics {
+	current, err := c.GetSettings(ctx)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error Reading Tenant Settings", fmt.Sprintf("Could not read the current settings to snapshot: %s", err))
+		return diags
+	}
+	return saveSnapshot(ctx, p, section(current))
+}
+
+// importSettingsSection reads the settings for an import and snapshots the
+// section, returning the settings the imported state is built from.
+func importSettingsSection(ctx context.Context, c *client.Client, p privateState, section settingsSection) (*client.TenantSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	settings, err := c.GetSettings(ctx)
+	if err != nil {
+		diags.AddError("Error Importing Tenant Settings", fmt.Sprintf("Could not read settings: %s", err))
+		return nil, diags
+	}
+	diags.Append(saveSnapshot(ctx, p, section(settings))...)
+	return settings, diags
+}
+
+// destroySettingsSection applies the on_destroy mode to a section. reset is
+// the patch that returns the section to the Shoehorn defaults.
+func destroySettingsSection(ctx context.Context, c *client.Client, mode string, p privateState, section settingsSection, reset client.PatchSettingsRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch mode {
+	case onDestroyRestore:
+		var snapshot client.PatchSettingsRequest
+		found, d := loadSnapshot(ctx, p, &snapshot)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		if !found {
+			diags.AddWarning("No Settings Snapshot", "No snapshot of the settings was captured when this resource was created or imported, so the settings were left in place.")
+			return diags
+		}
+		if _, err := applySettingsSection(ctx, c, section, nil, snapshot); err != nil {
+			diags.AddError("Error Restoring Tenant Settings", fmt.Sprintf("Could not restore settings: %s", err))
+		}
+	case onDestroyReset:
+		if _, err := applySettingsSection(ctx, c, section, nil, reset); err != nil {
+			diags.AddError("Error Resetting Tenant Settings", fmt.Sprintf("Could not reset settings: %s", err))
+		}
+	}
+	return diags
+}
+
+// settingsSectionError adds the error for a failed section update. A section
+// changed since the last refresh asks for a re-plan; a precondition failure
+// after the client's retries means another writer keeps changing the settings.
+func settingsSectionError(diags *diag.Diagnostics, summary, what string, err error) {
+	if errors.Is(err, errChangedSinceRefresh) {
+		addUpdateError(diags, summary, "Could not update "+what, err)
+		return
+	}
+	if client.IsPreconditionFailed(err) {
+		diags.AddError(summary, fmt.Sprintf("Could not update %s: the tenant settings kept changing while they were being updated. Retry the apply. (%s)", what, err))
+		return
+	}
+	diags.AddError(summary, fmt.Sprintf("Could not update %s: %s", what, err))
+}
+
+// sameJSON reports whether a and b encode to the same JSON, which treats
+// empty and omitted fields alike.
+func sameJSON(a, b any) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(ja, jb)
+}
@@ -0,0 +1,112 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+// planUserIDFromEmail resolves a configured user_email during plan and returns
+// the user ID to plan for user_id. replace is true when the email now belongs
+// to a different user than the one in state. Unknown or null emails leave
+// plannedID unchanged.
+func planUserIDFromEmail(ctx context.Context, c *client.Client, p path.Path, email, plannedID, priorID types.String) (id types.String, replace bool, diags diag.Diagnostics) {
+	if c == nil || email.IsNull() || email.IsUnknown() {
+		return plannedID, false, diags
+	}
+
+	resolved, err := c.ResolveUserEmail(ctx, email.ValueString())
+	if err != nil {
+		diags.AddAttributeError(p, "Unknown User Email", fmt.Sprintf("Could not resolve %q to a directory user: %s", email.ValueString(), err))
+		return plannedID, false, diags
+	}
+
+	replace = !priorID.IsNull() && !priorID.IsUnknown() && priorID.ValueString() != resolved
+	return types.StringValue(resolved), replace, diags
+}
+
+// resolveUserID returns the user ID to use when applying: the planned user_id
+// if known, otherwise the ID resolved from email.
+func resolveUserID(ctx context.Context, c *client.Client, userID, email types.String) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !userID.IsNull() && !userID.IsUnknown() && userID.ValueString() != "" {
+		return userID.ValueString(), diags
+	}
+
+	id, err := c.ResolveUserEmail(ctx, email.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("user_email"), "Unknown User Email", fmt.Sprintf("Could not resolve %q to a directory user: %s", email.ValueString(), err))
+		return "", diags
+	}
+	return id, diags
+}
+
+// refreshUserEmail returns the user_email to store after reading the user's
+// current email. The configured spelling is kept while it still matches, so
+// only a real change of email is reported as drift.
+func refreshUserEmail(current types.String, actual string) types.String {
+	if current.IsNull() || current.IsUnknown() || actual == "" || strings.EqualFold(current.ValueString(), actual) {
+		return current
+	}
+	return types.StringValue(actual)
+}
+
+// resolveUserEmails resolves each email to a directory user ID, loading the
+// directory at most once. Emails that do not resolve are reported at p.
+func resolveUserEmails(ctx context.Context, c *client.Client, p path.Path, emails []string) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	resolved := make(map[string]string, len(emails))
+	if len(emails) == 0 {
+		return resolved, diags
+	}
+
+	directory, err := c.LoadUserDirectory(ctx)
+	if err != nil {
+		diags.AddError("Error Resolving User Emails", fmt.Sprintf("Could not list directory users: %s", err))
+		return nil, diags
+	}
+
+	for _, email := range emails {
+		id, err := directory.UserID(email)
+		if err != nil {
+			diags.AddAttributeError(p, "Unknown User Email", fmt.Sprintf("Could not resolve %q to a directory user: %s", email, err))
+			continue
+		}
+		resolved[email] = id
+	}
+	return resolved, diags
+}
+
+// currentUserIDs resolves emails against the directory as it is now. Emails
+// that no longer resolve are omitted rather than reported, so that whatever
+// references them shows up as drift.
+func currentUserIDs(ctx context.Context, c *client.Client, emails []string) (map[string]string, error) {
+	ids := make(map[string]string, len(emails))
+	if len(emails) == 0 {
+		return ids, nil
+	}
+	directory, err := c.LoadUserDirectory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, email := range emails {
+		if id, err := directory.UserID(email); err == nil {
+			ids[email] = id
+		}
+	}
+	return ids, nil
+}
+
+// userIDsMapValue converts resolved email to user ID mappings to a map
+// attribute value, which is null when nothing was resolved.
+func userIDsMapValue(ctx context.Context, ids map[string]string) (types.Map, diag.Diagnostics) {
+	if len(ids) == 0 {
+		return types.MapNull(types.StringType), nil
+	}
+	return types.MapValueFrom(ctx, types.StringType, ids)
+}
@@ -0,0 +1,120 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+func TestPlanUserIDFromEmail(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	api.AddUser(client.DirectoryUser{ID: "u-1", Email: "ada@example.com"})
+	c := api.Client()
+	ctx := context.Background()
+	p := path.Root("user_email")
+
+	id, replace, diags := planUserIDFromEmail(ctx, c, p, types.StringValue("ADA@example.com"), types.StringUnknown(), types.StringNull())
+	if diags.HasError() || id.ValueString() != "u-1" || replace {
+		t.Errorf("create: id = %v, replace = %v, diags = %v", id, replace, diags)
+	}
+
+	id, replace, diags = planUserIDFromEmail(ctx, c, p, types.StringValue("ada@example.com"), types.StringValue("u-1"), types.StringValue("u-1"))
+	if diags.HasError() || id.ValueString() != "u-1" || replace {
+		t.Errorf("unchanged: id = %v, replace = %v, diags = %v", id, replace, diags)
+	}
+
+	// The email now belongs to a different directory user. The client caches
+	// the directory, so the next run sees the change with a new client.
+	api.AddUser(client.DirectoryUser{ID: "u-1", Email: "ada.old@example.com"})
+	api.AddUser(client.DirectoryUser{ID: "u-2", Email: "ada@example.com"})
+	c = api.Client()
+	id, replace, diags = planUserIDFromEmail(ctx, c, p, types.StringValue("ada@example.com"), types.StringValue("u-1"), types.StringValue("u-1"))
+	if diags.HasError() || id.ValueString() != "u-2" || !replace {
+		t.Errorf("remapped: id = %v, replace = %v, diags = %v", id, replace, diags)
+	}
+
+	_, _, diags = planUserIDFromEmail(ctx, c, p, types.StringValue("grace@example.com"), types.StringUnknown(), types.StringNull())
+	if !diags.HasError() {
+		t.Error("unknown email should be an error")
+	}
+
+	id, _, diags = planUserIDFromEmail(ctx, c, p, types.StringNull(), types.StringValue("u-9"), types.StringNull())
+	if diags.HasError() || id.ValueString() != "u-9" {
+		t.Errorf("no email: id = %v, diags = %v", id, diags)
+	}
+}
+
+func TestRefreshUserEmail(t *testing.T) {
+	tests := []struct {
+		current types.String
+		actual  string
+		want    types.String
+	}{
+		{types.StringNull(), "ada@example.com", types.StringNull()},
+		{types.StringValue("Ada@Example.com"), "ada@example.com", types.StringValue("Ada@Example.com")},
+		{types.StringValue("ada@example.com"), "", types.StringValue("ada@example.com")},
+		{types.StringValue("ada@example.com"), "ada.lovelace@example.com", types.StringValue("ada.lovelace@example.com")},
+	}
+	for _, tt := range tests {
+		if got := refreshUserEmail(tt.current, tt.actual); !got.Equal(tt.want) {
+			t.Errorf("refreshUserEmail(%v, %q) = %v, want %v", tt.current, tt.actual, got, tt.want)
+		}
+	}
+}
+
+func TestResolveUserEmails(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	api.AddUser(client.DirectoryUser{ID: "u-1", Email: "ada@example.com"})
+
+	ids, diags := resolveUserEmails(context.Background(), api.Client(), path.Root("members"), []string{"ada@example.com", "grace@example.com"})
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("ErrorsCount() = %d, want 1: %v", diags.ErrorsCount(), diags)
+	}
+	if ids["ada@example.com"] != "u-1" {
+		t.Errorf("ids = %v, want ada@example.com resolved to u-1", ids)
+	}
+}
+
+func TestUserRoleResource_ModifyPlan_ResolvesUserEmail(t *testing.T) {
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	api.AddUser(client.DirectoryUser{ID: "u-2", Email: "ada@example.com"})
+
+	r := &UserRoleResource{client: api.Client()}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	ctx := context.Background()
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	prior := UserRoleResourceModel{
+		ID: types.StringValue("u-1:admin"), UserID: types.StringValue("u-1"), UserEmail: types.StringValue("ada@example.com"),
+		Role: types.StringValue("admin"), Email: types.StringValue("ada@example.com"),
+	}
+	if diags := state.Set(ctx, &prior); diags.HasError() {
+		t.Fatalf("encoding model: %v", diags)
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan() error = %v", resp.Diagnostics)
+	}
+
+	var userID string
+	resp.Plan.GetAttribute(ctx, path.Root("user_id"), &userID)
+	if userID != "u-2" {
+		t.Errorf("planned user_id = %q, want %q", userID, "u-2")
+	}
+	if len(resp.RequiresReplace) != 1 || !resp.RequiresReplace[0].Equal(path.Root("user_id")) {
+		t.Errorf("RequiresReplace = %v, want [user_id]", resp.RequiresReplace)
+	}
+}
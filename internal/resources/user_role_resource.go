@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
 )
@@ -18,6 +20,7 @@ import (
 var (
 	_ resource.Resource                = &UserRoleResource{}
 	_ resource.ResourceWithImportState = &UserRoleResource{}
+	_ resource.ResourceWithModifyPlan  = &UserRoleResource{}
 )
 
 // UserRoleResource defines the resource implementation.
@@ -27,10 +30,11 @@ type UserRoleResource struct {
 
 // UserRoleResourceModel describes the resource data model.
 type UserRoleResourceModel struct {
-	ID     types.String `tfsdk:"id"`
-	UserID types.String `tfsdk:"user_id"`
-	Role   types.String `tfsdk:"role"`
-	Email  types.String `tfsdk:"email"`
+	ID        types.String `tfsdk:"id"`
+	UserID    types.String `tfsdk:"user_id"`
+	UserEmail types.String `tfsdk:"user_email"`
+	Role      types.String `tfsdk:"role"`
+	Email     types.String `tfsdk:"email"`
 }
 
 // NewUserRoleResource creates a new user role resource.
@@ -54,11 +58,21 @@ func (r *UserRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"user_id": schema.StringAttribute{
-				Description: "The ID of the user to assign the role to.",
-				Required:    true,
+				Description: "The ID of the user to assign the role to. Exactly one of user_id or user_email must be set.",
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_email")),
+				},
+			},
+			"user_email": schema.StringAttribute{
+				Description: "The email of the user to assign the role to, resolved to user_id during plan. " +
+					"If the email later belongs to a different user, the assignment is replaced.",
+				Optional: true,
 			},
 			"role": schema.StringAttribute{
 				Description: "The role to assign to the user (e.g., admin, editor, viewer).",
@@ -92,6 +106,38 @@ func (r *UserRoleResource) Configure(_ context.Context, req resource.ConfigureRe
 	r.client = c
 }
 
+// ModifyPlan resolves user_email to a user ID and validates the user and role
+// when the provider enables validate_references.
+func (r *UserRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state UserRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID, replace, diags := planUserIDFromEmail(ctx, r.client, path.Root("user_email"), plan.UserEmail, plan.UserID, state.UserID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !userID.Equal(plan.UserID) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("user_id"), userID)...)
+	}
+	if replace {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("user_id"))
+	}
+
+	resp.Diagnostics.Append(validateChangedReference(ctx, r.client, path.Root("user_id"), userID, state.UserID, referenceUser)...)
+	resp.Diagnostics.Append(validateChangedReference(ctx, r.client, path.Root("role"), plan.Role, state.Role, referenceRole)...)
+}
+
 func (r *UserRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Debug(ctx, "creating user role")
 
@@ -101,7 +147,11 @@ func (r *UserRoleResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	userID := plan.UserID.ValueString()
+	userID, diags := resolveUserID(ctx, r.client, plan.UserID, plan.UserEmail)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	role := plan.Role.ValueString()
 
 	err := r.client.AddUserRole(ctx, userID, client.RoleRequest{Role: role})
@@ -111,6 +161,8 @@ func (r *UserRoleResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	plan.ID = types.StringValue(userID + ":" + role)
+	plan.UserID = types.StringValue(userID)
+	plan.Email = types.StringNull()
 
 	// Read back to populate email
 	userRole, err := r.client.GetUserRole(ctx, userID, role)
@@ -146,29 +198,72 @@ func (r *UserRoleResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	state.ID = types.StringValue(userID + ":" + role)
 	state.Email = stringValueOrNull(userRole.Email)
+	state.UserEmail = refreshUserEmail(state.UserEmail, userRole.Email)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (r *UserRoleResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Both user_id and role use RequiresReplace, so Update is never called.
-	resp.Diagnostics.AddError("Update Not Supported", "User role assignments cannot be updated. Changes require replacement.")
+func (r *UserRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// user_id and role require replacement, so only switching between user_id
+	// and a user_email that resolves to the same user reaches Update.
+	var plan UserRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// ImportState imports an assignment from "user/role" or the legacy
+// "user:role", where user is a user ID or email. The email form is resolved
+// to user_id, which is what the imported state records.
 func (r *UserRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: user_id:role
-	parts := strings.SplitN(req.ID, ":", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+	candidates := importIDCandidates(req.ID)
+	if len(candidates) == 0 {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in format 'user_id:role', got: %s", req.ID),
+			fmt.Sprintf("Expected import ID in format 'user_id/role', 'user_email/role' or 'user_id:role', got: %s", req.ID),
 		)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), parts[1])...)
+	for _, c := range candidates {
+		userID, role := c[0], c[1]
+		// Keep the email of a user_email/role import so a config that sets
+		// user_email plans no change after the import.
+		userEmail := types.StringNull()
+		if strings.Contains(userID, "@") {
+			id, err := r.client.ResolveUserEmail(ctx, userID)
+			if err != nil {
+				resp.Diagnostics.AddError("Error Importing User Role", fmt.Sprintf("Could not resolve user email %s: %s", userID, err))
+				return
+			}
+			userEmail = types.StringValue(userID)
+			userID = id
+		}
+
+		userRole, err := r.client.GetUserRole(ctx, userID, role)
+		if err != nil {
+			if client.IsNotFound(err) {
+				continue
+			}
+			resp.Diagnostics.AddError("Error Importing User Role", fmt.Sprintf("Could not read role %s for user %s: %s", role, userID, err))
+			return
+		}
+
+		state := UserRoleResourceModel{
+			ID:        types.StringValue(userID + ":" + role),
+			UserID:    types.StringValue(userID),
+			UserEmail: userEmail,
+			Role:      types.StringValue(role),
+			Email:     stringValueOrNull(userRole.Email),
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	resp.Diagnostics.AddError("Error Importing User Role", fmt.Sprintf("No user role assignment matches import ID %s.", req.ID))
 }
 
 func (r *UserRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
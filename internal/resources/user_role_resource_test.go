@@ -33,7 +33,7 @@ func TestUserRoleResource_Schema_HasRequiredAttributes(t *testing.T) {
 	}
 }
 
-func TestUserRoleResource_Schema_UserIDIsRequired(t *testing.T) {
+func TestUserRoleResource_Schema_UserIDOrEmail(t *testing.T) {
 	r := NewUserRoleResource()
 	resp := &resource.SchemaResponse{}
 	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
@@ -42,8 +42,13 @@ func TestUserRoleResource_Schema_UserIDIsRequired(t *testing.T) {
 	if attr == nil {
 		t.Fatal("user_id attribute not found")
 	}
-	if !attr.IsRequired() {
-		t.Error("user_id should be required")
+	// user_id is computed from user_email when only the email is configured.
+	if !attr.IsOptional() || !attr.IsComputed() {
+		t.Error("user_id should be optional and computed")
+	}
+	email := resp.Schema.Attributes["user_email"]
+	if email == nil || !email.IsOptional() {
+		t.Error("user_email should be optional")
 	}
 }
 
@@ -0,0 +1,414 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+)
+
+var (
+	_ resource.Resource                = &UserRolesResource{}
+	_ resource.ResourceWithImportState = &UserRolesResource{}
+	_ resource.ResourceWithModifyPlan  = &UserRolesResource{}
+)
+
+// UserRolesResource defines the resource implementation.
+type UserRolesResource struct {
+	client *client.Client
+}
+
+// UserRolesResourceModel describes the resource data model.
+type UserRolesResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	UserID        types.String `tfsdk:"user_id"`
+	UserEmail     types.String `tfsdk:"user_email"`
+	Roles         types.Set    `tfsdk:"roles"`
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+	Email         types.String `tfsdk:"email"`
+}
+
+// NewUserRolesResource creates a new user roles resource.
+func NewUserRolesResource() resource.Resource {
+	return &UserRolesResource{}
+}
+
+func (r *UserRolesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_roles"
+}
+
+func (r *UserRolesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete set of roles assigned to a Shoehorn user. " +
+			"Do not combine with shoehorn_user_role for the same user.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The user ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user whose roles are managed. Exactly one of user_id or user_email must be set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_email")),
+				},
+			},
+			"user_email": schema.StringAttribute{
+				Description: "The email of the user whose roles are managed, resolved to user_id during plan. " +
+					"If the email later belongs to a different user, the resource is replaced.",
+				Optional: true,
+			},
+			"roles": schema.SetAttribute{
+				Description: "The roles assigned to the user. An empty set removes every managed role.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"authoritative": schema.BoolAttribute{
+				Description: "When true, roles granted outside Terraform are reported as drift and removed on apply. " +
+					"When false, only the roles listed here are added and removed. Defaults to true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"email": schema.StringAttribute{
+				Description: "The email of the user (read-only, populated from API).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserRolesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan resolves user_email to a user ID and validates the user and any
+// newly added roles when the provider enables validate_references.
+func (r *UserRolesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state UserRolesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID, replace, diags := planUserIDFromEmail(ctx, r.client, path.Root("user_email"), plan.UserEmail, plan.UserID, state.UserID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !userID.Equal(plan.UserID) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("user_id"), userID)...)
+	}
+	if replace {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("user_id"))
+	}
+
+	resp.Diagnostics.Append(validateChangedReference(ctx, r.client, path.Root("user_id"), userID, state.UserID, referenceUser)...)
+	if plan.Roles.IsUnknown() {
+		return
+	}
+
+	planned, diags := stringSetValues(ctx, plan.Roles)
+	resp.Diagnostics.Append(diags...)
+	prior, diags := stringSetValues(ctx, state.Roles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, role := range setDifference(planned, prior) {
+		resp.Diagnostics.Append(validateReference(ctx, r.client, path.Root("roles"), role, referenceRole)...)
+	}
+}
+
+func (r *UserRolesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "creating user roles")
+
+	var plan UserRolesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := stringSetValues(ctx, plan.Roles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID, diags := resolveUserID(ctx, r.client, plan.UserID, plan.UserEmail)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(reconcileUserRoles(ctx, r.client, userID, desired, nil, plan.Authoritative.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(userID)
+	plan.UserID = types.StringValue(userID)
+	plan.Email = r.readUserEmail(ctx, userID, types.StringNull())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserRolesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "reading user roles")
+
+	var state UserRolesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := state.UserID.ValueString()
+	assigned, err := r.client.ListUserRoles(ctx, userID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading User Roles", fmt.Sprintf("Could not read roles for user %s: %s", userID, err))
+		return
+	}
+
+	current := make([]string, 0, len(assigned))
+	for _, a := range assigned {
+		current = append(current, a.Role)
+	}
+
+	// A null authoritative value only occurs right after import.
+	if state.Authoritative.IsNull() {
+		state.Authoritative = types.BoolValue(true)
+	}
+
+	roles := current
+	if !state.Authoritative.ValueBool() {
+		// Only roles this resource manages are tracked; others are left alone.
+		managed, diags := stringSetValues(ctx, state.Roles)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		roles = setIntersection(managed, current)
+		if roles == nil {
+			roles = []string{}
+		}
+	}
+
+	rolesValue, diags := types.SetValueFrom(ctx, types.StringType, roles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(userID)
+	state.Roles = rolesValue
+	if len(assigned) > 0 && assigned[0].Email != "" {
+		state.Email = types.StringValue(assigned[0].Email)
+		state.UserEmail = refreshUserEmail(state.UserEmail, assigned[0].Email)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *UserRolesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "updating user roles")
+
+	var plan, state UserRolesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := stringSetValues(ctx, plan.Roles)
+	resp.Diagnostics.Append(diags...)
+	previous, diags := stringSetValues(ctx, state.Roles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := plan.UserID.ValueString()
+	resp.Diagnostics.Append(reconcileUserRoles(ctx, r.client, userID, desired, previous, plan.Authoritative.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(userID)
+	plan.Email = r.readUserEmail(ctx, userID, state.Email)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserRolesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "deleting user roles")
+
+	var state UserRolesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous, diags := stringSetValues(ctx, state.Roles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the roles in state are removed, even for authoritative resources:
+	// anything granted since the last refresh was never seen by Terraform.
+	resp.Diagnostics.Append(reconcileUserRoles(ctx, r.client, state.UserID.ValueString(), nil, previous, false)...)
+}
+
+// ImportState imports a user's roles by user ID or email. Imported resources
+// are authoritative and record the resolved user_id. An email import also
+// keeps user_email, so a config that sets it plans no change.
+func (r *UserRolesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userID := req.ID
+	if userID == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", "Expected a user ID or email, got an empty string.")
+		return
+	}
+
+	userEmail, email := types.StringNull(), types.StringNull()
+	if strings.Contains(req.ID, "@") {
+		directory, err := r.client.LoadUserDirectory(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Importing User Roles", fmt.Sprintf("Could not list directory users: %s", err))
+			return
+		}
+		if userID, err = directory.UserID(req.ID); err != nil {
+			resp.Diagnostics.AddError("Error Importing User Roles", fmt.Sprintf("Could not resolve user email %s: %s", req.ID, err))
+			return
+		}
+		userEmail = types.StringValue(req.ID)
+		email = stringValueOrNull(directory.Email(userID))
+	}
+
+	assigned, err := r.client.ListUserRoles(ctx, userID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing User Roles", fmt.Sprintf("Could not read roles for user %s: %s", userID, err))
+		return
+	}
+	roles := make([]string, 0, len(assigned))
+	for _, a := range assigned {
+		roles = append(roles, a.Role)
+	}
+	rolesValue, diags := types.SetValueFrom(ctx, types.StringType, roles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := UserRolesResourceModel{
+		ID:            types.StringValue(userID),
+		UserID:        types.StringValue(userID),
+		UserEmail:     userEmail,
+		Roles:         rolesValue,
+		Authoritative: types.BoolValue(true),
+		Email:         email,
+	}
+	if len(assigned) > 0 && assigned[0].Email != "" {
+		state.Email = types.StringValue(assigned[0].Email)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readUserEmail returns the email reported with the user's role assignments,
+// falling back to current when the user has no roles or the lookup fails.
+func (r *UserRolesResource) readUserEmail(ctx context.Context, userID string, current types.String) types.String {
+	assigned, err := r.client.ListUserRoles(ctx, userID)
+	if err != nil || len(assigned) == 0 || assigned[0].Email == "" {
+		if current.IsUnknown() {
+			return types.StringNull()
+		}
+		return current
+	}
+	return types.StringValue(assigned[0].Email)
+}
+
+// reconcileUserRoles adds the desired roles the user does not have and removes
+// roles that are no longer desired. When authoritative, every assigned role
+// outside desired is removed; otherwise only roles in previous are.
+func reconcileUserRoles(ctx context.Context, c *client.Client, userID string, desired, previous []string, authoritative bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	assigned, err := c.ListUserRoles(ctx, userID)
+	if err != nil {
+		diags.AddError("Error Reading User Roles", fmt.Sprintf("Could not read roles for user %s: %s", userID, err))
+		return diags
+	}
+	current := make([]string, 0, len(assigned))
+	for _, a := range assigned {
+		current = append(current, a.Role)
+	}
+
+	for _, role := range setDifference(desired, current) {
+		tflog.Debug(ctx, "adding user role", map[string]any{"user_id": userID, "role": role})
+		if err := c.AddUserRole(ctx, userID, client.RoleRequest{Role: role}); err != nil {
+			diags.AddError("Error Adding User Role", fmt.Sprintf("Could not add role %s to user %s: %s", role, userID, err))
+			return diags
+		}
+	}
+
+	removable := current
+	if !authoritative {
+		removable = setIntersection(previous, current)
+	}
+	for _, role := range setDifference(removable, desired) {
+		tflog.Debug(ctx, "removing user role", map[string]any{"user_id": userID, "role": role})
+		if err := c.RemoveUserRole(ctx, userID, client.RoleRequest{Role: role}); err != nil && !client.IsNotFound(err) {
+			diags.AddError("Error Removing User Role", fmt.Sprintf("Could not remove role %s from user %s: %s", role, userID, err))
+			return diags
+		}
+	}
+
+	return diags
+}
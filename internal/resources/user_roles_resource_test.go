@@ -0,0 +1,204 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/client"
+	"github.com/shoehorn-dev/terraform-provider-shoehorn/internal/fakeapi"
+)
+
+// userRolesTestClient returns a client backed by a fake API in which user u-1
+// holds the admin and viewer roles.
+func userRolesTestClient(t *testing.T) *client.Client {
+	t.Helper()
+	api := fakeapi.NewServer()
+	t.Cleanup(api.Close)
+	api.AddUser(client.DirectoryUser{ID: "u-1", Username: "ada", Email: "ada@example.com", Enabled: true})
+
+	c := api.Client()
+	for _, role := range []string{"admin", "viewer"} {
+		if err := c.AddUserRole(context.Background(), "u-1", client.RoleRequest{Role: role}); err != nil {
+			t.Fatalf("AddUserRole() error = %v", err)
+		}
+	}
+	return c
+}
+
+// assignedRoles returns the sorted roles currently held by userID.
+func assignedRoles(t *testing.T, c *client.Client, userID string) string {
+	t.Helper()
+	assigned, err := c.ListUserRoles(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("ListUserRoles() error = %v", err)
+	}
+	roles := make([]string, 0, len(assigned))
+	for _, a := range assigned {
+		roles = append(roles, a.Role)
+	}
+	return strings.Join(setIntersection(roles, roles), ",")
+}
+
+func userRolesSchema() resource.SchemaResponse {
+	resp := resource.SchemaResponse{}
+	NewUserRolesResource().Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	return resp
+}
+
+func TestUserRolesResource_Metadata(t *testing.T) {
+	r := NewUserRolesResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "shoehorn"}, resp)
+
+	if resp.TypeName != "shoehorn_user_roles" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "shoehorn_user_roles")
+	}
+}
+
+func TestUserRolesResource_Schema(t *testing.T) {
+	attrs := userRolesSchema().Schema.Attributes
+	for _, name := range []string{"id", "user_id", "roles", "authoritative", "email"} {
+		if _, ok := attrs[name]; !ok {
+			t.Errorf("schema missing attribute %q", name)
+		}
+	}
+	if !attrs["roles"].IsRequired() {
+		t.Error("roles should be required")
+	}
+	if !attrs["authoritative"].IsOptional() || !attrs["authoritative"].IsComputed() {
+		t.Error("authoritative should be optional and computed")
+	}
+}
+
+func TestReconcileUserRoles_Authoritative(t *testing.T) {
+	c := userRolesTestClient(t)
+
+	diags := reconcileUserRoles(context.Background(), c, "u-1", []string{"admin", "editor"}, nil, true)
+	if diags.HasError() {
+		t.Fatalf("reconcileUserRoles() error = %v", diags)
+	}
+	if got := assignedRoles(t, c, "u-1"); got != "admin,editor" {
+		t.Errorf("roles = %q, want %q", got, "admin,editor")
+	}
+}
+
+func TestReconcileUserRoles_NonAuthoritativeKeepsUnmanagedRoles(t *testing.T) {
+	c := userRolesTestClient(t)
+
+	diags := reconcileUserRoles(context.Background(), c, "u-1", []string{"editor"}, []string{"admin"}, false)
+	if diags.HasError() {
+		t.Fatalf("reconcileUserRoles() error = %v", diags)
+	}
+	if got := assignedRoles(t, c, "u-1"); got != "editor,viewer" {
+		t.Errorf("roles = %q, want %q", got, "editor,viewer")
+	}
+}
+
+func TestReconcileUserRoles_EmptySetOffboards(t *testing.T) {
+	c := userRolesTestClient(t)
+
+	diags := reconcileUserRoles(context.Background(), c, "u-1", nil, []string{"admin", "viewer"}, true)
+	if diags.HasError() {
+		t.Fatalf("reconcileUserRoles() error = %v", diags)
+	}
+	if got := assignedRoles(t, c, "u-1"); got != "" {
+		t.Errorf("roles = %q, want none", got)
+	}
+}
+
+func TestUserRolesResource_Read_ReportsDrift(t *testing.T) {
+	c := userRolesTestClient(t)
+	r := &UserRolesResource{client: c}
+	schemaResp := userRolesSchema()
+	ctx := context.Background()
+
+	tests := []struct {
+		authoritative bool
+		want          string
+	}{
+		{true, "admin,viewer"},
+		{false, "admin"},
+	}
+	for _, tt := range tests {
+		roles, _ := types.SetValueFrom(ctx, types.StringType, []string{"admin", "editor"})
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		m := UserRolesResourceModel{
+			ID: types.StringValue("u-1"), UserID: types.StringValue("u-1"), Roles: roles,
+			Authoritative: types.BoolValue(tt.authoritative), Email: types.StringNull(),
+		}
+		if diags := state.Set(ctx, &m); diags.HasError() {
+			t.Fatalf("encoding model: %v", diags)
+		}
+
+		resp := &resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Read() error = %v", resp.Diagnostics)
+		}
+
+		var got UserRolesResourceModel
+		resp.State.Get(ctx, &got)
+		names, _ := stringSetValues(ctx, got.Roles)
+		if joined := strings.Join(setIntersection(names, names), ","); joined != tt.want {
+			t.Errorf("authoritative=%v: roles = %q, want %q", tt.authoritative, joined, tt.want)
+		}
+		if got.Email.ValueString() != "ada@example.com" {
+			t.Errorf("email = %q, want %q", got.Email.ValueString(), "ada@example.com")
+		}
+	}
+}
+
+func TestUserRolesResource_ImportState(t *testing.T) {
+	r := &UserRolesResource{client: userRolesTestClient(t)}
+	schemaResp := userRolesSchema()
+	ctx := context.Background()
+
+	// An email import keeps user_email so a config that sets it plans no change.
+	for id, wantEmail := range map[string]types.String{
+		"u-1":             types.StringNull(),
+		"ADA@example.com": types.StringValue("ADA@example.com"),
+	} {
+		resp := &resource.ImportStateResponse{State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: id}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("ImportState(%q) error = %v", id, resp.Diagnostics)
+		}
+
+		var userID string
+		var authoritative bool
+		var userEmail types.String
+		resp.State.GetAttribute(ctx, path.Root("user_id"), &userID)
+		resp.State.GetAttribute(ctx, path.Root("authoritative"), &authoritative)
+		resp.State.GetAttribute(ctx, path.Root("user_email"), &userEmail)
+		if userID != "u-1" || !authoritative {
+			t.Errorf("ImportState(%q): user_id = %q, authoritative = %v", id, userID, authoritative)
+		}
+		if !userEmail.Equal(wantEmail) {
+			t.Errorf("ImportState(%q): user_email = %v, want %v", id, userEmail, wantEmail)
+		}
+	}
+}
+
+func TestUserRolesResource_ImportState_UnknownEmail(t *testing.T) {
+	r := &UserRolesResource{client: userRolesTestClient(t)}
+	schemaResp := userRolesSchema()
+	ctx := context.Background()
+
+	resp := &resource.ImportStateResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "grace@example.com"}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("ImportState() should fail for an unknown email")
+	}
+}
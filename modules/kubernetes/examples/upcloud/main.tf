@@ -139,3 +139,16 @@ module "shoehorn" {
     upcloud_kubernetes_node_group.workers,
   ]
 }
+
+# =============================================================================
+# Tenant Bootstrap (Phase 2)
+# =============================================================================
+
+module "tenant" {
+  source = "../../../tenant-bootstrap"
+  count  = var.shoehorn_api_key != "" ? 1 : 0
+
+  healthy   = module.shoehorn.healthy
+  tenant    = var.tenant
+  git_token = var.git_token
+}
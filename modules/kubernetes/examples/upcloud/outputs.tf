@@ -27,3 +27,8 @@ output "agent_status" {
   description = "K8s agent status"
   value       = module.shoehorn.agent_status
 }
+
+output "team_ids" {
+  description = "Bootstrapped team IDs keyed by slug"
+  value       = try(module.tenant[0].team_ids, {})
+}
@@ -139,3 +139,25 @@ variable "shoehorn_api_key" {
   sensitive   = true
   default     = ""
 }
+
+variable "tenant" {
+  description = "Tenant spec passed to the tenant-bootstrap module (teams, settings, group role mappings, git integration)"
+  type        = any
+  default = {
+    teams = {
+      "platform-admins" = {
+        name = "Platform Admins"
+      }
+    }
+    group_role_mappings = [
+      { group_name = "Everyone", role_name = "entity:viewer" },
+    ]
+  }
+}
+
+variable "git_token" {
+  description = "Token for the tenant git integration"
+  type        = string
+  sensitive   = true
+  default     = ""
+}
@@ -37,3 +37,13 @@ output "agent_status" {
   description = "K8s agent registration status"
   value       = var.deploy_agent ? shoehorn_k8s_agent.cluster[0].status : null
 }
+
+output "health_check_status" {
+  description = "HTTP status code returned by the /healthz check"
+  value       = data.http.health.status_code
+}
+
+output "healthy" {
+  description = "Whether Shoehorn answered the health check with 200 (gate for tenant bootstrap)"
+  value       = data.http.health.status_code == 200
+}
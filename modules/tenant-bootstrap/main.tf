@@ -0,0 +1,113 @@
+# =============================================================================
+# Shoehorn Tenant Bootstrap Module
+#
+# Creates the catalog resources every new tenant needs: teams, branding
+# settings, IdP group role mappings and the git integration.
+#
+# Pass module.shoehorn.healthy from the kubernetes module as `healthy`. All
+# resources depend on the gate below, so a fresh install creates the Helm
+# release first and bootstraps the tenant in the same apply.
+# =============================================================================
+
+locals {
+  group_role_mappings = {
+    for m in var.tenant.group_role_mappings : "${m.group_name}:${m.role_name}" => m
+  }
+
+  git = var.tenant.git_integration
+}
+
+# =============================================================================
+# 1. Health Gate
+# =============================================================================
+
+resource "terraform_data" "health_gate" {
+  input = var.healthy
+
+  lifecycle {
+    precondition {
+      condition     = var.healthy
+      error_message = "Shoehorn did not pass its health check; the tenant cannot be bootstrapped yet."
+    }
+  }
+}
+
+# =============================================================================
+# 2. Teams
+# =============================================================================
+
+resource "shoehorn_team" "this" {
+  for_each = var.tenant.teams
+
+  name         = each.value.name
+  slug         = each.key
+  display_name = coalesce(each.value.display_name, each.value.name)
+  description  = each.value.description
+
+  members = length(each.value.members) > 0 ? jsonencode([
+    for m in each.value.members : merge(
+      { role = m.role },
+      m.user_email != null ? { user_email = m.user_email } : {},
+      m.user_id != null ? { user_id = m.user_id } : {},
+    )
+  ]) : null
+
+  depends_on = [terraform_data.health_gate]
+}
+
+# =============================================================================
+# 3. Tenant Settings
+# =============================================================================
+
+resource "shoehorn_tenant_settings" "this" {
+  count = var.tenant.settings != null ? 1 : 0
+
+  on_destroy           = var.tenant.settings.on_destroy
+  platform_name        = var.tenant.settings.platform_name
+  platform_description = var.tenant.settings.platform_description
+  company_name         = var.tenant.settings.company_name
+  primary_color        = var.tenant.settings.primary_color
+  default_theme        = var.tenant.settings.default_theme
+
+  depends_on = [terraform_data.health_gate]
+}
+
+# =============================================================================
+# 4. Group Role Mappings
+# =============================================================================
+
+resource "shoehorn_group_role_mapping" "this" {
+  for_each = local.group_role_mappings
+
+  group_name    = each.value.group_name
+  role_name     = each.value.role_name
+  auth_provider = each.value.auth_provider
+  description   = each.value.description
+
+  depends_on = [terraform_data.health_gate]
+}
+
+# =============================================================================
+# 5. Git Integration
+# =============================================================================
+
+resource "shoehorn_integration" "git" {
+  count = local.git != null ? 1 : 0
+
+  name = local.git.name
+  type = local.git.type
+  config_json = jsonencode({
+    token        = var.git_token
+    organization = local.git.organization
+  })
+  team_id = local.git.team != null ? shoehorn_team.this[local.git.team].id : null
+
+  lifecycle {
+    precondition {
+      condition     = var.git_token != ""
+      error_message = "git_token must be set when tenant.git_integration is configured."
+    }
+  }
+
+  depends_on = [terraform_data.health_gate]
+}
@@ -0,0 +1,24 @@
+output "team_ids" {
+  description = "Team IDs keyed by slug"
+  value       = { for slug, team in shoehorn_team.this : slug => team.id }
+}
+
+output "team_member_user_ids" {
+  description = "Resolved directory user IDs for members given by email, keyed by team slug"
+  value       = { for slug, team in shoehorn_team.this : slug => team.member_user_ids }
+}
+
+output "group_role_mapping_ids" {
+  description = "Group role mapping IDs (group:role)"
+  value       = [for m in shoehorn_group_role_mapping.this : m.id]
+}
+
+output "tenant_settings_id" {
+  description = "Tenant settings ID, or null when settings were not managed"
+  value       = one(shoehorn_tenant_settings.this[*].id)
+}
+
+output "git_integration_id" {
+  description = "Git integration ID, or null when no integration was configured"
+  value       = one(shoehorn_integration.git[*].id)
+}
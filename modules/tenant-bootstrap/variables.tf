@@ -0,0 +1,72 @@
+# =============================================================================
+# Health Gate
+# =============================================================================
+
+variable "healthy" {
+  description = "Whether Shoehorn is up; wire to module.shoehorn.healthy so bootstrap waits for the Helm release"
+  type        = bool
+}
+
+# =============================================================================
+# Tenant Spec
+# =============================================================================
+
+variable "tenant" {
+  description = "Tenant to bootstrap: teams keyed by slug, branding settings, IdP group role mappings and the git integration"
+  type = object({
+    teams = optional(map(object({
+      name         = string
+      display_name = optional(string)
+      description  = optional(string)
+      members = optional(list(object({
+        user_email = optional(string)
+        user_id    = optional(string)
+        role       = optional(string, "member")
+      })), [])
+    })), {})
+
+    settings = optional(object({
+      platform_name        = optional(string)
+      platform_description = optional(string)
+      company_name         = optional(string)
+      primary_color        = optional(string)
+      default_theme        = optional(string)
+      on_destroy           = optional(string, "restore")
+    }))
+
+    group_role_mappings = optional(list(object({
+      group_name    = string
+      role_name     = string
+      auth_provider = optional(string)
+      description   = optional(string)
+    })), [])
+
+    git_integration = optional(object({
+      name         = string
+      type         = optional(string, "github")
+      organization = string
+      team         = optional(string) # key in teams; scopes the integration to that team
+    }))
+  })
+
+  validation {
+    condition = alltrue([
+      for t in values(var.tenant.teams) : alltrue([
+        for m in t.members : (m.user_email != null) != (m.user_id != null)
+      ])
+    ])
+    error_message = "Each team member needs exactly one of user_email or user_id."
+  }
+
+  validation {
+    condition     = try(var.tenant.git_integration.team, null) == null || contains(keys(var.tenant.teams), try(var.tenant.git_integration.team, ""))
+    error_message = "git_integration.team must be one of the keys in teams."
+  }
+}
+
+variable "git_token" {
+  description = "Token for the git integration (required when tenant.git_integration is set)"
+  type        = string
+  sensitive   = true
+  default     = ""
+}
@@ -0,0 +1,10 @@
+terraform {
+  required_version = ">= 1.5.0"
+
+  required_providers {
+    shoehorn = {
+      source  = "shoehorn-dev/shoehorn"
+      version = ">= 0.2.0"
+    }
+  }
+}